@@ -15,4 +15,5 @@ type Account struct {
 
 type AccountRepository interface {
 	Save(ctx context.Context, address common.Address, transactedAt time.Time) error
+	FindByAddress(ctx context.Context, address string) (*Account, error)
 }
@@ -76,19 +76,34 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) error {
 		return err
 	}
 
+	txRepository, err := repo.NewTransactionRepository(db)
+	if err != nil {
+		return err
+	}
+
+	accountRepository, err := repo.NewAccountRepository(db)
+	if err != nil {
+		return err
+	}
+
 	ethClient, err := ethclient.Dial(cfg.RPCUrl)
 	if err != nil {
 		return err
 	}
 
 	srv, err := http.NewServer(http.NewServerOpts{
-		EventRepo:        eventRepository,
-		NFTBalanceRepo:   nftBalanceRepository,
-		ERC20BalanceRepo: erc20BalanceRepository,
-		ChartRepo:        chartRepository,
-		Echo:             echo.New(),
-		CorsOrigins:      cfg.CORSOrigins,
-		EthClient:        ethClient,
+		EventRepo:             eventRepository,
+		NFTBalanceRepo:        nftBalanceRepository,
+		ERC20BalanceRepo:      erc20BalanceRepository,
+		ChartRepo:             chartRepository,
+		TxRepo:                txRepository,
+		AccountRepo:           accountRepository,
+		Echo:                  echo.New(),
+		CorsOrigins:           cfg.CORSOrigins,
+		EthClient:             ethClient,
+		GraphQLMaxDepth:       cfg.GraphQLMaxDepth,
+		GraphQLMaxComplexity:  cfg.GraphQLMaxComplexity,
+		GraphQLMaxQueryLength: cfg.GraphQLMaxQueryLength,
 	})
 	if err != nil {
 		return err
@@ -26,6 +26,9 @@ type Config struct {
 	MetricsHTTPPort         uint64
 	ETHClientTimeout        uint64
 	CORSOrigins             []string
+	GraphQLMaxDepth         int
+	GraphQLMaxComplexity    int
+	GraphQLMaxQueryLength   int
 	OpenDBFunc              func() (db.DB, error)
 }
 
@@ -47,6 +50,9 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MetricsHTTPPort:         c.Uint64(flags.MetricsHTTPPort.Name),
 		CORSOrigins:             cors,
 		RPCUrl:                  c.String(flags.APIRPCUrl.Name),
+		GraphQLMaxDepth:         c.Int(flags.GraphQLMaxDepth.Name),
+		GraphQLMaxComplexity:    c.Int(flags.GraphQLMaxComplexity.Name),
+		GraphQLMaxQueryLength:   c.Int(flags.GraphQLMaxQueryLength.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
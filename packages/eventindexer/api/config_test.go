@@ -15,6 +15,9 @@ var (
 	databaseMaxIdleConns    = "10"
 	databaseMaxOpenConns    = "10"
 	databaseMaxConnLifetime = "30"
+	graphQLMaxDepth         = "5"
+	graphQLMaxComplexity    = "500"
+	graphQLMaxQueryLength   = "2000"
 )
 
 func setupApp() *cli.App {
@@ -45,6 +48,9 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, uint64(10), c.DatabaseMaxIdleConns)
 		assert.Equal(t, uint64(10), c.DatabaseMaxOpenConns)
 		assert.Equal(t, uint64(30), c.DatabaseMaxConnLifetime)
+		assert.Equal(t, 5, c.GraphQLMaxDepth)
+		assert.Equal(t, 500, c.GraphQLMaxComplexity)
+		assert.Equal(t, 2000, c.GraphQLMaxQueryLength)
 		assert.NotNil(t, c.OpenDBFunc)
 
 		return err
@@ -63,5 +69,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.DatabaseMaxIdleConns.Name, databaseMaxIdleConns,
 		"--" + flags.DatabaseMaxOpenConns.Name, databaseMaxOpenConns,
 		"--" + flags.DatabaseConnMaxLifetime.Name, databaseMaxConnLifetime,
+		"--" + flags.GraphQLMaxDepth.Name, graphQLMaxDepth,
+		"--" + flags.GraphQLMaxComplexity.Name, graphQLMaxComplexity,
+		"--" + flags.GraphQLMaxQueryLength.Name, graphQLMaxQueryLength,
 	}))
 }
@@ -0,0 +1,53 @@
+package eventindexer
+
+import (
+	"context"
+	"database/sql"
+)
+
+var (
+	ContractTypeERC20   = "ERC20"
+	ContractTypeERC721  = "ERC721"
+	ContractTypeERC1155 = "ERC1155"
+)
+
+// BalanceTransfer is a transfer log applied to the balances, recorded along with the hash
+// of its block so it is only applied once, and can be rolled back if the block is reorged out.
+// FromAddress and ToAddress are empty when the balance on that side was left untouched.
+type BalanceTransfer struct {
+	ID              int
+	ChainID         int64
+	BlockID         uint64
+	BlockHash       string
+	TxHash          string
+	LogIndex        uint
+	BatchIndex      int
+	ContractAddress string
+	ContractType    string
+	TokenID         sql.NullInt64
+	FromAddress     string
+	ToAddress       string
+	Amount          string
+}
+
+// BalanceTransferOpts identifies the transfer log a balance update comes from. BatchIndex
+// tells apart the transfers of an ERC1155 TransferBatch log.
+type BalanceTransferOpts struct {
+	BlockID    uint64
+	BlockHash  string
+	TxHash     string
+	LogIndex   uint
+	BatchIndex int
+}
+
+// TransferBlock is a block transfers were applied from.
+type TransferBlock struct {
+	BlockID   uint64
+	BlockHash string
+}
+
+// BalanceTransferRepository is used to interact with the applied transfers in the store
+type BalanceTransferRepository interface {
+	FindBlocksFromBlockID(ctx context.Context, chainID int64, blockID uint64) ([]TransferBlock, error)
+	DeleteBeforeBlockID(ctx context.Context, chainID int64, blockID uint64) error
+}
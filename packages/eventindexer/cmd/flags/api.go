@@ -30,10 +30,37 @@ var (
 		Value:    "*",
 		Category: indexerCategory,
 	}
+	GraphQLMaxDepth = &cli.IntFlag{
+		Name:     "graphql.maxDepth",
+		Usage:    "Maximum nesting depth of the fields of a GraphQL query",
+		Required: false,
+		Value:    8,
+		Category: indexerCategory,
+		EnvVars:  []string{"GRAPHQL_MAX_DEPTH"},
+	}
+	GraphQLMaxComplexity = &cli.IntFlag{
+		Name:     "graphql.maxComplexity",
+		Usage:    "Maximum number of records a GraphQL query can load",
+		Required: false,
+		Value:    1000,
+		Category: indexerCategory,
+		EnvVars:  []string{"GRAPHQL_MAX_COMPLEXITY"},
+	}
+	GraphQLMaxQueryLength = &cli.IntFlag{
+		Name:     "graphql.maxQueryLength",
+		Usage:    "Maximum length of a GraphQL query, in bytes",
+		Required: false,
+		Value:    10000,
+		Category: indexerCategory,
+		EnvVars:  []string{"GRAPHQL_MAX_QUERY_LENGTH"},
+	}
 )
 
 var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
 	APIRPCUrl,
 	HTTPPort,
 	CORSOrigins,
+	GraphQLMaxDepth,
+	GraphQLMaxComplexity,
+	GraphQLMaxQueryLength,
 })
@@ -5,9 +5,10 @@ import (
 )
 
 var (
-	commonCategory  = "COMMON"
-	indexerCategory = "INDEXER"
-	txmgrCategory   = "TX_MANAGER"
+	commonCategory    = "COMMON"
+	indexerCategory   = "INDEXER"
+	generatorCategory = "GENERATOR"
+	txmgrCategory     = "TX_MANAGER"
 )
 
 var (
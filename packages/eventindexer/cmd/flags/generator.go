@@ -0,0 +1,57 @@
+package flags
+
+import "github.com/urfave/cli/v2"
+
+// required flags
+var (
+	GenesisDate = &cli.StringFlag{
+		Name:     "genesisDate",
+		Usage:    "Date to generate the time series data from, in the format YYYY-MM-DD",
+		Required: true,
+		Category: generatorCategory,
+		EnvVars:  []string{"GENESIS_DATE"},
+	}
+)
+
+// optional flags
+var (
+	Regenerate = &cli.BoolFlag{
+		Name:     "regenerate",
+		Usage:    "Delete all the time series data and generate it again from the genesis date",
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"REGENERATE"},
+	}
+	GenerateHourly = &cli.BoolFlag{
+		Name:     "generateHourly",
+		Usage:    "Whether to generate hourly time series data, in addition to the daily one",
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"GENERATE_HOURLY"},
+	}
+	GeneratorInterval = &cli.Uint64Flag{
+		Name:     "generatorInterval",
+		Usage:    "Interval in seconds between the runs generating the missing time series data",
+		Value:    3600,
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"GENERATOR_INTERVAL_IN_SECONDS"},
+	}
+	GeneratorRegenerateWindow = &cli.Uint64Flag{
+		Name:     "generatorRegenerateWindow",
+		Usage:    "Window in seconds before now over which the time series data is generated again at every run",
+		Value:    172800,
+		Required: false,
+		Category: generatorCategory,
+		EnvVars:  []string{"GENERATOR_REGENERATE_WINDOW_IN_SECONDS"},
+	}
+)
+
+var GeneratorFlags = MergeFlags(CommonFlags, []cli.Flag{
+	GenesisDate,
+	// optional
+	Regenerate,
+	GenerateHourly,
+	GeneratorInterval,
+	GeneratorRegenerateWindow,
+})
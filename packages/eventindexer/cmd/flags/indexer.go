@@ -74,6 +74,72 @@ var (
 		Category: indexerCategory,
 		EnvVars:  []string{"INDEX_ERC20S"},
 	}
+	ReorgCheckDepth = &cli.Uint64Flag{
+		Name:     "reorgCheckDepth",
+		Usage:    "Number of the latest indexed blocks whose transfers are checked to still be canonical",
+		Value:    64,
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"REORG_CHECK_DEPTH"},
+	}
+	ReconcileInterval = &cli.Uint64Flag{
+		Name:     "reconcileInterval",
+		Usage:    "Interval in seconds between the reconciliations of the indexed balances with the chain, 0 to disable",
+		Value:    3600,
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"RECONCILE_INTERVAL_IN_SECONDS"},
+	}
+	ReconcileSampleSize = &cli.Uint64Flag{
+		Name:     "reconcileSampleSize",
+		Usage:    "Number of random erc20 and nft balances checked against the chain per reconciliation",
+		Value:    100,
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"RECONCILE_SAMPLE_SIZE"},
+	}
+	TaikoTokenAddress = &cli.StringFlag{
+		Name:     "taikoTokenAddress",
+		Usage:    "Address of the TaikoToken contract",
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"TAIKO_TOKEN_ADDRESS"},
+	}
+	SgxVerifierAddress = &cli.StringFlag{
+		Name:     "sgxVerifierAddress",
+		Usage:    "Address of the SgxVerifier contract",
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"SGX_VERIFIER_ADDRESS"},
+	}
+	SwapAddresses = &cli.StringSliceFlag{
+		Name:     "swapAddresses",
+		Usage:    "Comma-delimited addresses of the swap pair contracts",
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"SWAP_ADDRESSES"},
+	}
+	IndexTaikoToken = &cli.BoolFlag{
+		Name:     "indexTaikoToken",
+		Usage:    "Whether to index TaikoToken delegation and transfer events or not",
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"INDEX_TAIKO_TOKEN"},
+	}
+	IndexSgxInstances = &cli.BoolFlag{
+		Name:     "indexSgxInstances",
+		Usage:    "Whether to index SgxVerifier instance registration and deletion events or not",
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"INDEX_SGX_INSTANCES"},
+	}
+	IndexSwaps = &cli.BoolFlag{
+		Name:     "indexSwaps",
+		Usage:    "Whether to index swap and liquidity events of the swap pairs or not",
+		Required: false,
+		Category: indexerCategory,
+		EnvVars:  []string{"INDEX_SWAPS"},
+	}
 )
 
 var IndexerFlags = MergeFlags(CommonFlags, []cli.Flag{
@@ -87,4 +153,13 @@ var IndexerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	SyncMode,
 	IndexNFTs,
 	IndexERC20s,
+	ReorgCheckDepth,
+	ReconcileInterval,
+	ReconcileSampleSize,
+	TaikoTokenAddress,
+	SgxVerifierAddress,
+	SwapAddresses,
+	IndexTaikoToken,
+	IndexSgxInstances,
+	IndexSwaps,
 })
@@ -34,18 +34,33 @@ type UpdateERC20BalanceOpts struct {
 	Amount          string
 }
 
+// FindERC20BalancesOpts filters the non-zero balances of an address, an empty field matching
+// any value.
+type FindERC20BalancesOpts struct {
+	Address         string
+	ChainID         int64
+	ContractAddress string
+	Offset          int
+	Limit           int
+}
+
 // ERC20BalanceRepository is used to interact with ERC20 balances in the store
 type ERC20BalanceRepository interface {
 	IncreaseAndDecreaseBalancesInTx(
 		ctx context.Context,
 		increaseOpts UpdateERC20BalanceOpts,
 		decreaseOpts UpdateERC20BalanceOpts,
+		transferOpts BalanceTransferOpts,
 	) (increasedBalance *ERC20Balance, decreasedBalance *ERC20Balance, err error)
+	RollbackAllAfterBlockID(ctx context.Context, blockID uint64, chainID int64) error
+	FindRandom(ctx context.Context, chainID int64, limit int) ([]*ERC20Balance, error)
+	SetBalance(ctx context.Context, opts UpdateERC20BalanceOpts) error
 	FindByAddress(ctx context.Context,
 		req *http.Request,
 		address string,
 		chainID string,
 	) (paginate.Page, error)
+	Find(ctx context.Context, opts FindERC20BalancesOpts) ([]*ERC20Balance, error)
 	FindMetadata(ctx context.Context, chainID int64, contractAddress string) (*ERC20Metadata, error)
 	CreateMetadata(
 		ctx context.Context,
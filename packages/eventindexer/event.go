@@ -13,13 +13,18 @@ import (
 )
 
 var (
-	EventNameProved        = "Proved"
-	EventNameProposed      = "Proposed"
-	EventNameMessageSent   = "MessageSent"
-	EventNameSwap          = "Swap"
-	EventNameMint          = "Mint"
-	EventNameNFTTransfer   = "Transfer"
-	EventNameInstanceAdded = "InstanceAdded"
+	EventNameProved               = "Proved"
+	EventNameProposed             = "Proposed"
+	EventNameMessageSent          = "MessageSent"
+	EventNameSwap                 = "Swap"
+	EventNameMint                 = "Mint"
+	EventNameNFTTransfer          = "Transfer"
+	EventNameInstanceAdded        = "InstanceAdded"
+	EventNameInstanceDeleted      = "InstanceDeleted"
+	EventNameDelegateChanged      = "DelegateChanged"
+	EventNameDelegateVotesChanged = "DelegateVotesChanged"
+	EventNameTaikoTokenTransfer   = "TaikoTokenTransfer"
+	EventNameLivenessBondSettled  = "LivenessBondSettled"
 )
 
 // Event represents a stored EVM event. The fields will be serialized
@@ -44,7 +49,10 @@ type Event struct {
 	TransactedAt    time.Time           `json:"transactedAt"`
 	Tier            sql.NullInt16       `json:"tier"`
 	EmittedBlockID  uint64              `json:"emittedBlockID"`
+	LogIndex        uint                `json:"logIndex"`
 	NumBlocks       sql.NullInt64       `json:"numBlocks"`
+	NumBlobs        sql.NullInt64       `json:"numBlobs"`
+	ProvingWindow   sql.NullInt64       `json:"provingWindow"`
 	BatchID         sql.NullInt64       `json:"batchID"`
 }
 
@@ -67,10 +75,23 @@ type SaveEventOpts struct {
 	TransactedAt    time.Time
 	Tier            *uint16
 	EmittedBlockID  uint64
+	LogIndex        uint
 	NumBlocks       *int64
+	NumBlobs        *int64
+	ProvingWindow   *int64
 	BatchID         *int64
 }
 
+// FindEventsOpts filters the events found, an empty field matching any value.
+type FindEventsOpts struct {
+	Event   string
+	Address string
+	ChainID int64
+	BatchID *int64
+	Offset  int
+	Limit   int
+}
+
 type UniqueProversResponse struct {
 	Address string `json:"address"`
 	Count   int    `json:"count"`
@@ -81,9 +102,68 @@ type UniqueProposersResponse struct {
 	Count   int    `json:"count"`
 }
 
+// SgxInstanceResponse is an SGX instance currently registered with a SgxVerifier.
+type SgxInstanceResponse struct {
+	ID              int64     `json:"id"`
+	Address         string    `json:"address"`
+	ContractAddress string    `json:"contractAddress"`
+	RegisteredAt    time.Time `json:"registeredAt"`
+}
+
+type TopDelegatesResponse struct {
+	Address string          `json:"address"`
+	Votes   decimal.Decimal `json:"votes"`
+}
+
+// ProposalAnalyticsResponse is a proposal joined with its proof and the settlement of its
+// liveness bond, the proof fields being null until it is proved.
+type ProposalAnalyticsResponse struct {
+	ProposalID int64      `json:"proposalID"`
+	Proposer   string     `json:"proposer"`
+	ProposedAt time.Time  `json:"proposedAt"`
+	NumBlobs   int64      `json:"numBlobs"`
+	Prover     *string    `json:"prover"`
+	ProvedAt   *time.Time `json:"provedAt"`
+	// ProvingLatency is the number of seconds between the proposal and its proof.
+	ProvingLatency *int64 `json:"provingLatency"`
+	// ProvedByProposer is whether the proposer, who is the designated prover of the
+	// proposal, proved it.
+	ProvedByProposer *bool `json:"provedByProposer"`
+	// ProvingWindow is the number of seconds the proposal had to be proved in, as configured
+	// in the Inbox when it was proposed.
+	ProvingWindow *int64 `json:"provingWindow"`
+	// MissedProvingWindow is whether the proposal was proved after its proving window.
+	MissedProvingWindow *bool `json:"missedProvingWindow"`
+	// LivenessBondSettled is whether the Inbox settled the liveness bond of the proposer,
+	// which it does for a late proof, once a grace after the previous finalization passed too,
+	// only when the prover whitelist is disabled and the bond is not zero.
+	// LivenessBondSlashed and LivenessBondCredited are the parts of the bond, in gwei, burned
+	// and credited to the prover.
+	LivenessBondSettled  bool                `json:"livenessBondSettled"`
+	LivenessBondSlashed  decimal.NullDecimal `json:"livenessBondSlashed"`
+	LivenessBondCredited decimal.NullDecimal `json:"livenessBondCredited"`
+}
+
+// ProverStatsResponse sums up how the proposals of a proposer, as their designated prover,
+// were proved. MissedProvingWindow counts the proposals proved after their proving window,
+// and LivenessBondsSettled the ones whose liveness bond the Inbox settled.
+type ProverStatsResponse struct {
+	Address              string   `json:"address"`
+	Proposals            int      `json:"proposals"`
+	Proved               int      `json:"proved"`
+	ProvedBySelf         int      `json:"provedBySelf"`
+	ProvedByOthers       int      `json:"provedByOthers"`
+	MissedProvingWindow  int      `json:"missedProvingWindow"`
+	LivenessBondsSettled int      `json:"livenessBondsSettled"`
+	AvgProvingLatency    *float64 `json:"avgProvingLatency"`
+	// LivenessBondSlashed is the total of the liveness bonds slashed, in gwei.
+	LivenessBondSlashed decimal.Decimal `json:"livenessBondSlashed"`
+}
+
 // EventRepository is used to interact with events in the store
 type EventRepository interface {
 	Save(ctx context.Context, opts SaveEventOpts) (*Event, error)
+	Find(ctx context.Context, opts FindEventsOpts) ([]*Event, error)
 	FindUniqueProvers(
 		ctx context.Context,
 	) ([]UniqueProversResponse, error)
@@ -117,4 +197,17 @@ type EventRepository interface {
 	) (uint64, error)
 	GetProposalProposedBy(ctx context.Context, proposalID int) (*Event, error)
 	GetProposalProvedBy(ctx context.Context, proposalID int) (*Event, error)
+	FindCurrentSgxInstances(ctx context.Context) ([]SgxInstanceResponse, error)
+	GetSgxInstanceHistory(
+		ctx context.Context,
+		req *http.Request,
+		instanceID int64,
+	) (paginate.Page, error)
+	FindTopDelegates(ctx context.Context, limit int) ([]TopDelegatesResponse, error)
+	GetProposalAnalytics(
+		ctx context.Context,
+		req *http.Request,
+		proposer string,
+	) (paginate.Page, error)
+	FindProverStats(ctx context.Context) ([]ProverStatsResponse, error)
 }
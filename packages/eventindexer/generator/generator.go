@@ -0,0 +1,286 @@
+package generator
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	cliV2 "github.com/urfave/cli/v2"
+	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+// Generator computes the time series data served by the chart API from the indexed events,
+// transactions and accounts, generating every date missing since the genesis date.
+type Generator struct {
+	db db.DB
+
+	genesisDate time.Time
+	regenerate  bool
+	periods     []period
+	interval    time.Duration
+	// regenerateWindow is the window before now the generated dates are generated again
+	// over, as the indexer may not have indexed all their events when first generated.
+	regenerateWindow time.Duration
+
+	wg     *sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+func (g *Generator) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	g.cancel = cancel
+
+	if g.regenerate {
+		if err := g.deleteTimeSeriesData(ctx); err != nil {
+			return errors.Wrap(err, "g.deleteTimeSeriesData")
+		}
+	}
+
+	g.wg.Add(1)
+
+	go g.generateLoop(ctx)
+
+	return nil
+}
+
+func (g *Generator) generateLoop(ctx context.Context) {
+	defer g.wg.Done()
+
+	t := time.NewTicker(g.interval)
+
+	defer t.Stop()
+
+	for {
+		if err := g.generate(ctx); err != nil {
+			eventindexer.TimeSeriesDataGeneratedError.Inc()
+
+			slog.Error("error generating time series data", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("generate loop context done")
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (g *Generator) Name() string {
+	return "generator"
+}
+
+func (g *Generator) InitFromCli(ctx context.Context, c *cliV2.Context) error {
+	cfg, err := NewConfigFromCliContext(c)
+	if err != nil {
+		return err
+	}
+
+	return InitFromConfig(ctx, g, cfg)
+}
+
+func InitFromConfig(ctx context.Context, g *Generator, cfg *Config) error {
+	db, err := cfg.OpenDBFunc()
+	if err != nil {
+		return err
+	}
+
+	g.db = db
+	g.genesisDate = cfg.GenesisDate
+	g.regenerate = cfg.Regenerate
+	g.interval = time.Duration(cfg.Interval) * time.Second
+	g.regenerateWindow = time.Duration(cfg.RegenerateWindow) * time.Second
+	g.wg = &sync.WaitGroup{}
+
+	g.periods = []period{day}
+	if cfg.GenerateHourly {
+		g.periods = append(g.periods, hour)
+	}
+
+	return nil
+}
+
+func (g *Generator) Close(ctx context.Context) {
+	if g.cancel != nil {
+		g.cancel()
+	}
+
+	g.wg.Wait()
+
+	// Close db connection.
+	if err := g.db.Close(); err != nil {
+		slog.Error("Failed to close db connection", "err", err)
+	}
+}
+
+// generate generates the time series data of every task and period, for the dates between
+// the genesis date and the last complete period which have not been generated yet, or end
+// within the regenerate window.
+func (g *Generator) generate(ctx context.Context) error {
+	now := time.Now()
+
+	for _, p := range g.periods {
+		for _, t := range tasks {
+			if err := g.generateTask(ctx, t, p, now); err != nil {
+				return errors.Wrapf(err, "g.generateTask(%s)", t.taskName(p))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) generateTask(ctx context.Context, t task, p period, now time.Time) error {
+	name := t.taskName(p)
+
+	generated, err := g.findGeneratedDates(ctx, name)
+	if err != nil {
+		return errors.Wrap(err, "g.findGeneratedDates")
+	}
+
+	count := 0
+
+	for _, start := range p.starts(g.genesisDate, now) {
+		date := start.Format(p.layout)
+		if generated[date] && !p.endsWithin(start, now, g.regenerateWindow) {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		data, err := g.queryTask(ctx, t, start, p.next(start))
+		if err != nil {
+			return errors.Wrap(err, "g.queryTask")
+		}
+
+		if err := g.saveTimeSeriesData(ctx, name, date, data); err != nil {
+			return errors.Wrap(err, "g.saveTimeSeriesData")
+		}
+
+		eventindexer.TimeSeriesDataGenerated.Inc()
+
+		count++
+	}
+
+	if count > 0 {
+		slog.Info("generated time series data", "task", name, "dates", count)
+	}
+
+	return nil
+}
+
+// findGeneratedDates returns the dates the time series data of the task was generated for.
+func (g *Generator) findGeneratedDates(ctx context.Context, task string) (map[string]bool, error) {
+	var dates []string
+
+	if err := g.db.GormDB().WithContext(ctx).
+		Raw("SELECT DISTINCT date FROM time_series_data WHERE task = ?", task).
+		Scan(&dates).Error; err != nil {
+		return nil, err
+	}
+
+	generated := make(map[string]bool, len(dates))
+	for _, date := range dates {
+		generated[date] = true
+	}
+
+	return generated, nil
+}
+
+// taskResult is a row returned by the query of a task, the tier being only set by the tasks
+// grouping their values by tier.
+type taskResult struct {
+	Tier  sql.NullInt16
+	Value decimal.NullDecimal
+}
+
+// queryTask returns the time series data of the task over the period between start and end.
+func (g *Generator) queryTask(
+	ctx context.Context,
+	t task,
+	start time.Time,
+	end time.Time,
+) ([]*eventindexer.TimeSeriesData, error) {
+	var results []taskResult
+
+	args := append(slices.Clone(t.args), start, end)
+
+	if err := g.db.GormDB().WithContext(ctx).Raw(t.query, args...).Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	// a task grouping by tier returns no rows over a period without any of its events, a
+	// zero row then marks the date as generated.
+	if len(results) == 0 {
+		results = append(results, taskResult{})
+	}
+
+	data := make([]*eventindexer.TimeSeriesData, 0, len(results))
+
+	for _, r := range results {
+		value := r.Value
+		if !value.Valid {
+			value = decimal.NewNullDecimal(decimal.Zero)
+		}
+
+		data = append(data, &eventindexer.TimeSeriesData{
+			Value: value,
+			Tier:  r.Tier,
+		})
+	}
+
+	return data, nil
+}
+
+// saveTimeSeriesData replaces the time series data of the task for the date, so generating
+// a date again does not duplicate it.
+func (g *Generator) saveTimeSeriesData(
+	ctx context.Context,
+	task string,
+	date string,
+	data []*eventindexer.TimeSeriesData,
+) error {
+	for _, d := range data {
+		d.Task = task
+		d.Date = date
+	}
+
+	return g.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM time_series_data WHERE task = ? AND date = ?", task, date).Error; err != nil {
+			return err
+		}
+
+		if len(data) == 0 {
+			return nil
+		}
+
+		return tx.Table("time_series_data").Create(data).Error
+	})
+}
+
+// deleteTimeSeriesData deletes the time series data of all the tasks, for it to be
+// generated again.
+func (g *Generator) deleteTimeSeriesData(ctx context.Context) error {
+	var names []string
+
+	for _, p := range []period{day, hour} {
+		for _, t := range tasks {
+			names = append(names, t.taskName(p))
+		}
+	}
+
+	slog.Info("deleting time series data to regenerate it", "tasks", len(names))
+
+	return g.db.GormDB().WithContext(ctx).
+		Exec("DELETE FROM time_series_data WHERE task IN (?)", names).Error
+}
@@ -0,0 +1,198 @@
+package generator
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+func saveProvedEvent(t *testing.T, d db.DB, tier int, transactedAt time.Time) {
+	require.NoError(t, d.GormDB().Exec(
+		`INSERT INTO events (name, event, chain_id, data, emitted_block_id, tier, transacted_at)
+		VALUES (?, ?, 167000, '{}', 1, ?, ?)`,
+		eventindexer.EventNameProved, eventindexer.EventNameProved, tier, transactedAt,
+	).Error)
+}
+
+func saveProposedEvent(t *testing.T, d db.DB, numBlobs int, transactedAt time.Time) {
+	require.NoError(t, d.GormDB().Exec(
+		`INSERT INTO events (name, event, chain_id, data, emitted_block_id, num_blobs, transacted_at)
+		VALUES (?, ?, 167000, '{}', 1, ?, ?)`,
+		eventindexer.EventNameProposed, eventindexer.EventNameProposed, numBlobs, transactedAt,
+	).Error)
+}
+
+func findTimeSeriesData(t *testing.T, d db.DB, task string) []*eventindexer.TimeSeriesData {
+	var data []*eventindexer.TimeSeriesData
+
+	require.NoError(t, d.GormDB().Raw(
+		"SELECT * FROM time_series_data WHERE task = ? ORDER BY date, tier", task,
+	).Scan(&data).Error)
+
+	return data
+}
+
+func findTask(t *testing.T, name string) task {
+	for _, task := range tasks {
+		if task.name == name {
+			return task
+		}
+	}
+
+	t.Fatalf("no %s task", name)
+
+	return task{}
+}
+
+func proofsByTier(t *testing.T) task {
+	return findTask(t, "proofs-by-tier")
+}
+
+func TestIntegration_Generator_queryTask(t *testing.T) {
+	d, close, err := testMysql(t)
+	require.NoError(t, err)
+
+	defer close()
+
+	g := &Generator{db: d}
+
+	start := time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC)
+
+	saveProvedEvent(t, d, 100, start.Add(time.Hour))
+	saveProvedEvent(t, d, 100, start.Add(2*time.Hour))
+	saveProvedEvent(t, d, 200, start.Add(3*time.Hour))
+	// the next day, out of the period.
+	saveProvedEvent(t, d, 200, start.AddDate(0, 0, 1))
+
+	data, err := g.queryTask(context.Background(), proofsByTier(t), start, day.next(start))
+	require.NoError(t, err)
+	require.Len(t, data, 2)
+
+	values := map[int16]int64{}
+	for _, row := range data {
+		values[row.Tier.Int16] = row.Value.Decimal.IntPart()
+	}
+
+	assert.Equal(t, map[int16]int64{100: 2, 200: 1}, values)
+
+	// a period without events returns a zero row.
+	empty := start.AddDate(0, 0, -1)
+
+	data, err = g.queryTask(context.Background(), proofsByTier(t), empty, day.next(empty))
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+	assert.False(t, data[0].Tier.Valid)
+	assert.True(t, data[0].Value.Decimal.IsZero())
+}
+
+func TestIntegration_Generator_saveTimeSeriesData(t *testing.T) {
+	d, close, err := testMysql(t)
+	require.NoError(t, err)
+
+	defer close()
+
+	g := &Generator{db: d}
+
+	tier := func(tier int16) sql.NullInt16 { return sql.NullInt16{Int16: tier, Valid: true} }
+	value := func(v int64) decimal.NullDecimal { return decimal.NewNullDecimal(decimal.NewFromInt(v)) }
+
+	require.NoError(t, g.saveTimeSeriesData(context.Background(), "test", "2024-05-27", []*eventindexer.TimeSeriesData{
+		{Tier: tier(100), Value: value(2)},
+		{Tier: tier(200), Value: value(1)},
+	}))
+	require.NoError(t, g.saveTimeSeriesData(context.Background(), "test", "2024-05-28", []*eventindexer.TimeSeriesData{
+		{Value: value(0)},
+	}))
+
+	// saving a date again replaces its data.
+	require.NoError(t, g.saveTimeSeriesData(context.Background(), "test", "2024-05-27", []*eventindexer.TimeSeriesData{
+		{Tier: tier(100), Value: value(3)},
+	}))
+
+	data := findTimeSeriesData(t, d, "test")
+	require.Len(t, data, 2)
+
+	assert.Equal(t, "2024-05-27", data[0].Date)
+	assert.Equal(t, int16(100), data[0].Tier.Int16)
+	assert.Equal(t, int64(3), data[0].Value.Decimal.IntPart())
+
+	assert.Equal(t, "2024-05-28", data[1].Date)
+	assert.False(t, data[1].Tier.Valid)
+	assert.True(t, data[1].Value.Decimal.IsZero())
+
+	dates, err := g.findGeneratedDates(context.Background(), "test")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"2024-05-27": true, "2024-05-28": true}, dates)
+}
+
+func TestIntegration_Generator_saveTimeSeriesData_Average(t *testing.T) {
+	d, close, err := testMysql(t)
+	require.NoError(t, err)
+
+	defer close()
+
+	g := &Generator{db: d}
+
+	start := time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC)
+
+	saveProposedEvent(t, d, 1, start.Add(time.Hour))
+	saveProposedEvent(t, d, 2, start.Add(2*time.Hour))
+
+	data, err := g.queryTask(context.Background(), findTask(t, "blobs-per-proposal"), start, day.next(start))
+	require.NoError(t, err)
+
+	require.NoError(t, g.saveTimeSeriesData(context.Background(), "blobs-per-proposal", "2024-05-27", data))
+
+	// the average keeps its fractional part once saved.
+	data = findTimeSeriesData(t, d, "blobs-per-proposal")
+	require.Len(t, data, 1)
+	assert.Equal(t, "1.5", data[0].Value.Decimal.String())
+}
+
+func TestIntegration_Generator_generateTask_RegeneratesWindow(t *testing.T) {
+	d, close, err := testMysql(t)
+	require.NoError(t, err)
+
+	defer close()
+
+	g := &Generator{
+		db:               d,
+		genesisDate:      time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC),
+		regenerateWindow: 24 * time.Hour,
+	}
+
+	now := time.Date(2024, 5, 29, 12, 0, 0, 0, time.UTC)
+	task := proofsByTier(t)
+
+	require.NoError(t, g.generateTask(context.Background(), task, day, now))
+
+	// both days are generated, with a zero row for the days without proofs.
+	data := findTimeSeriesData(t, d, task.taskName(day))
+	require.Len(t, data, 2)
+
+	// proofs of both days indexed after they were generated.
+	saveProvedEvent(t, d, 100, time.Date(2024, 5, 27, 1, 0, 0, 0, time.UTC))
+	saveProvedEvent(t, d, 100, time.Date(2024, 5, 28, 1, 0, 0, 0, time.UTC))
+
+	require.NoError(t, g.generateTask(context.Background(), task, day, now))
+
+	data = findTimeSeriesData(t, d, task.taskName(day))
+	require.Len(t, data, 2)
+
+	// the first day ended before the window, so it is not generated again.
+	assert.Equal(t, "2024-05-27", data[0].Date)
+	assert.False(t, data[0].Tier.Valid)
+	assert.True(t, data[0].Value.Decimal.IsZero())
+
+	assert.Equal(t, "2024-05-28", data[1].Date)
+	assert.Equal(t, int16(100), data[1].Tier.Int16)
+	assert.Equal(t, int64(1), data[1].Value.Decimal.IntPart())
+}
@@ -0,0 +1,167 @@
+package generator
+
+import (
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// dateLayout is the layout of the genesis date, and of the dates of the daily time series data.
+const dateLayout = "2006-01-02"
+
+// period is a granularity the time series data is generated at, in UTC.
+type period struct {
+	name     string
+	layout   string
+	truncate func(t time.Time) time.Time
+	next     func(start time.Time) time.Time
+}
+
+var (
+	day = period{
+		name:   "day",
+		layout: dateLayout,
+		truncate: func(t time.Time) time.Time {
+			t = t.UTC()
+
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		},
+		next: func(start time.Time) time.Time {
+			return start.AddDate(0, 0, 1)
+		},
+	}
+	hour = period{
+		name:   "hour",
+		layout: "2006-01-02 15:04",
+		truncate: func(t time.Time) time.Time {
+			return t.UTC().Truncate(time.Hour)
+		},
+		next: func(start time.Time) time.Time {
+			return start.Add(time.Hour)
+		},
+	}
+)
+
+// starts returns the starts of the periods from the one including from, up to the one
+// including now, which is left out as it is not over yet.
+func (p period) starts(from time.Time, now time.Time) []time.Time {
+	var starts []time.Time
+
+	for start, end := p.truncate(from), p.truncate(now); start.Before(end); start = p.next(start) {
+		starts = append(starts, start)
+	}
+
+	return starts
+}
+
+// endsWithin returns whether the period starting at start ends within the window before now.
+func (p period) endsWithin(start time.Time, now time.Time, window time.Duration) bool {
+	return p.next(start).After(now.Add(-window))
+}
+
+// task is a time series computed over each period. Its query is given the args, then the
+// start and end of the period, and returns a value, or a value per tier.
+type task struct {
+	name  string
+	query string
+	args  []interface{}
+}
+
+// taskName is the name the time series data of the task is stored under, for the period.
+func (t task) taskName(p period) string {
+	return t.name + "-per-" + p.name
+}
+
+var tasks = []task{
+	{
+		name:  "proposals",
+		query: "SELECT COUNT(*) AS value FROM events WHERE event = ? AND transacted_at >= ? AND transacted_at < ?",
+		args:  []interface{}{eventindexer.EventNameProposed},
+	},
+	{
+		name:  "proofs",
+		query: "SELECT COUNT(*) AS value FROM events WHERE event = ? AND transacted_at >= ? AND transacted_at < ?",
+		args:  []interface{}{eventindexer.EventNameProved},
+	},
+	{
+		name: "proofs-by-tier",
+		query: `SELECT tier, COUNT(*) AS value FROM events
+		WHERE event = ? AND tier IS NOT NULL AND transacted_at >= ? AND transacted_at < ?
+		GROUP BY tier`,
+		args: []interface{}{eventindexer.EventNameProved},
+	},
+	{
+		name: "unique-proposers",
+		query: `SELECT COUNT(DISTINCT address) AS value FROM events
+		WHERE event = ? AND transacted_at >= ? AND transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameProposed},
+	},
+	{
+		name: "unique-provers",
+		query: `SELECT COUNT(DISTINCT address) AS value FROM events
+		WHERE event = ? AND transacted_at >= ? AND transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameProved},
+	},
+	{
+		name: "bridged-volume",
+		query: `SELECT COALESCE(SUM(amount), 0) AS value FROM events
+		WHERE event = ? AND transacted_at >= ? AND transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameMessageSent},
+	},
+	{
+		name: "proving-latency",
+		query: `SELECT AVG(TIMESTAMPDIFF(SECOND, p.transacted_at, v.transacted_at)) AS value FROM events v
+		JOIN events p ON p.event = ? AND p.chain_id = v.chain_id AND p.batch_id = v.batch_id
+		WHERE v.event = ? AND v.transacted_at >= ? AND v.transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameProposed, eventindexer.EventNameProved},
+	},
+	{
+		name: "proofs-by-other-provers",
+		query: `SELECT COUNT(*) AS value FROM events v
+		JOIN events p ON p.event = ? AND p.chain_id = v.chain_id AND p.batch_id = v.batch_id
+		WHERE v.event = ? AND v.address <> p.address AND v.transacted_at >= ? AND v.transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameProposed, eventindexer.EventNameProved},
+	},
+	// the proofs of the period which came after the proving window of their proposal.
+	{
+		name: "missed-proving-windows",
+		query: `SELECT COUNT(*) AS value FROM events v
+		JOIN events p ON p.event = ? AND p.chain_id = v.chain_id AND p.batch_id = v.batch_id
+		WHERE v.event = ? AND v.transacted_at > p.transacted_at + INTERVAL p.proving_window SECOND
+		AND v.transacted_at >= ? AND v.transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameProposed, eventindexer.EventNameProved},
+	},
+	{
+		name:  "liveness-bonds-settled",
+		query: "SELECT COUNT(*) AS value FROM events WHERE event = ? AND transacted_at >= ? AND transacted_at < ?",
+		args:  []interface{}{eventindexer.EventNameLivenessBondSettled},
+	},
+	{
+		name: "liveness-bond-slashed",
+		query: `SELECT COALESCE(SUM(amount), 0) AS value FROM events
+		WHERE event = ? AND transacted_at >= ? AND transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameLivenessBondSettled},
+	},
+	{
+		name: "blobs-per-proposal",
+		query: `SELECT AVG(num_blobs) AS value FROM events
+		WHERE event = ? AND transacted_at >= ? AND transacted_at < ?`,
+		args: []interface{}{eventindexer.EventNameProposed},
+	},
+	{
+		name:  "active-accounts",
+		query: "SELECT COUNT(DISTINCT sender) AS value FROM transactions WHERE transacted_at >= ? AND transacted_at < ?",
+	},
+	{
+		name:  "new-accounts",
+		query: "SELECT COUNT(*) AS value FROM accounts WHERE transacted_at >= ? AND transacted_at < ?",
+	},
+	{
+		name:  "tx-count",
+		query: "SELECT COUNT(*) AS value FROM transactions WHERE transacted_at >= ? AND transacted_at < ?",
+	},
+	{
+		name:  "gas-used",
+		query: "SELECT COALESCE(SUM(gas_used), 0) AS value FROM transactions WHERE transacted_at >= ? AND transacted_at < ?",
+	},
+}
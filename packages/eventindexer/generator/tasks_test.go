@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_period_starts(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     period
+		from  time.Time
+		now   time.Time
+		dates []string
+	}{
+		{
+			"daysLeaveOutToday",
+			day,
+			time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 30, 13, 20, 0, 0, time.UTC),
+			[]string{"2024-05-27", "2024-05-28", "2024-05-29"},
+		},
+		{
+			"daysInUTC",
+			day,
+			time.Date(2024, 5, 27, 22, 0, 0, 0, time.FixedZone("UTC-3", -3*60*60)),
+			time.Date(2024, 5, 29, 1, 0, 0, 0, time.UTC),
+			[]string{"2024-05-28"},
+		},
+		{
+			"hoursLeaveOutCurrentHour",
+			hour,
+			time.Date(2024, 5, 27, 22, 30, 0, 0, time.UTC),
+			time.Date(2024, 5, 28, 1, 10, 0, 0, time.UTC),
+			[]string{"2024-05-27 22:00", "2024-05-27 23:00", "2024-05-28 00:00"},
+		},
+		{
+			"genesisInTheFuture",
+			day,
+			time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 30, 0, 0, 0, 0, time.UTC),
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dates []string
+
+			for _, start := range tt.p.starts(tt.from, tt.now) {
+				dates = append(dates, start.Format(tt.p.layout))
+			}
+
+			assert.Equal(t, tt.dates, dates)
+		})
+	}
+}
+
+func Test_task_taskName(t *testing.T) {
+	assert.Equal(t, "proofs-by-tier-per-day", task{name: "proofs-by-tier"}.taskName(day))
+	assert.Equal(t, "gas-used-per-hour", task{name: "gas-used"}.taskName(hour))
+}
+
+func Test_taskNamesFitTheTable(t *testing.T) {
+	for _, p := range []period{day, hour} {
+		for _, task := range tasks {
+			assert.LessOrEqual(t, len(task.taskName(p)), 40)
+		}
+	}
+}
+
+func Test_period_endsWithin(t *testing.T) {
+	now := time.Date(2024, 5, 30, 13, 20, 0, 0, time.UTC)
+
+	assert.True(t, day.endsWithin(time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC), now, 48*time.Hour))
+	assert.True(t, day.endsWithin(time.Date(2024, 5, 28, 0, 0, 0, 0, time.UTC), now, 48*time.Hour))
+	assert.False(t, day.endsWithin(time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC), now, 48*time.Hour))
+	assert.False(t, day.endsWithin(time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC), now, 0))
+
+	assert.True(t, hour.endsWithin(time.Date(2024, 5, 30, 12, 0, 0, 0, time.UTC), now, time.Hour))
+	assert.False(t, hour.endsWithin(time.Date(2024, 5, 30, 11, 0, 0, 0, time.UTC), now, time.Hour))
+}
@@ -1,6 +1,8 @@
 package indexer
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/urfave/cli/v2"
 	"gorm.io/driver/mysql"
@@ -30,12 +32,43 @@ type Config struct {
 	SyncMode                SyncMode
 	IndexNFTs               bool
 	IndexERC20s             bool
+	ReorgCheckDepth         uint64
+	ReconcileInterval       uint64
+	ReconcileSampleSize     uint64
+	TaikoTokenAddress       common.Address
+	SgxVerifierAddress      common.Address
+	SwapAddresses           []common.Address
+	IndexTaikoToken         bool
+	IndexSgxInstances       bool
+	IndexSwaps              bool
 	Layer                   string
 	OpenDBFunc              func() (db.DB, error)
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
+	var swapAddresses []common.Address
+
+	for _, addr := range c.StringSlice(flags.SwapAddresses.Name) {
+		if !common.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid %s: %s", flags.SwapAddresses.Name, addr)
+		}
+
+		swapAddresses = append(swapAddresses, common.HexToAddress(addr))
+	}
+
+	if c.Bool(flags.IndexTaikoToken.Name) && !c.IsSet(flags.TaikoTokenAddress.Name) {
+		return nil, fmt.Errorf("%s is required to index the TaikoToken", flags.TaikoTokenAddress.Name)
+	}
+
+	if c.Bool(flags.IndexSgxInstances.Name) && !c.IsSet(flags.SgxVerifierAddress.Name) {
+		return nil, fmt.Errorf("%s is required to index the SGX instances", flags.SgxVerifierAddress.Name)
+	}
+
+	if c.Bool(flags.IndexSwaps.Name) && len(swapAddresses) == 0 {
+		return nil, fmt.Errorf("%s is required to index the swaps", flags.SwapAddresses.Name)
+	}
+
 	return &Config{
 		DatabaseUsername:        c.String(flags.DatabaseUsername.Name),
 		DatabasePassword:        c.String(flags.DatabasePassword.Name),
@@ -54,6 +87,15 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		SyncMode:                SyncMode(c.String(flags.SyncMode.Name)),
 		IndexNFTs:               c.Bool(flags.IndexNFTs.Name),
 		IndexERC20s:             c.Bool(flags.IndexERC20s.Name),
+		ReorgCheckDepth:         c.Uint64(flags.ReorgCheckDepth.Name),
+		ReconcileInterval:       c.Uint64(flags.ReconcileInterval.Name),
+		ReconcileSampleSize:     c.Uint64(flags.ReconcileSampleSize.Name),
+		TaikoTokenAddress:       common.HexToAddress(c.String(flags.TaikoTokenAddress.Name)),
+		SgxVerifierAddress:      common.HexToAddress(c.String(flags.SgxVerifierAddress.Name)),
+		SwapAddresses:           swapAddresses,
+		IndexTaikoToken:         c.Bool(flags.IndexTaikoToken.Name),
+		IndexSgxInstances:       c.Bool(flags.IndexSgxInstances.Name),
+		IndexSwaps:              c.Bool(flags.IndexSwaps.Name),
 		Layer:                   c.String(flags.Layer.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
@@ -22,6 +22,12 @@ var (
 	syncMode                = "sync"
 	layer                   = "l1"
 	rpcUrl                  = "rpcUrl"
+	reorgCheckDepth         = "32"
+	reconcileInterval       = "600"
+	reconcileSampleSize     = "50"
+	taikoTokenAddress       = "0x83FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	sgxVerifierAddress      = "0x93FaC9201494f0bd17B9892B9fae4d52fe3BD377"
+	swapAddresses           = "0xA3FaC9201494f0bd17B9892B9fae4d52fe3BD377,0xB3FaC9201494f0bd17B9892B9fae4d52fe3BD377"
 )
 
 func setupApp() *cli.App {
@@ -58,6 +64,18 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, uint64(30), c.SubscriptionBackoff)
 		assert.Equal(t, SyncMode(syncMode), c.SyncMode)
 		assert.Equal(t, true, c.IndexNFTs)
+		assert.Equal(t, uint64(32), c.ReorgCheckDepth)
+		assert.Equal(t, uint64(600), c.ReconcileInterval)
+		assert.Equal(t, uint64(50), c.ReconcileSampleSize)
+		assert.Equal(t, common.HexToAddress(taikoTokenAddress), c.TaikoTokenAddress)
+		assert.Equal(t, common.HexToAddress(sgxVerifierAddress), c.SgxVerifierAddress)
+		assert.Equal(t, []common.Address{
+			common.HexToAddress("0xA3FaC9201494f0bd17B9892B9fae4d52fe3BD377"),
+			common.HexToAddress("0xB3FaC9201494f0bd17B9892B9fae4d52fe3BD377"),
+		}, c.SwapAddresses)
+		assert.Equal(t, true, c.IndexTaikoToken)
+		assert.Equal(t, true, c.IndexSgxInstances)
+		assert.Equal(t, true, c.IndexSwaps)
 		assert.Equal(t, layer, c.Layer)
 		assert.Equal(t, rpcUrl, c.RPCUrl)
 		assert.NotNil(t, c.OpenDBFunc)
@@ -86,5 +104,61 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.IndexNFTs.Name,
 		"--" + flags.Layer.Name, layer,
 		"--" + flags.IndexerRPCUrl.Name, rpcUrl,
+		"--" + flags.ReorgCheckDepth.Name, reorgCheckDepth,
+		"--" + flags.ReconcileInterval.Name, reconcileInterval,
+		"--" + flags.ReconcileSampleSize.Name, reconcileSampleSize,
+		"--" + flags.TaikoTokenAddress.Name, taikoTokenAddress,
+		"--" + flags.SgxVerifierAddress.Name, sgxVerifierAddress,
+		"--" + flags.SwapAddresses.Name, swapAddresses,
+		"--" + flags.IndexTaikoToken.Name,
+		"--" + flags.IndexSgxInstances.Name,
+		"--" + flags.IndexSwaps.Name,
 	}))
 }
+
+func TestNewConfigFromCliContext_IndexingTaskWithoutAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			"taikoToken",
+			[]string{"--" + flags.IndexTaikoToken.Name},
+			flags.TaikoTokenAddress.Name,
+		},
+		{
+			"sgxInstances",
+			[]string{"--" + flags.IndexSgxInstances.Name},
+			flags.SgxVerifierAddress.Name,
+		},
+		{
+			"swaps",
+			[]string{"--" + flags.IndexSwaps.Name},
+			flags.SwapAddresses.Name,
+		},
+		{
+			"invalidSwapAddress",
+			[]string{"--" + flags.SwapAddresses.Name, "0x123"},
+			flags.SwapAddresses.Name,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := setupApp()
+
+			err := app.Run(append([]string{
+				"TestNewConfigFromCliContext",
+				"--" + flags.DatabaseUsername.Name, "dbuser",
+				"--" + flags.DatabasePassword.Name, "dbpass",
+				"--" + flags.DatabaseHost.Name, "dbhost",
+				"--" + flags.DatabaseName.Name, "dbname",
+				"--" + flags.L1TaikoAddress.Name, l1TaikoAddress,
+				"--" + flags.IndexerRPCUrl.Name, rpcUrl,
+			}, tt.args...))
+
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -43,6 +43,36 @@ func filterFunc(
 		})
 	}
 
+	if i.taikoToken != nil {
+		wg.Go(func() error {
+			if err := i.filterTaikoTokenEvents(ctx, chainID, filterOpts); err != nil {
+				return errors.Wrap(err, "i.filterTaikoTokenEvents")
+			}
+
+			return nil
+		})
+	}
+
+	if i.sgxVerifier != nil {
+		wg.Go(func() error {
+			if err := i.filterSgxInstanceEvents(ctx, chainID, filterOpts); err != nil {
+				return errors.Wrap(err, "i.filterSgxInstanceEvents")
+			}
+
+			return nil
+		})
+	}
+
+	for _, pair := range i.swaps {
+		wg.Go(func() error {
+			if err := i.filterSwapEvents(ctx, chainID, pair, filterOpts); err != nil {
+				return errors.Wrap(err, "i.filterSwapEvents")
+			}
+
+			return nil
+		})
+	}
+
 	wg.Go(func() error {
 		if err := i.indexRawBlockData(ctx, chainID, filterOpts.Start, *filterOpts.End); err != nil {
 			return errors.Wrap(err, "i.indexRawBlockData")
@@ -68,6 +98,10 @@ func filterFunc(
 func (i *Indexer) filter(
 	ctx context.Context,
 ) error {
+	if err := i.handleReorgs(ctx); err != nil {
+		return errors.Wrap(err, "i.handleReorgs")
+	}
+
 	endBlockID, err := i.ethClient.BlockNumber(ctx)
 	if err != nil {
 		return errors.Wrap(err, "i.ethClient.BlockNumber")
@@ -117,5 +151,9 @@ func (i *Indexer) filter(
 		i.latestIndexedBlockNumber = end
 	}
 
+	if err := i.pruneBalanceTransfers(ctx); err != nil {
+		return errors.Wrap(err, "i.pruneBalanceTransfers")
+	}
+
 	return nil
 }
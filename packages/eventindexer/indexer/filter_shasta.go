@@ -8,6 +8,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/shasta/inbox"
 )
 
 func filterFuncShasta(
@@ -47,6 +49,16 @@ func filterFuncShasta(
 
 			return nil
 		})
+		wg.Go(func() error {
+			bondEvents, err := i.inbox.FilterLivenessBondSettled(filterOpts, nil, nil)
+			if err != nil {
+				return errors.Wrap(err, "i.inbox.FilterLivenessBondSettled")
+			}
+
+			return saveEvents(ctx, chainID, bondEvents, func() *inbox.InboxLivenessBondSettled {
+				return bondEvents.Event
+			}, i.saveLivenessBondSettledEvent)
+		})
 	}
 
 	err := wg.Wait()
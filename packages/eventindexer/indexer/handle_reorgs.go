@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// handleReorgs checks the blocks transfers were applied from, within the reorg check depth of
+// the latest indexed block, are still canonical. If one is not, the events and balance
+// updates from it on are undone, and indexing resumes from it.
+func (i *Indexer) handleReorgs(ctx context.Context) error {
+	if !i.indexERC20s && !i.indexNfts {
+		return nil
+	}
+
+	var from uint64
+
+	if i.latestIndexedBlockNumber > i.reorgCheckDepth {
+		from = i.latestIndexedBlockNumber - i.reorgCheckDepth
+	}
+
+	blocks, err := i.balanceTransferRepo.FindBlocksFromBlockID(ctx, int64(i.srcChainID), from)
+	if err != nil {
+		return errors.Wrap(err, "i.balanceTransferRepo.FindBlocksFromBlockID")
+	}
+
+	for _, block := range blocks {
+		header, err := i.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(block.BlockID))
+		if err != nil && !errors.Is(err, ethereum.NotFound) {
+			return errors.Wrap(err, "i.ethClient.HeaderByNumber")
+		}
+
+		if header != nil && header.Hash().Hex() == block.BlockHash {
+			continue
+		}
+
+		slog.Warn("reorg detected",
+			"blockID", block.BlockID,
+			"indexedBlockHash", block.BlockHash,
+			"latestIndexedBlockNumber", i.latestIndexedBlockNumber,
+		)
+
+		eventindexer.ReorgsDetected.Inc()
+
+		return i.rollbackFromBlockID(ctx, block.BlockID)
+	}
+
+	return nil
+}
+
+// pruneBalanceTransfers deletes the transfers applied from the blocks deeper than the reorg
+// check depth, as handleReorgs no longer checks them.
+func (i *Indexer) pruneBalanceTransfers(ctx context.Context) error {
+	if !i.indexERC20s && !i.indexNfts {
+		return nil
+	}
+
+	if i.latestIndexedBlockNumber <= i.reorgCheckDepth {
+		return nil
+	}
+
+	if err := i.balanceTransferRepo.DeleteBeforeBlockID(
+		ctx,
+		int64(i.srcChainID),
+		i.latestIndexedBlockNumber-i.reorgCheckDepth,
+	); err != nil {
+		return errors.Wrap(err, "i.balanceTransferRepo.DeleteBeforeBlockID")
+	}
+
+	return nil
+}
+
+// rollbackFromBlockID deletes the events and reverts the balance updates indexed from the
+// block on, for it to be indexed again.
+func (i *Indexer) rollbackFromBlockID(ctx context.Context, blockID uint64) error {
+	if err := i.eventRepo.DeleteAllAfterBlockID(ctx, blockID, i.srcChainID); err != nil {
+		return errors.Wrap(err, "i.eventRepo.DeleteAllAfterBlockID")
+	}
+
+	if err := i.erc20BalanceRepo.RollbackAllAfterBlockID(ctx, blockID, int64(i.srcChainID)); err != nil {
+		return errors.Wrap(err, "i.erc20BalanceRepo.RollbackAllAfterBlockID")
+	}
+
+	if err := i.nftBalanceRepo.RollbackAllAfterBlockID(ctx, blockID, int64(i.srcChainID)); err != nil {
+		return errors.Wrap(err, "i.nftBalanceRepo.RollbackAllAfterBlockID")
+	}
+
+	i.latestIndexedBlockNumber = blockID - 1
+
+	return nil
+}
@@ -115,6 +115,18 @@ func (i *Indexer) isERC721Transfer(_ context.Context, vLog types.Log) bool {
 	return true
 }
 
+// transferOpts identifies the transfer log the balances are updated from, batchIndex telling
+// apart the transfers of an ERC1155 TransferBatch log.
+func transferOpts(vLog types.Log, batchIndex int) eventindexer.BalanceTransferOpts {
+	return eventindexer.BalanceTransferOpts{
+		BlockID:    vLog.BlockNumber,
+		BlockHash:  vLog.BlockHash.Hex(),
+		TxHash:     vLog.TxHash.Hex(),
+		LogIndex:   vLog.Index,
+		BatchIndex: batchIndex,
+	}
+}
+
 // saveNFTTransfer parses the event logs and saves either an ERC721 or ERC1155 event, updating
 // users balances
 func (i *Indexer) saveNFTTransfer(ctx context.Context, chainID *big.Int, vLog types.Log) error {
@@ -150,7 +162,7 @@ func (i *Indexer) saveERC721Transfer(ctx context.Context, chainID *big.Int, vLog
 		Address:         to,
 		TokenID:         tokenID,
 		ContractAddress: vLog.Address.Hex(),
-		ContractType:    "ERC721",
+		ContractType:    eventindexer.ContractTypeERC721,
 		Amount:          1, // ERC721 is always 1
 	}
 	decreaseOpts := eventindexer.UpdateNFTBalanceOpts{}
@@ -162,12 +174,12 @@ func (i *Indexer) saveERC721Transfer(ctx context.Context, chainID *big.Int, vLog
 			Address:         from,
 			TokenID:         tokenID,
 			ContractAddress: vLog.Address.Hex(),
-			ContractType:    "ERC721",
+			ContractType:    eventindexer.ContractTypeERC721,
 			Amount:          1, // ERC721 is always 1
 		}
 	}
 
-	_, _, err := i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts)
+	_, _, err := i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts, transferOpts(vLog, 0))
 	if err != nil {
 		return err
 	}
@@ -211,7 +223,7 @@ func (i *Indexer) saveERC1155Transfer(ctx context.Context, chainID *big.Int, vLo
 			Address:         to,
 			TokenID:         t.Id.Int64(),
 			ContractAddress: vLog.Address.Hex(),
-			ContractType:    "ERC1155",
+			ContractType:    eventindexer.ContractTypeERC1155,
 			Amount:          t.Value.Int64(),
 		}
 		decreaseOpts := eventindexer.UpdateNFTBalanceOpts{}
@@ -223,12 +235,12 @@ func (i *Indexer) saveERC1155Transfer(ctx context.Context, chainID *big.Int, vLo
 				Address:         from,
 				TokenID:         t.Id.Int64(),
 				ContractAddress: vLog.Address.Hex(),
-				ContractType:    "ERC1155",
+				ContractType:    eventindexer.ContractTypeERC1155,
 				Amount:          t.Value.Int64(),
 			}
 		}
 
-		_, _, err = i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts)
+		_, _, err = i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts, transferOpts(vLog, 0))
 		if err != nil {
 			return err
 		}
@@ -254,7 +266,7 @@ func (i *Indexer) saveERC1155Transfer(ctx context.Context, chainID *big.Int, vLo
 				Address:         to,
 				TokenID:         id.Int64(),
 				ContractAddress: vLog.Address.Hex(),
-				ContractType:    "ERC1155",
+				ContractType:    eventindexer.ContractTypeERC1155,
 				Amount:          t.Values[idx].Int64(),
 			}
 			decreaseOpts := eventindexer.UpdateNFTBalanceOpts{}
@@ -266,12 +278,17 @@ func (i *Indexer) saveERC1155Transfer(ctx context.Context, chainID *big.Int, vLo
 					Address:         from,
 					TokenID:         id.Int64(),
 					ContractAddress: vLog.Address.Hex(),
-					ContractType:    "ERC1155",
+					ContractType:    eventindexer.ContractTypeERC1155,
 					Amount:          t.Values[idx].Int64(),
 				}
 			}
 
-			_, _, err = i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(ctx, increaseOpts, decreaseOpts)
+			_, _, err = i.nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(
+				ctx,
+				increaseOpts,
+				decreaseOpts,
+				transferOpts(vLog, idx),
+			)
 			if err != nil {
 				return err
 			}
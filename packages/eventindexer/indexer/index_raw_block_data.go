@@ -67,6 +67,7 @@ func (i *Indexer) indexRawBlockData(
 							block.Number(),
 							time.Unix(int64(block.Time()), 0),
 							receipt.ContractAddress,
+							receipt.GasUsed,
 						); err != nil {
 							return errors.Wrap(err, "i.txRepo.Save")
 						}
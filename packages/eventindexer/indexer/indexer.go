@@ -13,7 +13,10 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/sgxverifier"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/shasta/inbox"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/swap"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/taikotoken"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/repo"
 )
@@ -44,6 +47,8 @@ type Indexer struct {
 	erc20BalanceRepo eventindexer.ERC20BalanceRepository
 	txRepo           eventindexer.TransactionRepository
 
+	balanceTransferRepo eventindexer.BalanceTransferRepository
+
 	ethClient  *ethclient.Client
 	srcChainID uint64
 
@@ -55,10 +60,18 @@ type Indexer struct {
 	bridge *bridge.Bridge
 	inbox  *inbox.Inbox
 
+	taikoToken  *taikotoken.TaikoToken
+	sgxVerifier *sgxverifier.SgxVerifier
+	swaps       []*swap.Swap
+
 	indexNfts   bool
 	indexERC20s bool
 	layer       string
 
+	reorgCheckDepth     uint64
+	reconcileInterval   time.Duration
+	reconcileSampleSize int
+
 	wg  *sync.WaitGroup
 	ctx context.Context
 
@@ -91,6 +104,18 @@ func (i *Indexer) eventLoop(ctx context.Context) {
 
 	defer t.Stop()
 
+	// reconciling in the same loop as filtering keeps the indexed block the balances are
+	// compared at from moving meanwhile.
+	var reconcileC <-chan time.Time
+
+	if i.reconcileInterval > 0 && (i.indexERC20s || i.indexNfts) {
+		reconcileTicker := time.NewTicker(i.reconcileInterval)
+
+		defer reconcileTicker.Stop()
+
+		reconcileC = reconcileTicker.C
+	}
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -100,6 +125,10 @@ func (i *Indexer) eventLoop(ctx context.Context) {
 			if err := i.filter(ctx); err != nil {
 				slog.Error("error filtering", "error", err)
 			}
+		case <-reconcileC:
+			if err := i.reconcileBalances(ctx); err != nil {
+				slog.Error("error reconciling balances", "error", err)
+			}
 		}
 	}
 }
@@ -149,6 +178,11 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 		return err
 	}
 
+	balanceTransferRepository, err := repo.NewBalanceTransferRepository(db)
+	if err != nil {
+		return err
+	}
+
 	ethClient, err := ethclient.Dial(cfg.RPCUrl)
 	if err != nil {
 		return err
@@ -181,6 +215,43 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 		}
 	}
 
+	var taikoTokenContract *taikotoken.TaikoToken
+
+	if cfg.IndexTaikoToken {
+		slog.Info("setting taikoTokenAddress", "addr", cfg.TaikoTokenAddress.Hex())
+
+		taikoTokenContract, err = taikotoken.NewTaikoToken(cfg.TaikoTokenAddress, ethClient)
+		if err != nil {
+			return errors.Wrap(err, "taikotoken.NewTaikoToken")
+		}
+	}
+
+	var sgxVerifierContract *sgxverifier.SgxVerifier
+
+	if cfg.IndexSgxInstances {
+		slog.Info("setting sgxVerifierAddress", "addr", cfg.SgxVerifierAddress.Hex())
+
+		sgxVerifierContract, err = sgxverifier.NewSgxVerifier(cfg.SgxVerifierAddress, ethClient)
+		if err != nil {
+			return errors.Wrap(err, "sgxverifier.NewSgxVerifier")
+		}
+	}
+
+	var swapContracts []*swap.Swap
+
+	if cfg.IndexSwaps {
+		for _, addr := range cfg.SwapAddresses {
+			slog.Info("adding swapAddress", "addr", addr.Hex())
+
+			swapContract, err := swap.NewSwap(addr, ethClient)
+			if err != nil {
+				return errors.Wrap(err, "swap.NewSwap")
+			}
+
+			swapContracts = append(swapContracts, swapContract)
+		}
+	}
+
 	i.db = db
 	i.blockSaveMutex = &sync.Mutex{}
 	i.accountRepo = accountRepository
@@ -188,12 +259,16 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 	i.nftBalanceRepo = nftBalanceRepository
 	i.erc20BalanceRepo = erc20BalanceRepository
 	i.txRepo = txRepository
+	i.balanceTransferRepo = balanceTransferRepository
 
 	i.srcChainID = chainID.Uint64()
 
 	i.ethClient = ethClient
 	i.inbox = inboxContract
 	i.bridge = bridgeContract
+	i.taikoToken = taikoTokenContract
+	i.sgxVerifier = sgxVerifierContract
+	i.swaps = swapContracts
 	i.blockBatchSize = cfg.BlockBatchSize
 	i.subscriptionBackoff = time.Duration(cfg.SubscriptionBackoff) * time.Second
 	i.wg = &sync.WaitGroup{}
@@ -202,6 +277,9 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) error {
 	i.indexNfts = cfg.IndexNFTs
 	i.indexERC20s = cfg.IndexERC20s
 	i.layer = cfg.Layer
+	i.reorgCheckDepth = cfg.ReorgCheckDepth
+	i.reconcileInterval = time.Duration(cfg.ReconcileInterval) * time.Second
+	i.reconcileSampleSize = int(cfg.ReconcileSampleSize)
 	i.contractToMetadata = make(map[common.Address]*eventindexer.ERC20Metadata, 0)
 	i.contractToMetadataMutex = &sync.Mutex{}
 
@@ -0,0 +1,243 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/erc721"
+)
+
+var (
+	parsedERC721ABI     abi.ABI
+	parsedERC721ABIErr  error
+	parsedERC721ABIOnce sync.Once
+)
+
+func getParsedERC721ABI() (*abi.ABI, error) {
+	parsedERC721ABIOnce.Do(func() {
+		parsedERC721ABI, parsedERC721ABIErr = abi.JSON(strings.NewReader(erc721.ABI))
+	})
+
+	err := parsedERC721ABIErr
+	if err != nil {
+		return nil, errors.Wrap(err, "abi.JSON(strings.NewReader)")
+	}
+
+	return &parsedERC721ABI, nil
+}
+
+// reconcileBalances compares a random sample of the indexed balances with the chain at the
+// latest indexed block, and corrects the ones which drifted, such as the balances of
+// fee-on-transfer tokens, or the ones a transfer was missed for.
+func (i *Indexer) reconcileBalances(ctx context.Context) error {
+	opts := &bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: new(big.Int).SetUint64(i.latestIndexedBlockNumber),
+	}
+
+	if i.indexERC20s {
+		if err := i.reconcileERC20Balances(ctx, opts); err != nil {
+			return errors.Wrap(err, "i.reconcileERC20Balances")
+		}
+	}
+
+	if i.indexNfts {
+		if err := i.reconcileNFTBalances(ctx, opts); err != nil {
+			return errors.Wrap(err, "i.reconcileNFTBalances")
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) reconcileERC20Balances(ctx context.Context, opts *bind.CallOpts) error {
+	balances, err := i.erc20BalanceRepo.FindRandom(ctx, int64(i.srcChainID), i.reconcileSampleSize)
+	if err != nil {
+		return errors.Wrap(err, "i.erc20BalanceRepo.FindRandom")
+	}
+
+	parsedABI, err := getParsedERC20ABI()
+	if err != nil {
+		return err
+	}
+
+	for _, b := range balances {
+		onChain, err := i.callBigInt(opts, parsedABI, b.ContractAddress, "balanceOf", common.HexToAddress(b.Address))
+		if err != nil {
+			// some contracts can not be called, they should not stop the other balances from
+			// being reconciled.
+			slog.Warn("error getting erc20 balance", "contractAddress", b.ContractAddress, "error", err)
+			continue
+		}
+
+		eventindexer.BalancesReconciled.Inc()
+
+		if onChain.String() == b.Amount {
+			continue
+		}
+
+		slog.Warn("erc20 balance drifted",
+			"address", b.Address,
+			"contractAddress", b.ContractAddress,
+			"indexed", b.Amount,
+			"onChain", onChain.String(),
+		)
+
+		eventindexer.ERC20BalancesDrifted.Inc()
+
+		if err := i.erc20BalanceRepo.SetBalance(ctx, eventindexer.UpdateERC20BalanceOpts{
+			ERC20MetadataID: b.ERC20MetadataID,
+			ChainID:         b.ChainID,
+			Address:         b.Address,
+			ContractAddress: b.ContractAddress,
+			Amount:          onChain.String(),
+		}); err != nil {
+			return errors.Wrap(err, "i.erc20BalanceRepo.SetBalance")
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) reconcileNFTBalances(ctx context.Context, opts *bind.CallOpts) error {
+	balances, err := i.nftBalanceRepo.FindRandom(ctx, int64(i.srcChainID), i.reconcileSampleSize)
+	if err != nil {
+		return errors.Wrap(err, "i.nftBalanceRepo.FindRandom")
+	}
+
+	for _, b := range balances {
+		var (
+			onChain int64
+			owner   common.Address
+		)
+
+		switch b.ContractType {
+		case eventindexer.ContractTypeERC721:
+			owner, err = i.erc721Owner(opts, b.ContractAddress, b.TokenID)
+			if err == nil && owner == common.HexToAddress(b.Address) {
+				onChain = 1
+			}
+		case eventindexer.ContractTypeERC1155:
+			onChain, err = i.erc1155Balance(opts, b.ContractAddress, b.Address, b.TokenID)
+		default:
+			continue
+		}
+
+		if err != nil {
+			slog.Warn("error getting nft balance", "contractAddress", b.ContractAddress, "tokenID", b.TokenID, "error", err)
+			continue
+		}
+
+		eventindexer.BalancesReconciled.Inc()
+
+		if onChain == b.Amount {
+			continue
+		}
+
+		slog.Warn("nft balance drifted",
+			"address", b.Address,
+			"contractAddress", b.ContractAddress,
+			"tokenID", b.TokenID,
+			"indexed", b.Amount,
+			"onChain", onChain,
+		)
+
+		eventindexer.NFTBalancesDrifted.Inc()
+
+		updateOpts := eventindexer.UpdateNFTBalanceOpts{
+			ChainID:         b.ChainID,
+			Address:         b.Address,
+			TokenID:         b.TokenID,
+			ContractAddress: b.ContractAddress,
+			ContractType:    b.ContractType,
+			Amount:          onChain,
+		}
+
+		if err := i.nftBalanceRepo.SetBalance(ctx, updateOpts); err != nil {
+			return errors.Wrap(err, "i.nftBalanceRepo.SetBalance")
+		}
+
+		// the ERC721 token is held by someone else, credit them with it instead.
+		if b.ContractType == eventindexer.ContractTypeERC721 && onChain == 0 && owner != ZeroAddress {
+			updateOpts.Address = strings.ToLower(owner.Hex())
+			updateOpts.Amount = 1
+
+			if err := i.nftBalanceRepo.SetBalance(ctx, updateOpts); err != nil {
+				return errors.Wrap(err, "i.nftBalanceRepo.SetBalance")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (i *Indexer) erc721Owner(opts *bind.CallOpts, contractAddress string, tokenID int64) (common.Address, error) {
+	parsedABI, err := getParsedERC721ABI()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var out []interface{}
+
+	contract := bind.NewBoundContract(common.HexToAddress(contractAddress), *parsedABI, i.ethClient, nil, nil)
+
+	if err := contract.Call(opts, &out, "ownerOf", big.NewInt(tokenID)); err != nil {
+		return common.Address{}, err
+	}
+
+	return *abi.ConvertType(out[0], new(common.Address)).(*common.Address), nil
+}
+
+func (i *Indexer) erc1155Balance(
+	opts *bind.CallOpts,
+	contractAddress string,
+	address string,
+	tokenID int64,
+) (int64, error) {
+	parsedABI, err := getParsedERC1155ABI()
+	if err != nil {
+		return 0, err
+	}
+
+	balance, err := i.callBigInt(
+		opts,
+		parsedABI,
+		contractAddress,
+		"balanceOf",
+		common.HexToAddress(address),
+		big.NewInt(tokenID),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return balance.Int64(), nil
+}
+
+// callBigInt calls a view method of the contract returning a uint256.
+func (i *Indexer) callBigInt(
+	opts *bind.CallOpts,
+	parsedABI *abi.ABI,
+	contractAddress string,
+	method string,
+	args ...interface{},
+) (*big.Int, error) {
+	var out []interface{}
+
+	contract := bind.NewBoundContract(common.HexToAddress(contractAddress), *parsedABI, i.ethClient, nil, nil)
+
+	if err := contract.Call(opts, &out, method, args...); err != nil {
+		return nil, err
+	}
+
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
@@ -0,0 +1,87 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// eventIterator is implemented by the iterators the bindings filter the logs of an event with.
+type eventIterator interface {
+	Next() bool
+	Error() error
+	Close() error
+}
+
+// saveEvents saves each event of the iterator with save, event returning the one the
+// iterator is at.
+func saveEvents[E any](
+	ctx context.Context,
+	chainID *big.Int,
+	events eventIterator,
+	event func() *E,
+	save func(ctx context.Context, chainID *big.Int, event *E) error,
+) error {
+	defer events.Close()
+
+	wg, ctx := errgroup.WithContext(ctx)
+
+	for events.Next() {
+		e := event()
+
+		wg.Go(func() error {
+			return save(ctx, chainID, e)
+		})
+	}
+
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+
+	return events.Error()
+}
+
+// saveEvent stores the event decoded from the raw log, opts holding the event specific
+// columns it is queried by.
+func (i *Indexer) saveEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	name string,
+	raw types.Log,
+	event interface{},
+	opts eventindexer.SaveEventOpts,
+) error {
+	marshaled, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal(event)")
+	}
+
+	header, err := i.ethClient.HeaderByNumber(ctx, new(big.Int).SetUint64(raw.BlockNumber))
+	if err != nil {
+		return errors.Wrap(err, "i.ethClient.HeaderByNumber")
+	}
+
+	contractAddress := raw.Address.Hex()
+
+	opts.Name = name
+	opts.Event = name
+	opts.Data = string(marshaled)
+	opts.ChainID = chainID
+	opts.ContractAddress = &contractAddress
+	opts.TransactedAt = time.Unix(int64(header.Time), 0)
+	opts.EmittedBlockID = raw.BlockNumber
+	opts.LogIndex = raw.Index
+
+	if _, err := i.eventRepo.Save(ctx, opts); err != nil {
+		return errors.Wrap(err, "i.eventRepo.Save")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/shasta/inbox"
+)
+
+// saveLivenessBondSettledEvent stores the settlement of the liveness bond of a proposal
+// proved after its proving window. The event does not include the proposal ID, which is
+// the first new proposal of the Proved event of the same transaction.
+func (i *Indexer) saveLivenessBondSettledEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *inbox.InboxLivenessBondSettled,
+) error {
+	proposalID, err := i.findProvedProposalID(ctx, event)
+	if err != nil {
+		eventindexer.LivenessBondSettledEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.findProvedProposalID")
+	}
+
+	// let Address = payer, the proposer who was the designated prover, To = payee, the
+	// actual prover, Amount = slashed, ProofReward = credited to the actual prover,
+	// BatchID = proposal ID
+	payee := event.Payee.Hex()
+
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameLivenessBondSettled, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address:     event.Payer.Hex(),
+			To:          &payee,
+			Amount:      new(big.Int).SetUint64(event.Slashed),
+			ProofReward: new(big.Int).SetUint64(event.Credited),
+			BatchID:     &proposalID,
+		},
+	); err != nil {
+		eventindexer.LivenessBondSettledEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.LivenessBondSettledEventsProcessed.Inc()
+
+	return nil
+}
+
+// findProvedProposalID returns the first new proposal of the Proved event emitted along
+// with the liveness bond settlement, the only one whose bond is settled.
+func (i *Indexer) findProvedProposalID(ctx context.Context, event *inbox.InboxLivenessBondSettled) (int64, error) {
+	receipt, err := i.ethClient.TransactionReceipt(ctx, event.Raw.TxHash)
+	if err != nil {
+		return 0, errors.Wrap(err, "i.ethClient.TransactionReceipt")
+	}
+
+	inboxABI, err := inbox.InboxMetaData.GetAbi()
+	if err != nil {
+		return 0, errors.Wrap(err, "inbox.InboxMetaData.GetAbi")
+	}
+
+	provedID := inboxABI.Events["Proved"].ID
+
+	for _, log := range receipt.Logs {
+		if log.Address != event.Raw.Address || len(log.Topics) == 0 || log.Topics[0] != provedID {
+			continue
+		}
+
+		proved, err := i.inbox.ParseProved(*log)
+		if err != nil {
+			return 0, errors.Wrap(err, "i.inbox.ParseProved")
+		}
+
+		return proved.FirstNewProposalId.Int64(), nil
+	}
+
+	return 0, errors.Errorf("no Proved event in transaction %s", event.Raw.TxHash.Hex())
+}
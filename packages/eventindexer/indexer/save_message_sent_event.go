@@ -75,6 +75,7 @@ func (i *Indexer) saveMessageSentEvent(
 		ChainID:        chainID,
 		Event:          eventindexer.EventNameMessageSent,
 		Address:        event.Message.From.Hex(),
+		Amount:         event.Message.Value,
 		TransactedAt:   time.Unix(int64(block.Time()), 0),
 		EmittedBlockID: event.Raw.BlockNumber,
 	})
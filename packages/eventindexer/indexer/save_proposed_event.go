@@ -8,6 +8,7 @@ import (
 
 	"log/slog"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/shasta/inbox"
@@ -66,11 +67,27 @@ func (i *Indexer) saveProposedEvent(
 	proposalId := event.Id.Int64()
 	proposer := event.Proposer.Hex()
 
+	var numBlobs int64
+	for _, source := range event.Sources {
+		numBlobs += int64(len(source.BlobSlice.BlobHashes))
+	}
+
 	block, err := i.ethClient.BlockByNumber(ctx, new(big.Int).SetUint64(event.Raw.BlockNumber))
 	if err != nil {
 		return errors.Wrap(err, "i.ethClient.BlockByNumber")
 	}
 
+	// the proving window the proposal was proposed with, an upgrade of the Inbox can change it.
+	config, err := i.inbox.GetConfig(&bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: new(big.Int).SetUint64(event.Raw.BlockNumber),
+	})
+	if err != nil {
+		return errors.Wrap(err, "i.inbox.GetConfig")
+	}
+
+	provingWindow := config.ProvingWindow.Int64()
+
 	_, err = i.eventRepo.Save(ctx, eventindexer.SaveEventOpts{
 		Name:    eventindexer.EventNameProposed,
 		Data:    string(marshaled),
@@ -82,6 +99,8 @@ func (i *Indexer) saveProposedEvent(
 		// EmittedBlockID = L1 Block ID
 		EmittedBlockID: event.Raw.BlockNumber,
 		BatchID:        &proposalId,
+		NumBlobs:       &numBlobs,
+		ProvingWindow:  &provingWindow,
 	})
 	if err != nil {
 		return errors.Wrap(err, "i.eventRepo.Save")
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/sgxverifier"
+)
+
+// filterSgxInstanceEvents indexes the registrations and deletions of the SGX instances of
+// the SgxVerifier.
+func (i *Indexer) filterSgxInstanceEvents(
+	ctx context.Context,
+	chainID *big.Int,
+	filterOpts *bind.FilterOpts,
+) error {
+	wg, ctx := errgroup.WithContext(ctx)
+
+	wg.Go(func() error {
+		events, err := i.sgxVerifier.FilterInstanceAdded(filterOpts, nil, nil, nil)
+		if err != nil {
+			return errors.Wrap(err, "i.sgxVerifier.FilterInstanceAdded")
+		}
+
+		return saveEvents(ctx, chainID, events, func() *sgxverifier.SgxVerifierInstanceAdded {
+			return events.Event
+		}, i.saveInstanceAddedEvent)
+	})
+
+	wg.Go(func() error {
+		events, err := i.sgxVerifier.FilterInstanceDeleted(filterOpts, nil, nil)
+		if err != nil {
+			return errors.Wrap(err, "i.sgxVerifier.FilterInstanceDeleted")
+		}
+
+		return saveEvents(ctx, chainID, events, func() *sgxverifier.SgxVerifierInstanceDeleted {
+			return events.Event
+		}, i.saveInstanceDeletedEvent)
+	})
+
+	return wg.Wait()
+}
+
+func (i *Indexer) saveInstanceAddedEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *sgxverifier.SgxVerifierInstanceAdded,
+) error {
+	// let Address = instance, TokenID = instance id, To = replaced instance
+	id := event.Id.Int64()
+	replaced := event.Replaced.Hex()
+
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameInstanceAdded, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address: event.Instance.Hex(),
+			TokenID: &id,
+			To:      &replaced,
+		},
+	); err != nil {
+		eventindexer.SgxInstanceEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.SgxInstanceEventsProcessed.Inc()
+
+	return nil
+}
+
+func (i *Indexer) saveInstanceDeletedEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *sgxverifier.SgxVerifierInstanceDeleted,
+) error {
+	// let Address = instance, TokenID = instance id
+	id := event.Id.Int64()
+
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameInstanceDeleted, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address: event.Instance.Hex(),
+			TokenID: &id,
+		},
+	); err != nil {
+		eventindexer.SgxInstanceEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.SgxInstanceEventsProcessed.Inc()
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/swap"
+)
+
+// filterSwapEvents indexes the swaps and the liquidity added to the swap pair.
+func (i *Indexer) filterSwapEvents(
+	ctx context.Context,
+	chainID *big.Int,
+	pair *swap.Swap,
+	filterOpts *bind.FilterOpts,
+) error {
+	wg, ctx := errgroup.WithContext(ctx)
+
+	wg.Go(func() error {
+		events, err := pair.FilterSwap(filterOpts, nil, nil)
+		if err != nil {
+			return errors.Wrap(err, "pair.FilterSwap")
+		}
+
+		return saveEvents(ctx, chainID, events, func() *swap.SwapSwap {
+			return events.Event
+		}, i.saveSwapEvent)
+	})
+
+	wg.Go(func() error {
+		events, err := pair.FilterMint(filterOpts, nil)
+		if err != nil {
+			return errors.Wrap(err, "pair.FilterMint")
+		}
+
+		return saveEvents(ctx, chainID, events, func() *swap.SwapMint {
+			return events.Event
+		}, i.saveMintEvent)
+	})
+
+	return wg.Wait()
+}
+
+func (i *Indexer) saveSwapEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *swap.SwapSwap,
+) error {
+	// let Address = sender
+	to := event.To.Hex()
+
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameSwap, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address: event.Sender.Hex(),
+			To:      &to,
+		},
+	); err != nil {
+		eventindexer.SwapEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.SwapEventsProcessed.Inc()
+
+	return nil
+}
+
+func (i *Indexer) saveMintEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *swap.SwapMint,
+) error {
+	// let Address = sender
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameMint, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address: event.Sender.Hex(),
+		},
+	); err != nil {
+		eventindexer.LiquidityAddedEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.LiquidityAddedEventsProcessed.Inc()
+
+	return nil
+}
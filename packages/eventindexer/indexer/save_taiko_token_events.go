@@ -0,0 +1,128 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/contracts/taikotoken"
+)
+
+// filterTaikoTokenEvents indexes the delegation and transfer events of the TaikoToken.
+func (i *Indexer) filterTaikoTokenEvents(
+	ctx context.Context,
+	chainID *big.Int,
+	filterOpts *bind.FilterOpts,
+) error {
+	wg, ctx := errgroup.WithContext(ctx)
+
+	wg.Go(func() error {
+		events, err := i.taikoToken.FilterDelegateChanged(filterOpts, nil, nil, nil)
+		if err != nil {
+			return errors.Wrap(err, "i.taikoToken.FilterDelegateChanged")
+		}
+
+		return saveEvents(ctx, chainID, events, func() *taikotoken.TaikoTokenDelegateChanged {
+			return events.Event
+		}, i.saveDelegateChangedEvent)
+	})
+
+	wg.Go(func() error {
+		events, err := i.taikoToken.FilterDelegateVotesChanged(filterOpts, nil)
+		if err != nil {
+			return errors.Wrap(err, "i.taikoToken.FilterDelegateVotesChanged")
+		}
+
+		return saveEvents(ctx, chainID, events, func() *taikotoken.TaikoTokenDelegateVotesChanged {
+			return events.Event
+		}, i.saveDelegateVotesChangedEvent)
+	})
+
+	wg.Go(func() error {
+		events, err := i.taikoToken.FilterTransfer(filterOpts, nil, nil)
+		if err != nil {
+			return errors.Wrap(err, "i.taikoToken.FilterTransfer")
+		}
+
+		return saveEvents(ctx, chainID, events, func() *taikotoken.TaikoTokenTransfer {
+			return events.Event
+		}, i.saveTaikoTokenTransferEvent)
+	})
+
+	return wg.Wait()
+}
+
+func (i *Indexer) saveDelegateChangedEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *taikotoken.TaikoTokenDelegateChanged,
+) error {
+	// let Address = delegator, To = toDelegate
+	toDelegate := event.ToDelegate.Hex()
+
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameDelegateChanged, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address: event.Delegator.Hex(),
+			To:      &toDelegate,
+		},
+	); err != nil {
+		eventindexer.TaikoTokenEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.TaikoTokenEventsProcessed.Inc()
+
+	return nil
+}
+
+func (i *Indexer) saveDelegateVotesChangedEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *taikotoken.TaikoTokenDelegateVotesChanged,
+) error {
+	// let Address = delegate, Amount = newBalance
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameDelegateVotesChanged, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address: event.Delegate.Hex(),
+			Amount:  event.NewBalance,
+		},
+	); err != nil {
+		eventindexer.TaikoTokenEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.TaikoTokenEventsProcessed.Inc()
+
+	return nil
+}
+
+func (i *Indexer) saveTaikoTokenTransferEvent(
+	ctx context.Context,
+	chainID *big.Int,
+	event *taikotoken.TaikoTokenTransfer,
+) error {
+	// let Address = from
+	to := event.To.Hex()
+
+	if err := i.saveEvent(ctx, chainID, eventindexer.EventNameTaikoTokenTransfer, event.Raw, event,
+		eventindexer.SaveEventOpts{
+			Address: event.From.Hex(),
+			To:      &to,
+			Amount:  event.Value,
+		},
+	); err != nil {
+		eventindexer.TaikoTokenEventsProcessedError.Inc()
+
+		return errors.Wrap(err, "i.saveEvent")
+	}
+
+	eventindexer.TaikoTokenEventsProcessed.Inc()
+
+	return nil
+}
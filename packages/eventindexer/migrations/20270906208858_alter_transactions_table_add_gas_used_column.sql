@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE transactions
+ADD COLUMN gas_used BIGINT UNSIGNED NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE transactions DROP COLUMN gas_used;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS balance_transfers (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    chain_id int NOT NULL,
+    block_id BIGINT UNSIGNED NOT NULL,
+    block_hash VARCHAR(66) NOT NULL,
+    tx_hash VARCHAR(66) NOT NULL,
+    log_index int NOT NULL,
+    batch_index int NOT NULL DEFAULT 0,
+    contract_address VARCHAR(42) NOT NULL,
+    contract_type VARCHAR(7) NOT NULL,
+    token_id DECIMAL(65, 0) DEFAULT NULL,
+    from_address VARCHAR(42) NOT NULL DEFAULT "",
+    to_address VARCHAR(42) NOT NULL DEFAULT "",
+    amount VARCHAR(200) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY `balance_transfers_log_index` (`chain_id`, `block_hash`, `log_index`, `batch_index`),
+    INDEX `balance_transfers_chain_id_block_id_index` (`chain_id`, `block_id`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE balance_transfers;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+ADD COLUMN log_index INT UNSIGNED NOT NULL DEFAULT 0;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN log_index;
+-- +goose StatementEnd
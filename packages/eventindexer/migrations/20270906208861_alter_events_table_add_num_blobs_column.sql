@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+ADD COLUMN num_blobs int DEFAULT NULL;
+
+-- the Inbox Proposed events stored their number of blobs as their number of blocks.
+UPDATE events SET num_blobs = num_blocks, num_blocks = NULL
+WHERE event = 'Proposed' AND num_blocks IS NOT NULL AND JSON_CONTAINS_PATH(data, 'one', '$.Sources');
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+UPDATE events SET num_blocks = num_blobs WHERE event = 'Proposed' AND num_blobs IS NOT NULL;
+
+ALTER TABLE events DROP COLUMN num_blobs;
+-- +goose StatementEnd
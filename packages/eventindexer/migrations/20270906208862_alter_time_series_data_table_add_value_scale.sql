@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the averages, such as the proving latency and the blobs per proposal, have a fractional part.
+ALTER TABLE time_series_data
+MODIFY COLUMN value DECIMAL(65, 18) NOT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE time_series_data
+MODIFY COLUMN value DECIMAL(65, 0) NOT NULL;
+-- +goose StatementEnd
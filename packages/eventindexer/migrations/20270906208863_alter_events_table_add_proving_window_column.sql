@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the proving window of the Inbox, in seconds, when a proposal was proposed.
+ALTER TABLE events
+ADD COLUMN proving_window int unsigned DEFAULT NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN proving_window;
+-- +goose StatementEnd
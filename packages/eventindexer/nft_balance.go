@@ -28,16 +28,31 @@ type UpdateNFTBalanceOpts struct {
 	Amount          int64
 }
 
+// FindNFTBalancesOpts filters the non-zero balances of an address, an empty field matching
+// any value.
+type FindNFTBalancesOpts struct {
+	Address         string
+	ChainID         int64
+	ContractAddress string
+	Offset          int
+	Limit           int
+}
+
 // NFTBalanceRepository is used to interact with nft balances in the store
 type NFTBalanceRepository interface {
 	IncreaseAndDecreaseBalancesInTx(
 		ctx context.Context,
 		increaseOpts UpdateNFTBalanceOpts,
 		decreaseOpts UpdateNFTBalanceOpts,
+		transferOpts BalanceTransferOpts,
 	) (increasedBalance *NFTBalance, decreasedBalance *NFTBalance, err error)
+	RollbackAllAfterBlockID(ctx context.Context, blockID uint64, chainID int64) error
+	FindRandom(ctx context.Context, chainID int64, limit int) ([]*NFTBalance, error)
+	SetBalance(ctx context.Context, opts UpdateNFTBalanceOpts) error
 	FindByAddress(ctx context.Context,
 		req *http.Request,
 		address string,
 		chainID string,
 	) (paginate.Page, error)
+	Find(ctx context.Context, opts FindNFTBalancesOpts) ([]*NFTBalance, error)
 }
@@ -0,0 +1,143 @@
+package graphql
+
+import (
+	"context"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// accountResolver resolves the records of an address, which does not need to have sent a
+// transaction.
+type accountResolver struct {
+	address string
+	r       *resolver
+}
+
+func (a *accountResolver) Address() string {
+	return a.address
+}
+
+func (a *accountResolver) FirstTransactedAt(ctx context.Context) (*graphqlgo.Time, error) {
+	if err := charge(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	account, err := a.r.accountRepo.FindByAddress(ctx, a.address)
+	if err != nil {
+		return nil, err
+	}
+
+	if account == nil {
+		return nil, nil
+	}
+
+	return &graphqlgo.Time{Time: account.TransactedAt}, nil
+}
+
+func (a *accountResolver) Events(ctx context.Context, args struct {
+	Event *string
+	First int32
+	Skip  int32
+}) ([]*eventResolver, error) {
+	offset, limit, err := pageOpts(ctx, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := eventindexer.FindEventsOpts{
+		Address: a.address,
+		Offset:  offset,
+		Limit:   limit,
+	}
+
+	if args.Event != nil {
+		opts.Event = *args.Event
+	}
+
+	return a.r.findEvents(ctx, opts)
+}
+
+func (a *accountResolver) NFTBalances(ctx context.Context, args struct {
+	ChainID *int32
+	First   int32
+	Skip    int32
+}) ([]*nftBalanceResolver, error) {
+	offset, limit, err := pageOpts(ctx, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := a.r.nftBalanceRepo.Find(ctx, eventindexer.FindNFTBalancesOpts{
+		Address: a.address,
+		ChainID: chainIDOrAny(args.ChainID),
+		Offset:  offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*nftBalanceResolver, 0, len(balances))
+	for _, b := range balances {
+		resolvers = append(resolvers, &nftBalanceResolver{b: b})
+	}
+
+	return resolvers, nil
+}
+
+func (a *accountResolver) ERC20Balances(ctx context.Context, args struct {
+	ChainID *int32
+	First   int32
+	Skip    int32
+}) ([]*erc20BalanceResolver, error) {
+	offset, limit, err := pageOpts(ctx, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	balances, err := a.r.erc20BalanceRepo.Find(ctx, eventindexer.FindERC20BalancesOpts{
+		Address: a.address,
+		ChainID: chainIDOrAny(args.ChainID),
+		Offset:  offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*erc20BalanceResolver, 0, len(balances))
+	for _, b := range balances {
+		resolvers = append(resolvers, &erc20BalanceResolver{b: b})
+	}
+
+	return resolvers, nil
+}
+
+func (a *accountResolver) Transactions(ctx context.Context, args struct {
+	ChainID *int32
+	First   int32
+	Skip    int32
+}) ([]*transactionResolver, error) {
+	offset, limit, err := pageOpts(ctx, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.r.findTransactions(ctx, eventindexer.FindTransactionsOpts{
+		Sender:  a.address,
+		ChainID: chainIDOrAny(args.ChainID),
+		Offset:  offset,
+		Limit:   limit,
+	})
+}
+
+// chainIDOrAny returns the chain ID argument, 0 matching any chain when it is omitted.
+func chainIDOrAny(chainID *int32) int64 {
+	if chainID == nil {
+		return 0
+	}
+
+	return int64(*chainID)
+}
@@ -0,0 +1,71 @@
+package graphql
+
+import (
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type nftBalanceResolver struct {
+	b *eventindexer.NFTBalance
+}
+
+func (n *nftBalanceResolver) ChainID() Long {
+	return Long(n.b.ChainID)
+}
+
+func (n *nftBalanceResolver) Address() string {
+	return n.b.Address
+}
+
+func (n *nftBalanceResolver) ContractAddress() string {
+	return n.b.ContractAddress
+}
+
+func (n *nftBalanceResolver) ContractType() string {
+	return n.b.ContractType
+}
+
+func (n *nftBalanceResolver) TokenID() Long {
+	return Long(n.b.TokenID)
+}
+
+func (n *nftBalanceResolver) Amount() Long {
+	return Long(n.b.Amount)
+}
+
+type erc20BalanceResolver struct {
+	b *eventindexer.ERC20Balance
+}
+
+func (e *erc20BalanceResolver) ChainID() Long {
+	return Long(e.b.ChainID)
+}
+
+func (e *erc20BalanceResolver) Address() string {
+	return e.b.Address
+}
+
+func (e *erc20BalanceResolver) ContractAddress() string {
+	return e.b.ContractAddress
+}
+
+func (e *erc20BalanceResolver) Amount() string {
+	return e.b.Amount
+}
+
+func (e *erc20BalanceResolver) Symbol() *string {
+	if e.b.Metadata == nil {
+		return nil
+	}
+
+	return &e.b.Metadata.Symbol
+}
+
+func (e *erc20BalanceResolver) Decimals() *int32 {
+	if e.b.Metadata == nil {
+		return nil
+	}
+
+	decimals := int32(e.b.Metadata.Decimals)
+
+	return &decimals
+}
@@ -0,0 +1,37 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+type budgetKey struct{}
+
+// budget is the number of records a query can still load. It is shared by the resolvers of
+// the query, so a nested list costs its page size for each record of its parent.
+type budget struct {
+	max       int
+	remaining atomic.Int64
+}
+
+func withBudget(ctx context.Context, maxRecords int) context.Context {
+	b := &budget{max: maxRecords}
+	b.remaining.Store(int64(maxRecords))
+
+	return context.WithValue(ctx, budgetKey{}, b)
+}
+
+// charge takes the records from the budget of the query, failing once it is exhausted.
+func charge(ctx context.Context, records int) error {
+	b, ok := ctx.Value(budgetKey{}).(*budget)
+	if !ok {
+		return nil
+	}
+
+	if b.remaining.Add(-int64(records)) < 0 {
+		return fmt.Errorf("query exceeds the complexity limit of %d records", b.max)
+	}
+
+	return nil
+}
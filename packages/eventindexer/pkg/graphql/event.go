@@ -0,0 +1,131 @@
+package graphql
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/shopspring/decimal"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type eventResolver struct {
+	e *eventindexer.Event
+	r *resolver
+}
+
+func (e *eventResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(strconv.Itoa(e.e.ID))
+}
+
+func (e *eventResolver) Name() string {
+	return e.e.Name
+}
+
+func (e *eventResolver) Event() string {
+	return e.e.Event
+}
+
+func (e *eventResolver) ChainID() Long {
+	return Long(e.e.ChainID)
+}
+
+func (e *eventResolver) Address() string {
+	return e.e.Address
+}
+
+func (e *eventResolver) BlockID() *Long {
+	return nullLong(e.e.BlockID)
+}
+
+func (e *eventResolver) BatchID() *Long {
+	return nullLong(e.e.BatchID)
+}
+
+func (e *eventResolver) Amount() *string {
+	return nullDecimal(e.e.Amount)
+}
+
+func (e *eventResolver) To() string {
+	return e.e.To
+}
+
+func (e *eventResolver) TokenID() *Long {
+	return nullLong(e.e.TokenID)
+}
+
+func (e *eventResolver) ContractAddress() string {
+	return e.e.ContractAddress
+}
+
+func (e *eventResolver) Tier() *int32 {
+	if !e.e.Tier.Valid {
+		return nil
+	}
+
+	tier := int32(e.e.Tier.Int16)
+
+	return &tier
+}
+
+func (e *eventResolver) EmittedBlockID() Long {
+	return Long(e.e.EmittedBlockID)
+}
+
+func (e *eventResolver) TransactedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: e.e.TransactedAt}
+}
+
+func (e *eventResolver) Data() string {
+	return string(e.e.Data)
+}
+
+func (e *eventResolver) Account() *accountResolver {
+	return &accountResolver{address: e.e.Address, r: e.r}
+}
+
+func (e *eventResolver) Proposal(ctx context.Context) (*eventResolver, error) {
+	if e.e.Event != eventindexer.EventNameProved || !e.e.BatchID.Valid {
+		return nil, nil
+	}
+
+	if err := charge(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	return e.r.eventOrNil(e.r.eventRepo.GetProposalProposedBy(ctx, int(e.e.BatchID.Int64)))
+}
+
+func (e *eventResolver) Proof(ctx context.Context) (*eventResolver, error) {
+	if e.e.Event != eventindexer.EventNameProposed || !e.e.BatchID.Valid {
+		return nil, nil
+	}
+
+	if err := charge(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	return e.r.eventOrNil(e.r.eventRepo.GetProposalProvedBy(ctx, int(e.e.BatchID.Int64)))
+}
+
+func nullLong(v sql.NullInt64) *Long {
+	if !v.Valid {
+		return nil
+	}
+
+	l := Long(v.Int64)
+
+	return &l
+}
+
+func nullDecimal(v decimal.NullDecimal) *string {
+	if !v.Valid {
+		return nil
+	}
+
+	s := v.Decimal.String()
+
+	return &s
+}
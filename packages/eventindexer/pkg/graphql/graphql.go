@@ -0,0 +1,122 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+//go:embed schema.graphql
+var schemaString string
+
+var (
+	// maxPageSize bounds the first argument of the list fields.
+	maxPageSize int32 = 100
+	// maxSkip bounds the skip argument of the list fields, as the database still reads the
+	// skipped records.
+	maxSkip int32 = 10000
+	// maxParallelism bounds the resolvers of a query running concurrently, each of them
+	// using a database connection.
+	maxParallelism = 10
+
+	// the limits of a schema whose options leave them unset.
+	defaultMaxDepth       = 8
+	defaultMaxComplexity  = 1000
+	defaultMaxQueryLength = 10000
+)
+
+// Request is a GraphQL query, with its operation name and variables.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Schema executes the queries of the GraphQL API over the repositories, within the limits
+// which keep the queries of the public API from being too expensive.
+type Schema struct {
+	schema *graphqlgo.Schema
+
+	maxComplexity int
+}
+
+type NewSchemaOpts struct {
+	EventRepo        eventindexer.EventRepository
+	NFTBalanceRepo   eventindexer.NFTBalanceRepository
+	ERC20BalanceRepo eventindexer.ERC20BalanceRepository
+	TxRepo           eventindexer.TransactionRepository
+	AccountRepo      eventindexer.AccountRepository
+	ChartRepo        eventindexer.ChartRepository
+	// MaxDepth is the maximum nesting depth of the fields of a query.
+	MaxDepth int
+	// MaxComplexity is the maximum number of records a query can load, the list fields
+	// counting for their page size, and the others for a record each.
+	MaxComplexity int
+	// MaxQueryLength is the maximum length of a query, in bytes.
+	MaxQueryLength int
+}
+
+func NewSchema(opts NewSchemaOpts) (*Schema, error) {
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = defaultMaxDepth
+	}
+
+	if opts.MaxComplexity <= 0 {
+		opts.MaxComplexity = defaultMaxComplexity
+	}
+
+	if opts.MaxQueryLength <= 0 {
+		opts.MaxQueryLength = defaultMaxQueryLength
+	}
+
+	schema, err := graphqlgo.ParseSchema(
+		schemaString,
+		&resolver{
+			eventRepo:        opts.EventRepo,
+			nftBalanceRepo:   opts.NFTBalanceRepo,
+			erc20BalanceRepo: opts.ERC20BalanceRepo,
+			txRepo:           opts.TxRepo,
+			accountRepo:      opts.AccountRepo,
+			chartRepo:        opts.ChartRepo,
+		},
+		graphqlgo.MaxDepth(opts.MaxDepth),
+		graphqlgo.MaxParallelism(maxParallelism),
+		graphqlgo.MaxQueryLength(opts.MaxQueryLength),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Schema{
+		schema:        schema,
+		maxComplexity: opts.MaxComplexity,
+	}, nil
+}
+
+// Exec executes the query, failing it once it loaded more records than the complexity
+// limit allows.
+func (s *Schema) Exec(ctx context.Context, req Request) *graphqlgo.Response {
+	return s.schema.Exec(withBudget(ctx, s.maxComplexity), req.Query, req.OperationName, req.Variables)
+}
+
+// pageOpts validates the pagination arguments of a list field, and charges the records the
+// page can hold to the budget of the query, before they are loaded.
+func pageOpts(ctx context.Context, first int32, skip int32) (offset int, limit int, err error) {
+	if first < 1 || first > maxPageSize {
+		return 0, 0, fmt.Errorf("first must be between 1 and %d", maxPageSize)
+	}
+
+	if skip < 0 || skip > maxSkip {
+		return 0, 0, fmt.Errorf("skip must be between 0 and %d", maxSkip)
+	}
+
+	if err := charge(ctx, int(first)); err != nil {
+		return 0, 0, err
+	}
+
+	return int(skip), int(first), nil
+}
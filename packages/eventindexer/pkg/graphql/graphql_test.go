@@ -0,0 +1,236 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/mock"
+)
+
+// chartRepository returns a chart of a date per day of its range, and counts the charts found.
+type chartRepository struct {
+	finds int
+}
+
+func (r *chartRepository) Find(
+	ctx context.Context,
+	task string,
+	start string,
+	end string,
+	feeTokenAddress string,
+	tier string,
+) (*eventindexer.ChartResponse, error) {
+	r.finds++
+
+	chart := &eventindexer.ChartResponse{Chart: make([]eventindexer.ChartItem, 0)}
+
+	from, _ := time.Parse("2006-01-02", start)
+	to, _ := time.Parse("2006-01-02", end)
+
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		chart.Chart = append(chart.Chart, eventindexer.ChartItem{Date: d.Format("2006-01-02"), Value: "1"})
+	}
+
+	return chart, nil
+}
+
+func newTestSchema(t *testing.T) *Schema {
+	schema, _ := newTestSchemaWithChartRepo(t)
+
+	return schema
+}
+
+func newTestSchemaWithChartRepo(t *testing.T) (*Schema, *chartRepository) {
+	eventRepo := mock.NewEventRepository()
+
+	for _, opts := range []eventindexer.SaveEventOpts{
+		{Name: "proposed", Event: eventindexer.EventNameProposed, Address: "0x1", BatchID: int64Ptr(1)},
+		{Name: "proved", Event: eventindexer.EventNameProved, Address: "0x2", BatchID: int64Ptr(1)},
+		{Name: "proposed", Event: eventindexer.EventNameProposed, Address: "0x1", BatchID: int64Ptr(2)},
+	} {
+		opts.ChainID = big.NewInt(167000)
+		opts.Data = "{}"
+
+		_, err := eventRepo.Save(context.Background(), opts)
+		assert.Nil(t, err)
+	}
+
+	chartRepo := &chartRepository{}
+
+	schema, err := NewSchema(NewSchemaOpts{
+		EventRepo:        eventRepo,
+		NFTBalanceRepo:   mock.NewNFTBalanceRepository(),
+		ERC20BalanceRepo: mock.NewERC20BalanceRepository(),
+		TxRepo:           mock.NewTransactionRepository(),
+		AccountRepo:      mock.NewAccountRepository(),
+		ChartRepo:        chartRepo,
+		MaxDepth:         5,
+		MaxComplexity:    50,
+		MaxQueryLength:   300,
+	})
+	assert.Nil(t, err)
+
+	return schema, chartRepo
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func Test_Exec(t *testing.T) {
+	schema := newTestSchema(t)
+
+	tests := []struct {
+		name      string
+		req       Request
+		wantData  string
+		wantError string
+	}{
+		{
+			"proposalWithProof",
+			Request{Query: `{ proposal(proposalID: 1) { address proof { address event } } }`},
+			`{"proposal":{"address":"0x1","proof":{"address":"0x2","event":"Proved"}}}`,
+			"",
+		},
+		{
+			"proposalNotFound",
+			Request{Query: `{ proposal(proposalID: 3) { address } }`},
+			`{"proposal":null}`,
+			"",
+		},
+		{
+			"variables",
+			Request{
+				Query:     `query ($id: Int!) { proposal(proposalID: $id) { batchID proof { batchID } } }`,
+				Variables: map[string]interface{}{"id": float64(2)},
+			},
+			`{"proposal":{"batchID":2,"proof":null}}`,
+			"",
+		},
+		{
+			"eventsFilteredAndPaginated",
+			Request{Query: `{ events(filter: {address: "0x1"}, first: 1, skip: 1) { batchID } }`},
+			`{"events":[{"batchID":1}]}`,
+			"",
+		},
+		{
+			"nestedAccountEvents",
+			Request{Query: `{ events(filter: {batchID: 1}, first: 1) { account { events(first: 5) { batchID } } } }`},
+			`{"events":[{"account":{"events":[{"batchID":1}]}}]}`,
+			"",
+		},
+		{
+			"pageTooLarge",
+			Request{Query: `{ events(first: 101) { id } }`},
+			"",
+			"first must be between 1 and 100",
+		},
+		{
+			"skipTooLarge",
+			Request{Query: `{ events(first: 1, skip: 10001) { id } }`},
+			"",
+			"skip must be between 0 and 10000",
+		},
+		{
+			"chart",
+			Request{Query: `{ chart(task: "proofs-per-day", start: "2024-05-27", end: "2024-05-28") { date value } }`},
+			`{"chart":[{"date":"2024-05-27","value":"1"},{"date":"2024-05-28","value":"1"}]}`,
+			"",
+		},
+		{
+			"chartInvalidDate",
+			Request{Query: `{ chart(task: "proofs-per-day", start: "27-05-2024", end: "2024-05-28") { date } }`},
+			"",
+			"invalid date",
+		},
+		{
+			"complexityExceeded",
+			Request{Query: `{ events(first: 10) { account { events(first: 20) { id } } } }`},
+			"",
+			"query exceeds the complexity limit of 50 records",
+		},
+		{
+			"depthExceeded",
+			Request{Query: `{ events { account { events { account { events { account { address } } } } } } }`},
+			"",
+			"exceeds max depth 5",
+		},
+		{
+			"queryTooLong",
+			Request{Query: "{ events { id " + strings.Repeat(" ", 300) + "} }"},
+			"",
+			"exceeds the maximum allowed query length of 300 bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := schema.Exec(context.Background(), tt.req)
+
+			if tt.wantError != "" {
+				assert.NotEmpty(t, resp.Errors)
+				assert.Contains(t, resp.Errors[0].Message, tt.wantError)
+
+				return
+			}
+
+			assert.Empty(t, resp.Errors)
+
+			data, err := json.Marshal(resp.Data)
+			assert.Nil(t, err)
+			assert.JSONEq(t, tt.wantData, string(data))
+		})
+	}
+}
+
+func Test_Exec_ChartRangeChargedBeforeLoading(t *testing.T) {
+	schema, chartRepo := newTestSchemaWithChartRepo(t)
+
+	// 51 days exceed the complexity limit of 50 records.
+	resp := schema.Exec(context.Background(), Request{
+		Query: `{ chart(task: "proofs-per-day", start: "2024-05-01", end: "2024-06-20") { date } }`,
+	})
+
+	assert.NotEmpty(t, resp.Errors)
+	assert.Contains(t, resp.Errors[0].Message, "query exceeds the complexity limit of 50 records")
+	assert.Equal(t, 0, chartRepo.finds)
+}
+
+func Test_chartDates(t *testing.T) {
+	tests := []struct {
+		name    string
+		task    string
+		start   string
+		end     string
+		want    int
+		wantErr bool
+	}{
+		{"days", "proofs-per-day", "2024-05-27", "2024-05-29", 3, false},
+		{"sameDay", "proofs-per-day", "2024-05-27", "2024-05-27", 1, false},
+		{"hours", "proofs-per-hour", "2024-05-27 22:00", "2024-05-28 01:00", 4, false},
+		{"hoursOfDays", "proofs-per-hour", "2024-05-27", "2024-05-28", 25, false},
+		{"endBeforeStart", "proofs-per-day", "2024-05-29", "2024-05-27", 0, false},
+		{"invalidStart", "proofs-per-day", "2024/05/27", "2024-05-29", 0, true},
+		{"invalidEnd", "proofs-per-day", "2024-05-27", "tomorrow", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := chartDates(tt.task, tt.start, tt.end)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
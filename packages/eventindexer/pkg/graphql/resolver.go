@@ -0,0 +1,245 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// resolver resolves the fields of Query.
+type resolver struct {
+	eventRepo        eventindexer.EventRepository
+	nftBalanceRepo   eventindexer.NFTBalanceRepository
+	erc20BalanceRepo eventindexer.ERC20BalanceRepository
+	txRepo           eventindexer.TransactionRepository
+	accountRepo      eventindexer.AccountRepository
+	chartRepo        eventindexer.ChartRepository
+}
+
+type eventFilter struct {
+	Event   *string
+	Address *string
+	ChainID *int32
+	BatchID *int32
+}
+
+func (r *resolver) Events(ctx context.Context, args struct {
+	Filter *eventFilter
+	First  int32
+	Skip   int32
+}) ([]*eventResolver, error) {
+	offset, limit, err := pageOpts(ctx, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := eventindexer.FindEventsOpts{
+		Offset: offset,
+		Limit:  limit,
+	}
+
+	if f := args.Filter; f != nil {
+		if f.Event != nil {
+			opts.Event = *f.Event
+		}
+
+		if f.Address != nil {
+			opts.Address = *f.Address
+		}
+
+		if f.ChainID != nil {
+			opts.ChainID = int64(*f.ChainID)
+		}
+
+		if f.BatchID != nil {
+			batchID := int64(*f.BatchID)
+			opts.BatchID = &batchID
+		}
+	}
+
+	return r.findEvents(ctx, opts)
+}
+
+func (r *resolver) Proposal(ctx context.Context, args struct {
+	ProposalID int32
+}) (*eventResolver, error) {
+	if err := charge(ctx, 1); err != nil {
+		return nil, err
+	}
+
+	return r.eventOrNil(r.eventRepo.GetProposalProposedBy(ctx, int(args.ProposalID)))
+}
+
+func (r *resolver) Account(args struct {
+	Address string
+}) *accountResolver {
+	return &accountResolver{address: args.Address, r: r}
+}
+
+func (r *resolver) Transactions(ctx context.Context, args struct {
+	Sender  *string
+	ChainID *int32
+	First   int32
+	Skip    int32
+}) ([]*transactionResolver, error) {
+	offset, limit, err := pageOpts(ctx, args.First, args.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := eventindexer.FindTransactionsOpts{
+		Offset: offset,
+		Limit:  limit,
+	}
+
+	if args.Sender != nil {
+		opts.Sender = *args.Sender
+	}
+
+	if args.ChainID != nil {
+		opts.ChainID = int64(*args.ChainID)
+	}
+
+	return r.findTransactions(ctx, opts)
+}
+
+func (r *resolver) Chart(ctx context.Context, args struct {
+	Task            string
+	Start           string
+	End             string
+	FeeTokenAddress *string
+	Tier            *string
+}) ([]*chartItemResolver, error) {
+	var feeTokenAddress, tier string
+
+	if args.FeeTokenAddress != nil {
+		feeTokenAddress = *args.FeeTokenAddress
+	}
+
+	if args.Tier != nil {
+		tier = *args.Tier
+	}
+
+	// the dates of a chart are not paginated, the dates of its range are charged instead.
+	dates, err := chartDates(args.Task, args.Start, args.End)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := charge(ctx, dates); err != nil {
+		return nil, err
+	}
+
+	chart, err := r.chartRepo.Find(ctx, args.Task, args.Start, args.End, feeTokenAddress, tier)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*chartItemResolver, 0, len(chart.Chart))
+	for _, item := range chart.Chart {
+		items = append(items, &chartItemResolver{item: item})
+	}
+
+	return items, nil
+}
+
+// chartDateLayouts are the layouts of the dates of the daily and the hourly time series data.
+var chartDateLayouts = []string{"2006-01-02", "2006-01-02 15:04"}
+
+// chartDates returns the number of dates a chart of the task can hold between start and end,
+// the dates of the hourly tasks being hours.
+func chartDates(task string, start string, end string) (int, error) {
+	from, err := parseChartDate(start)
+	if err != nil {
+		return 0, err
+	}
+
+	to, err := parseChartDate(end)
+	if err != nil {
+		return 0, err
+	}
+
+	if to.Before(from) {
+		return 0, nil
+	}
+
+	step := 24 * time.Hour
+	if strings.HasSuffix(task, "-per-hour") {
+		step = time.Hour
+	}
+
+	return int(to.Sub(from)/step) + 1, nil
+}
+
+func parseChartDate(date string) (time.Time, error) {
+	for _, layout := range chartDateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or YYYY-MM-DD HH:MM", date)
+}
+
+func (r *resolver) findEvents(ctx context.Context, opts eventindexer.FindEventsOpts) ([]*eventResolver, error) {
+	events, err := r.eventRepo.Find(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*eventResolver, 0, len(events))
+	for _, e := range events {
+		resolvers = append(resolvers, &eventResolver{e: e, r: r})
+	}
+
+	return resolvers, nil
+}
+
+// eventOrNil resolves the event of a lookup by proposal ID, the event not being found
+// resolving to null.
+func (r *resolver) eventOrNil(e *eventindexer.Event, err error) (*eventResolver, error) {
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &eventResolver{e: e, r: r}, nil
+}
+
+func (r *resolver) findTransactions(
+	ctx context.Context,
+	opts eventindexer.FindTransactionsOpts,
+) ([]*transactionResolver, error) {
+	txs, err := r.txRepo.Find(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*transactionResolver, 0, len(txs))
+	for _, tx := range txs {
+		resolvers = append(resolvers, &transactionResolver{tx: tx, r: r})
+	}
+
+	return resolvers, nil
+}
+
+type chartItemResolver struct {
+	item eventindexer.ChartItem
+}
+
+func (c *chartItemResolver) Date() string {
+	return c.item.Date
+}
+
+func (c *chartItemResolver) Value() string {
+	return c.item.Value
+}
@@ -0,0 +1,38 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Long is the Long scalar of the schema, for the IDs and amounts which do not fit in the
+// 32 bits of Int.
+type Long int64
+
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case int32:
+		*l = Long(input)
+	case float64:
+		*l = Long(input)
+	case string:
+		v, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return err
+		}
+
+		*l = Long(v)
+	default:
+		return fmt.Errorf("wrong type for Long: %T", input)
+	}
+
+	return nil
+}
+
+func (l Long) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(l), 10), nil
+}
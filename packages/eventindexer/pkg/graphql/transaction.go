@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"strconv"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type transactionResolver struct {
+	tx *eventindexer.Transaction
+	r  *resolver
+}
+
+func (t *transactionResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(strconv.Itoa(t.tx.ID))
+}
+
+func (t *transactionResolver) ChainID() Long {
+	return Long(t.tx.ChainID)
+}
+
+func (t *transactionResolver) Sender() string {
+	return t.tx.Sender
+}
+
+func (t *transactionResolver) Recipient() string {
+	return t.tx.Recipient
+}
+
+func (t *transactionResolver) BlockID() Long {
+	return Long(t.tx.BlockID)
+}
+
+func (t *transactionResolver) Amount() *string {
+	return nullDecimal(t.tx.Amount)
+}
+
+func (t *transactionResolver) GasPrice() string {
+	return t.tx.GasPrice
+}
+
+func (t *transactionResolver) GasUsed() Long {
+	return Long(t.tx.GasUsed)
+}
+
+func (t *transactionResolver) TransactedAt() graphqlgo.Time {
+	return graphqlgo.Time{Time: t.tx.TransactedAt}
+}
+
+func (t *transactionResolver) ContractAddress() string {
+	return t.tx.ContractAddress
+}
+
+func (t *transactionResolver) Account() *accountResolver {
+	return &accountResolver{address: t.tx.Sender, r: t.r}
+}
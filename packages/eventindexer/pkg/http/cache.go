@@ -7,4 +7,7 @@ var (
 	CacheKeyPOSStats          = "pos-stats"
 	CacheKeyCurrentProvers    = "current-provers"
 	CacheKeyTotalTransactions = "total-transactions"
+	CacheKeySgxInstances      = "sgx-instances"
+	CacheKeyTopDelegates      = "top-delegates"
+	CacheKeyProverStats       = "prover-stats"
 )
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+)
+
+// GetProposalAnalytics
+//
+//	 returns the proposals with their proving latency, prover and liveness bond outcome
+//
+//			@Summary		Get proposal analytics
+//			@ID			   	get-proposal-analytics
+//		    @Param			proposer	query		string		false	"proposer to query, all of them by default"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} paginate.Page
+//			@Router			/proposalAnalytics [get]
+func (srv *Server) GetProposalAnalytics(c echo.Context) error {
+	page, err := srv.eventRepo.GetProposalAnalytics(
+		c.Request().Context(),
+		c.Request(),
+		c.QueryParam("proposer"),
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
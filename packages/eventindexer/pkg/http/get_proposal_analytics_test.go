@@ -0,0 +1,78 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+func Test_GetProposalAnalytics(t *testing.T) {
+	srv := newTestServer()
+
+	proposedAt := time.Now()
+	provingWindow := int64(30)
+
+	for _, opts := range []eventindexer.SaveEventOpts{
+		{Event: eventindexer.EventNameProposed, Address: "0x123", TransactedAt: proposedAt, ProvingWindow: &provingWindow},
+		{Event: eventindexer.EventNameProved, Address: "0x456", TransactedAt: proposedAt.Add(time.Minute)},
+	} {
+		proposalID := int64(1)
+
+		opts.Name = opts.Event
+		opts.Data = "{}"
+		opts.ChainID = big.NewInt(167001)
+		opts.BatchID = &proposalID
+
+		_, err := srv.eventRepo.Save(context.Background(), opts)
+		assert.Equal(t, nil, err)
+	}
+
+	tests := []struct {
+		name                  string
+		query                 string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"success",
+			"",
+			http.StatusOK,
+			[]string{
+				`"proposalID":1,"proposer":"0x123"`,
+				`"prover":"0x456"`,
+				`"provingLatency":60,"provedByProposer":false`,
+				`"provingWindow":30,"missedProvingWindow":true,"livenessBondSettled":false`,
+			},
+		},
+		{
+			"successOtherProposer",
+			"?proposer=0x456",
+			http.StatusOK,
+			[]string{`"items":\[\]`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				"/proposalAnalytics"+tt.query,
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
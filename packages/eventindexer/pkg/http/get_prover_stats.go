@@ -0,0 +1,36 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+	"github.com/patrickmn/go-cache"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+// GetProverStats
+//
+//	 returns, for each proposer, how the proposals they are the designated prover of were proved
+//
+//			@Summary		Get prover stats
+//			@ID			   	get-prover-stats
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} []eventindexer.ProverStatsResponse
+//			@Router			/proverStats [get]
+func (srv *Server) GetProverStats(c echo.Context) error {
+	cached, found := srv.cache.Get(CacheKeyProverStats)
+	if found {
+		return c.JSON(http.StatusOK, cached.([]eventindexer.ProverStatsResponse))
+	}
+
+	stats, err := srv.eventRepo.FindProverStats(c.Request().Context())
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	srv.cache.Set(CacheKeyProverStats, stats, cache.DefaultExpiration)
+
+	return c.JSON(http.StatusOK, stats)
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+)
+
+// GetSgxInstanceHistory
+//
+//	 returns the registration and deletion events of an SGX instance ID
+//
+//			@Summary		Get SGX instance history
+//			@ID			   	get-sgx-instance-history
+//		    @Param			instanceID	query		string		true	"SGX instance ID to query"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} paginate.Page
+//			@Router			/sgxInstanceHistory [get]
+func (srv *Server) GetSgxInstanceHistory(c echo.Context) error {
+	instanceID, err := strconv.ParseInt(c.QueryParam("instanceID"), 10, 64)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	page, err := srv.eventRepo.GetSgxInstanceHistory(
+		c.Request().Context(),
+		c.Request(),
+		instanceID,
+	)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+	"github.com/patrickmn/go-cache"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type sgxInstancesResp struct {
+	Instances    []eventindexer.SgxInstanceResponse `json:"instances"`
+	SgxInstances int                                `json:"sgxInstances"`
+}
+
+// GetSgxInstances
+//
+//	 returns the SGX instances currently registered
+//
+//			@Summary		Get SGX instances
+//			@ID			   	get-sgx-instances
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} sgxInstancesResp
+//			@Router			/sgxInstances [get]
+func (srv *Server) GetSgxInstances(c echo.Context) error {
+	cached, found := srv.cache.Get(CacheKeySgxInstances)
+
+	var instances []eventindexer.SgxInstanceResponse
+
+	var err error
+
+	if found {
+		instances = cached.([]eventindexer.SgxInstanceResponse)
+	} else {
+		instances, err = srv.eventRepo.FindCurrentSgxInstances(
+			c.Request().Context(),
+		)
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
+
+		srv.cache.Set(CacheKeySgxInstances, instances, cache.DefaultExpiration)
+	}
+
+	return c.JSON(http.StatusOK, &sgxInstancesResp{
+		Instances:    instances,
+		SgxInstances: len(instances),
+	})
+}
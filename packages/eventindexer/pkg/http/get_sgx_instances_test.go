@@ -0,0 +1,70 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+func Test_GetSgxInstances(t *testing.T) {
+	srv := newTestServer()
+
+	verifier := "0x456"
+
+	for _, event := range []string{
+		eventindexer.EventNameInstanceAdded,
+		eventindexer.EventNameInstanceDeleted,
+		eventindexer.EventNameInstanceAdded,
+	} {
+		id := int64(1)
+
+		_, err := srv.eventRepo.Save(context.Background(), eventindexer.SaveEventOpts{
+			Name:            event,
+			Data:            `{"Instance": "0x0000000000000000000000000000000000000123"}`,
+			ChainID:         big.NewInt(167001),
+			Address:         "0x123",
+			Event:           event,
+			TokenID:         &id,
+			ContractAddress: &verifier,
+			TransactedAt:    time.Now(),
+		})
+
+		assert.Equal(t, nil, err)
+	}
+
+	tests := []struct {
+		name                  string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"success",
+			http.StatusOK,
+			[]string{`"id":1,"address":"0x123","contractAddress":"0x456"`, `"sgxInstances":1`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				"/sgxInstances",
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
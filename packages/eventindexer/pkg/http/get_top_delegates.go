@@ -0,0 +1,65 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+	"github.com/patrickmn/go-cache"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+var (
+	defaultTopDelegatesLimit = 10
+	maxTopDelegatesLimit     = 100
+)
+
+// GetTopDelegates
+//
+//	 returns the TaikoToken delegates with the most votes
+//
+//			@Summary		Get top delegates
+//			@ID			   	get-top-delegates
+//		    @Param			limit	query		string		false	"number of delegates to return, 10 by default, at most 100"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} []eventindexer.TopDelegatesResponse
+//			@Router			/topDelegates [get]
+func (srv *Server) GetTopDelegates(c echo.Context) error {
+	limit := defaultTopDelegatesLimit
+
+	if c.QueryParam("limit") != "" {
+		var err error
+
+		limit, err = strconv.Atoi(c.QueryParam("limit"))
+		if err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+		}
+
+		if limit <= 0 || limit > maxTopDelegatesLimit {
+			return webutils.LogAndRenderErrors(
+				c,
+				http.StatusUnprocessableEntity,
+				fmt.Errorf("limit must be between 1 and %d", maxTopDelegatesLimit),
+			)
+		}
+	}
+
+	key := fmt.Sprintf("%s-%d", CacheKeyTopDelegates, limit)
+
+	cached, found := srv.cache.Get(key)
+	if found {
+		return c.JSON(http.StatusOK, cached.([]eventindexer.TopDelegatesResponse))
+	}
+
+	delegates, err := srv.eventRepo.FindTopDelegates(c.Request().Context(), limit)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	srv.cache.Set(key, delegates, cache.DefaultExpiration)
+
+	return c.JSON(http.StatusOK, delegates)
+}
@@ -0,0 +1,62 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+)
+
+func Test_GetTopDelegates(t *testing.T) {
+	srv := newTestServer()
+
+	tests := []struct {
+		name                  string
+		query                 string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"successDefaultLimit",
+			"",
+			http.StatusOK,
+			[]string{`\[\]`},
+		},
+		{
+			"successLimit",
+			"?limit=50",
+			http.StatusOK,
+			[]string{`\[\]`},
+		},
+		{
+			"invalidLimit",
+			"?limit=notanumber",
+			http.StatusUnprocessableEntity,
+			[]string{`invalid syntax`},
+		},
+		{
+			"limitTooHigh",
+			"?limit=1000",
+			http.StatusUnprocessableEntity,
+			[]string{`limit must be between 1 and 100`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				"/topDelegates"+tt.query,
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/graphql"
+)
+
+// maxGraphQLRequestBytes bounds the body of a GraphQL request, the query length itself
+// being limited by the schema.
+const maxGraphQLRequestBytes = 1 << 20
+
+// GraphQL
+//
+//	 executes a GraphQL query over the indexed events, balances, transactions and charts.
+//	 queries exceeding the depth, complexity or length limits fail with an error.
+//
+//			@Summary		Execute a GraphQL query
+//			@ID			   	graphql
+//		    @Param			query	query		string		false	"query, for GET requests"
+//		    @Param			operationName	query		string		false	"operation name, for GET requests"
+//		    @Param			variables	query		string		false	"JSON encoded variables, for GET requests"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} map[string]interface{}
+//			@Router			/graphql [get]
+//			@Router			/graphql [post]
+func (srv *Server) GraphQL(c echo.Context) error {
+	var req graphql.Request
+
+	if c.Request().Method == http.MethodGet {
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+
+		if c.QueryParam("variables") != "" {
+			if err := json.Unmarshal([]byte(c.QueryParam("variables")), &req.Variables); err != nil {
+				return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+			}
+		}
+	} else {
+		body := http.MaxBytesReader(c.Response(), c.Request().Body, maxGraphQLRequestBytes)
+
+		if err := json.NewDecoder(body).Decode(&req); err != nil {
+			return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+		}
+	}
+
+	return c.JSON(http.StatusOK, srv.graphqlSchema.Exec(c.Request().Context(), req))
+}
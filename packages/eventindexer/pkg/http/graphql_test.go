@@ -0,0 +1,79 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+)
+
+func Test_GraphQL(t *testing.T) {
+	srv := newTestServer()
+
+	tests := []struct {
+		name                  string
+		method                string
+		query                 string
+		body                  string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"successGet",
+			echo.GET,
+			"?query=" + url.QueryEscape(`{ events(first: 5) { id } }`),
+			"",
+			http.StatusOK,
+			[]string{`{"data":{"events":\[\]}}`},
+		},
+		{
+			"successPost",
+			echo.POST,
+			"",
+			`{"query":"query ($a: String!) { account(address: $a) { address } }","variables":{"a":"0x1"}}`,
+			http.StatusOK,
+			[]string{`{"data":{"account":{"address":"0x1"}}}`},
+		},
+		{
+			"complexityExceeded",
+			echo.POST,
+			"",
+			`{"query":"{ events(first: 100) { id } transactions(first: 100) { id } }"}`,
+			http.StatusOK,
+			[]string{`query exceeds the complexity limit of 100 records`},
+		},
+		{
+			"invalidVariables",
+			echo.GET,
+			"?query=" + url.QueryEscape(`{ events { id } }`) + "&variables=notjson",
+			"",
+			http.StatusBadRequest,
+			[]string{`invalid character`},
+		},
+		{
+			"invalidBody",
+			echo.POST,
+			"",
+			`notjson`,
+			http.StatusBadRequest,
+			[]string{`invalid character`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/graphql"+tt.query, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
@@ -12,6 +12,14 @@ func (srv *Server) configureRoutes() {
 	srv.echo.GET("/erc20ByAddress", srv.GetERC20BalancesByAddressAndChainID)
 	srv.echo.GET("/proposalProposedBy", srv.GetProposalProposedBy)
 	srv.echo.GET("/proposalProvedBy", srv.GetProposalProvedBy)
+	srv.echo.GET("/sgxInstances", srv.GetSgxInstances)
+	srv.echo.GET("/sgxInstanceHistory", srv.GetSgxInstanceHistory)
+	srv.echo.GET("/topDelegates", srv.GetTopDelegates)
+	srv.echo.GET("/proposalAnalytics", srv.GetProposalAnalytics)
+	srv.echo.GET("/proverStats", srv.GetProverStats)
+
+	srv.echo.GET("/graphql", srv.GraphQL)
+	srv.echo.POST("/graphql", srv.GraphQL)
 
 	galaxeAPI := srv.echo.Group("/api")
 
@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/patrickmn/go-cache"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/graphql"
 
 	echo "github.com/labstack/echo/v4"
 )
@@ -33,6 +34,7 @@ type Server struct {
 	nftBalanceRepo   eventindexer.NFTBalanceRepository
 	erc20BalanceRepo eventindexer.ERC20BalanceRepository
 	chartRepo        eventindexer.ChartRepository
+	graphqlSchema    *graphql.Schema
 	cache            *cache.Cache
 }
 
@@ -42,8 +44,15 @@ type NewServerOpts struct {
 	NFTBalanceRepo   eventindexer.NFTBalanceRepository
 	ERC20BalanceRepo eventindexer.ERC20BalanceRepository
 	ChartRepo        eventindexer.ChartRepository
+	TxRepo           eventindexer.TransactionRepository
+	AccountRepo      eventindexer.AccountRepository
 	EthClient        *ethclient.Client
 	CorsOrigins      []string
+	// GraphQLMaxDepth, GraphQLMaxComplexity and GraphQLMaxQueryLength limit the queries of
+	// the GraphQL endpoint, the defaults of the graphql package being used when unset.
+	GraphQLMaxDepth       int
+	GraphQLMaxComplexity  int
+	GraphQLMaxQueryLength int
 }
 
 func (opts NewServerOpts) Validate() error {
@@ -69,12 +78,28 @@ func NewServer(opts NewServerOpts) (*Server, error) {
 
 	cache := cache.New(5*time.Minute, 10*time.Minute)
 
+	graphqlSchema, err := graphql.NewSchema(graphql.NewSchemaOpts{
+		EventRepo:        opts.EventRepo,
+		NFTBalanceRepo:   opts.NFTBalanceRepo,
+		ERC20BalanceRepo: opts.ERC20BalanceRepo,
+		TxRepo:           opts.TxRepo,
+		AccountRepo:      opts.AccountRepo,
+		ChartRepo:        opts.ChartRepo,
+		MaxDepth:         opts.GraphQLMaxDepth,
+		MaxComplexity:    opts.GraphQLMaxComplexity,
+		MaxQueryLength:   opts.GraphQLMaxQueryLength,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	srv := &Server{
 		echo:             opts.Echo,
 		eventRepo:        opts.EventRepo,
 		nftBalanceRepo:   opts.NFTBalanceRepo,
 		erc20BalanceRepo: opts.ERC20BalanceRepo,
 		chartRepo:        opts.ChartRepo,
+		graphqlSchema:    graphqlSchema,
 		cache:            cache,
 	}
 
@@ -137,6 +162,6 @@ func (srv *Server) configureMiddleware(corsOrigins []string) {
 	srv.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: corsOrigins,
 		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept},
-		AllowMethods: []string{http.MethodGet, http.MethodHead},
+		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPost},
 	}))
 }
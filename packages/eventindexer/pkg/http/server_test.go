@@ -12,6 +12,7 @@ import (
 	"github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/graphql"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/mock"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/repo"
 )
@@ -19,12 +20,29 @@ import (
 func newTestServer() *Server {
 	_ = godotenv.Load("../.test.env")
 
+	eventRepo := mock.NewEventRepository()
+	nftBalanceRepo := mock.NewNFTBalanceRepository()
+	erc20BalanceRepo := mock.NewERC20BalanceRepository()
+
+	graphqlSchema, err := graphql.NewSchema(graphql.NewSchemaOpts{
+		EventRepo:        eventRepo,
+		NFTBalanceRepo:   nftBalanceRepo,
+		ERC20BalanceRepo: erc20BalanceRepo,
+		TxRepo:           mock.NewTransactionRepository(),
+		AccountRepo:      mock.NewAccountRepository(),
+		MaxComplexity:    100,
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	srv := &Server{
 		cache:            cache.New(5*time.Second, 6*time.Second),
 		echo:             echo.New(),
-		eventRepo:        mock.NewEventRepository(),
-		nftBalanceRepo:   mock.NewNFTBalanceRepository(),
-		erc20BalanceRepo: mock.NewERC20BalanceRepository(),
+		eventRepo:        eventRepo,
+		nftBalanceRepo:   nftBalanceRepo,
+		erc20BalanceRepo: erc20BalanceRepo,
+		graphqlSchema:    graphqlSchema,
 	}
 
 	srv.configureMiddleware([]string{"*"})
@@ -0,0 +1,41 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type AccountRepository struct {
+	accounts []*eventindexer.Account
+}
+
+func NewAccountRepository() *AccountRepository {
+	return &AccountRepository{}
+}
+
+func (r *AccountRepository) Save(ctx context.Context, address common.Address, transactedAt time.Time) error {
+	if a, _ := r.FindByAddress(ctx, address.Hex()); a != nil {
+		return nil
+	}
+
+	r.accounts = append(r.accounts, &eventindexer.Account{
+		ID:           len(r.accounts) + 1,
+		Address:      address.Hex(),
+		TransactedAt: transactedAt,
+	})
+
+	return nil
+}
+
+func (r *AccountRepository) FindByAddress(ctx context.Context, address string) (*eventindexer.Account, error) {
+	for _, a := range r.accounts {
+		if a.Address == address {
+			return a, nil
+		}
+	}
+
+	return nil, nil
+}
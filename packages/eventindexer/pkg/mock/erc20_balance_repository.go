@@ -20,10 +20,51 @@ func (r *ERC20BalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	ctx context.Context,
 	increaseOpts eventindexer.UpdateERC20BalanceOpts,
 	decreaseOpts eventindexer.UpdateERC20BalanceOpts,
+	transferOpts eventindexer.BalanceTransferOpts,
 ) (increasedBalance *eventindexer.ERC20Balance, decreasedBalance *eventindexer.ERC20Balance, err error) {
 	return nil, nil, nil
 }
 
+func (r *ERC20BalanceRepository) RollbackAllAfterBlockID(ctx context.Context, blockID uint64, chainID int64) error {
+	return nil
+}
+
+func (r *ERC20BalanceRepository) FindRandom(
+	ctx context.Context,
+	chainID int64,
+	limit int,
+) ([]*eventindexer.ERC20Balance, error) {
+	var balances []*eventindexer.ERC20Balance
+
+	for _, b := range r.ERC20Balances {
+		if b.ChainID == chainID && len(balances) < limit {
+			balances = append(balances, b)
+		}
+	}
+
+	return balances, nil
+}
+
+func (r *ERC20BalanceRepository) SetBalance(ctx context.Context, opts eventindexer.UpdateERC20BalanceOpts) error {
+	for _, b := range r.ERC20Balances {
+		if b.ChainID == opts.ChainID && b.Address == opts.Address && b.ContractAddress == opts.ContractAddress {
+			b.Amount = opts.Amount
+
+			return nil
+		}
+	}
+
+	r.ERC20Balances = append(r.ERC20Balances, &eventindexer.ERC20Balance{
+		ERC20MetadataID: opts.ERC20MetadataID,
+		ChainID:         opts.ChainID,
+		Address:         opts.Address,
+		ContractAddress: opts.ContractAddress,
+		Amount:          opts.Amount,
+	})
+
+	return nil
+}
+
 func (r *ERC20BalanceRepository) FindByAddress(ctx context.Context,
 	req *http.Request,
 	address string,
@@ -42,6 +83,21 @@ func (r *ERC20BalanceRepository) FindByAddress(ctx context.Context,
 	}, nil
 }
 
+func (r *ERC20BalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindERC20BalancesOpts,
+) ([]*eventindexer.ERC20Balance, error) {
+	var balances []*eventindexer.ERC20Balance
+
+	for _, b := range r.ERC20Balances {
+		if b.Address == opts.Address && (opts.ChainID == 0 || b.ChainID == opts.ChainID) {
+			balances = append(balances, b)
+		}
+	}
+
+	return page(balances, opts.Offset, opts.Limit), nil
+}
+
 func (r *ERC20BalanceRepository) FindMetadata(
 	ctx context.Context,
 	chainID int64,
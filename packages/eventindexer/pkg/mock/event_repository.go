@@ -32,6 +32,21 @@ func (r *EventRepository) Save(ctx context.Context, opts eventindexer.SaveEventO
 		Name:    opts.Name,
 		Event:   opts.Event,
 		Address: opts.Address,
+
+		TransactedAt:   opts.TransactedAt,
+		EmittedBlockID: opts.EmittedBlockID,
+		LogIndex:       opts.LogIndex,
+	}
+
+	if opts.TokenID != nil {
+		e.TokenID = sql.NullInt64{
+			Valid: true,
+			Int64: *opts.TokenID,
+		}
+	}
+
+	if opts.ContractAddress != nil {
+		e.ContractAddress = *opts.ContractAddress
 	}
 
 	if opts.BatchID != nil {
@@ -41,11 +56,43 @@ func (r *EventRepository) Save(ctx context.Context, opts eventindexer.SaveEventO
 		}
 	}
 
+	if opts.NumBlobs != nil {
+		e.NumBlobs = sql.NullInt64{
+			Valid: true,
+			Int64: *opts.NumBlobs,
+		}
+	}
+
+	if opts.ProvingWindow != nil {
+		e.ProvingWindow = sql.NullInt64{
+			Valid: true,
+			Int64: *opts.ProvingWindow,
+		}
+	}
+
 	r.events = append(r.events, e)
 
 	return nil, nil
 }
 
+func (r *EventRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindEventsOpts,
+) ([]*eventindexer.Event, error) {
+	var events []*eventindexer.Event
+
+	for _, e := range slices.Backward(r.events) {
+		if (opts.Event == "" || e.Event == opts.Event) &&
+			(opts.Address == "" || e.Address == opts.Address) &&
+			(opts.ChainID == 0 || e.ChainID == opts.ChainID) &&
+			(opts.BatchID == nil || e.BatchID.Int64 == *opts.BatchID) {
+			events = append(events, e)
+		}
+	}
+
+	return page(events, opts.Offset, opts.Limit), nil
+}
+
 func (r *EventRepository) FindUniqueProposers(
 	ctx context.Context,
 ) ([]eventindexer.UniqueProposersResponse, error) {
@@ -175,3 +222,101 @@ func (r *EventRepository) GetProposalProvedBy(ctx context.Context, proposalID in
 
 	return nil, gorm.ErrRecordNotFound
 }
+
+func (r *EventRepository) FindCurrentSgxInstances(ctx context.Context) ([]eventindexer.SgxInstanceResponse, error) {
+	latest := make(map[int64]*eventindexer.Event)
+
+	for _, e := range r.events {
+		if e.Event == eventindexer.EventNameInstanceAdded || e.Event == eventindexer.EventNameInstanceDeleted {
+			latest[e.TokenID.Int64] = e
+		}
+	}
+
+	instances := make([]eventindexer.SgxInstanceResponse, 0)
+
+	for id, e := range latest {
+		if e.Event == eventindexer.EventNameInstanceAdded {
+			instances = append(instances, eventindexer.SgxInstanceResponse{
+				ID:              id,
+				Address:         e.Address,
+				ContractAddress: e.ContractAddress,
+				RegisteredAt:    e.TransactedAt,
+			})
+		}
+	}
+
+	return instances, nil
+}
+
+func (r *EventRepository) GetSgxInstanceHistory(
+	ctx context.Context,
+	req *http.Request,
+	instanceID int64,
+) (paginate.Page, error) {
+	var events []*eventindexer.Event
+
+	for _, e := range r.events {
+		if e.TokenID.Int64 == instanceID &&
+			(e.Event == eventindexer.EventNameInstanceAdded || e.Event == eventindexer.EventNameInstanceDeleted) {
+			events = append(events, e)
+		}
+	}
+
+	return paginate.Page{
+		Items: events,
+	}, nil
+}
+
+func (r *EventRepository) FindTopDelegates(ctx context.Context, limit int) ([]eventindexer.TopDelegatesResponse, error) {
+	return make([]eventindexer.TopDelegatesResponse, 0), nil
+}
+
+func (r *EventRepository) GetProposalAnalytics(
+	ctx context.Context,
+	req *http.Request,
+	proposer string,
+) (paginate.Page, error) {
+	proposals := make([]eventindexer.ProposalAnalyticsResponse, 0)
+
+	for _, e := range r.events {
+		if e.Event != eventindexer.EventNameProposed || (proposer != "" && e.Address != proposer) {
+			continue
+		}
+
+		p := eventindexer.ProposalAnalyticsResponse{
+			ProposalID: e.BatchID.Int64,
+			Proposer:   e.Address,
+			ProposedAt: e.TransactedAt,
+			NumBlobs:   e.NumBlobs.Int64,
+		}
+
+		if e.ProvingWindow.Valid {
+			p.ProvingWindow = &e.ProvingWindow.Int64
+		}
+
+		if proof, err := r.GetProposalProvedBy(ctx, int(e.BatchID.Int64)); err == nil {
+			latency := int64(proof.TransactedAt.Sub(e.TransactedAt).Seconds())
+			provedByProposer := proof.Address == e.Address
+
+			p.Prover = &proof.Address
+			p.ProvedAt = &proof.TransactedAt
+			p.ProvingLatency = &latency
+			p.ProvedByProposer = &provedByProposer
+
+			if p.ProvingWindow != nil {
+				missed := latency > *p.ProvingWindow
+				p.MissedProvingWindow = &missed
+			}
+		}
+
+		proposals = append(proposals, p)
+	}
+
+	return paginate.Page{
+		Items: proposals,
+	}, nil
+}
+
+func (r *EventRepository) FindProverStats(ctx context.Context) ([]eventindexer.ProverStatsResponse, error) {
+	return make([]eventindexer.ProverStatsResponse, 0), nil
+}
@@ -20,10 +20,53 @@ func (r *NFTBalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	ctx context.Context,
 	increaseOpts eventindexer.UpdateNFTBalanceOpts,
 	decreaseOpts eventindexer.UpdateNFTBalanceOpts,
+	transferOpts eventindexer.BalanceTransferOpts,
 ) (increasedBalance *eventindexer.NFTBalance, decreasedBalance *eventindexer.NFTBalance, err error) {
 	return nil, nil, nil
 }
 
+func (r *NFTBalanceRepository) RollbackAllAfterBlockID(ctx context.Context, blockID uint64, chainID int64) error {
+	return nil
+}
+
+func (r *NFTBalanceRepository) FindRandom(
+	ctx context.Context,
+	chainID int64,
+	limit int,
+) ([]*eventindexer.NFTBalance, error) {
+	var balances []*eventindexer.NFTBalance
+
+	for _, b := range r.nftBalances {
+		if b.ChainID == chainID && len(balances) < limit {
+			balances = append(balances, b)
+		}
+	}
+
+	return balances, nil
+}
+
+func (r *NFTBalanceRepository) SetBalance(ctx context.Context, opts eventindexer.UpdateNFTBalanceOpts) error {
+	for _, b := range r.nftBalances {
+		if b.ChainID == opts.ChainID && b.Address == opts.Address &&
+			b.ContractAddress == opts.ContractAddress && b.TokenID == opts.TokenID {
+			b.Amount = opts.Amount
+
+			return nil
+		}
+	}
+
+	r.nftBalances = append(r.nftBalances, &eventindexer.NFTBalance{
+		ChainID:         opts.ChainID,
+		Address:         opts.Address,
+		TokenID:         opts.TokenID,
+		ContractAddress: opts.ContractAddress,
+		ContractType:    opts.ContractType,
+		Amount:          opts.Amount,
+	})
+
+	return nil
+}
+
 func (r *NFTBalanceRepository) FindByAddress(ctx context.Context,
 	req *http.Request,
 	address string,
@@ -41,3 +84,18 @@ func (r *NFTBalanceRepository) FindByAddress(ctx context.Context,
 		Items: balances,
 	}, nil
 }
+
+func (r *NFTBalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindNFTBalancesOpts,
+) ([]*eventindexer.NFTBalance, error) {
+	var balances []*eventindexer.NFTBalance
+
+	for _, b := range r.nftBalances {
+		if b.Address == opts.Address && (opts.ChainID == 0 || b.ChainID == opts.ChainID) {
+			balances = append(balances, b)
+		}
+	}
+
+	return page(balances, opts.Offset, opts.Limit), nil
+}
@@ -0,0 +1,56 @@
+package mock
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+)
+
+type TransactionRepository struct {
+	txs []*eventindexer.Transaction
+}
+
+func NewTransactionRepository() *TransactionRepository {
+	return &TransactionRepository{}
+}
+
+func (r *TransactionRepository) Save(
+	ctx context.Context,
+	tx *types.Transaction,
+	sender common.Address,
+	blockID *big.Int,
+	transactedAt time.Time,
+	contractAddress common.Address,
+	gasUsed uint64,
+) error {
+	r.txs = append(r.txs, &eventindexer.Transaction{
+		ID:           len(r.txs) + 1,
+		ChainID:      tx.ChainId().Int64(),
+		Sender:       sender.Hex(),
+		BlockID:      blockID.Int64(),
+		GasPrice:     tx.GasPrice().String(),
+		GasUsed:      gasUsed,
+		TransactedAt: transactedAt,
+	})
+
+	return nil
+}
+
+func (r *TransactionRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindTransactionsOpts,
+) ([]*eventindexer.Transaction, error) {
+	var txs []*eventindexer.Transaction
+
+	for _, tx := range r.txs {
+		if (opts.Sender == "" || tx.Sender == opts.Sender) && (opts.ChainID == 0 || tx.ChainID == opts.ChainID) {
+			txs = append(txs, tx)
+		}
+	}
+
+	return page(txs, opts.Offset, opts.Limit), nil
+}
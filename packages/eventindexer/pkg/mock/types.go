@@ -8,3 +8,12 @@ var (
 	MockChainID       = big.NewInt(167001)
 	LatestBlockNumber = big.NewInt(10)
 )
+
+// page returns the items of the page starting at offset.
+func page[T any](items []T, offset int, limit int) []T {
+	if offset >= len(items) {
+		return make([]T, 0)
+	}
+
+	return items[offset:min(offset+limit, len(items))]
+}
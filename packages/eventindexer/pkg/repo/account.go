@@ -57,3 +57,18 @@ func (r *AccountRepository) Save(
 
 	return nil
 }
+
+// FindByAddress returns the account of the address, or nil if it never sent a transaction.
+func (r *AccountRepository) FindByAddress(ctx context.Context, address string) (*eventindexer.Account, error) {
+	a := &eventindexer.Account{}
+
+	if err := r.db.GormDB().WithContext(ctx).Where("address = ?", address).First(a).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "r.db.First")
+	}
+
+	return a, nil
+}
@@ -83,3 +83,25 @@ func TestIntegration_Account_Save(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_Account_FindByAddress(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	accountRepo, err := NewAccountRepository(db)
+	assert.Equal(t, nil, err)
+
+	address := common.HexToAddress("0x1234")
+
+	assert.Nil(t, accountRepo.Save(context.Background(), address, time.Now()))
+
+	account, err := accountRepo.FindByAddress(context.Background(), address.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, address.Hex(), account.Address)
+
+	account, err = accountRepo.FindByAddress(context.Background(), "0x5678")
+	assert.Nil(t, err)
+	assert.Nil(t, account)
+}
@@ -0,0 +1,112 @@
+package repo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+// errTransferAlreadyApplied rolls back the transaction of a balance update whose transfer
+// log was already applied, when a batch of blocks is indexed again.
+var errTransferAlreadyApplied = errors.New("transfer already applied")
+
+type BalanceTransferRepository struct {
+	db db.DB
+}
+
+func NewBalanceTransferRepository(dbHandler db.DB) (*BalanceTransferRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &BalanceTransferRepository{
+		db: dbHandler,
+	}, nil
+}
+
+// FindBlocksFromBlockID returns the blocks transfers were applied from, from blockID on,
+// ordered by block ID.
+func (r *BalanceTransferRepository) FindBlocksFromBlockID(
+	ctx context.Context,
+	chainID int64,
+	blockID uint64,
+) ([]eventindexer.TransferBlock, error) {
+	blocks := make([]eventindexer.TransferBlock, 0)
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Raw(`SELECT DISTINCT block_id, block_hash FROM balance_transfers
+		WHERE chain_id = ? AND block_id >= ? ORDER BY block_id`, chainID, blockID).
+		Scan(&blocks).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return blocks, nil
+}
+
+// DeleteBeforeBlockID deletes the transfers applied from the blocks before blockID, which
+// are too deep to be reorged, so their blocks are no longer checked.
+func (r *BalanceTransferRepository) DeleteBeforeBlockID(
+	ctx context.Context,
+	chainID int64,
+	blockID uint64,
+) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Exec("DELETE FROM balance_transfers WHERE chain_id = ? AND block_id < ?", chainID, blockID).
+		Error; err != nil {
+		return errors.Wrap(err, "r.db.Exec")
+	}
+
+	return nil
+}
+
+// saveBalanceTransfer records the transfer within the transaction applying it to the balances.
+func saveBalanceTransfer(db *gorm.DB, t *eventindexer.BalanceTransfer) error {
+	if err := db.Create(t).Error; err != nil {
+		if strings.Contains(err.Error(), "Duplicate") {
+			return errTransferAlreadyApplied
+		}
+
+		return errors.Wrap(err, "r.db.Create")
+	}
+
+	return nil
+}
+
+// findBalanceTransfersToRollback returns the transfers of the contract types applied from
+// blockID on, the latest first, so they are rolled back in the reverse order.
+func findBalanceTransfersToRollback(
+	db *gorm.DB,
+	blockID uint64,
+	chainID int64,
+	contractTypes []string,
+) ([]*eventindexer.BalanceTransfer, error) {
+	var transfers []*eventindexer.BalanceTransfer
+
+	if err := db.
+		Where("chain_id = ?", chainID).
+		Where("block_id >= ?", blockID).
+		Where("contract_type IN (?)", contractTypes).
+		Order("id DESC").
+		Find(&transfers).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return transfers, nil
+}
+
+func deleteBalanceTransfers(db *gorm.DB, transfers []*eventindexer.BalanceTransfer) error {
+	if len(transfers) == 0 {
+		return nil
+	}
+
+	if err := db.Delete(transfers).Error; err != nil {
+		return errors.Wrap(err, "r.db.Delete")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+)
+
+func Test_NewBalanceTransferRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBalanceTransferRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_BalanceTransfer_FindBlocksFromBlockID(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	balanceTransferRepo, err := NewBalanceTransferRepository(db)
+	assert.Equal(t, nil, err)
+
+	nftBalanceRepo, err := NewNFTBalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	for _, transferOpts := range []eventindexer.BalanceTransferOpts{
+		{BlockID: 1, BlockHash: "0x01", TxHash: "0x01", LogIndex: 0},
+		{BlockID: 2, BlockHash: "0x02", TxHash: "0x02", LogIndex: 0},
+		{BlockID: 2, BlockHash: "0x02", TxHash: "0x02", LogIndex: 1},
+	} {
+		_, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+			eventindexer.UpdateNFTBalanceOpts{
+				ChainID:         1,
+				Address:         "0x456",
+				TokenID:         int64(transferOpts.LogIndex),
+				ContractAddress: "0x123",
+				ContractType:    "ERC1155",
+				Amount:          1,
+			}, eventindexer.UpdateNFTBalanceOpts{}, transferOpts)
+		assert.Nil(t, err)
+	}
+
+	blocks, err := balanceTransferRepo.FindBlocksFromBlockID(context.Background(), 1, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, []eventindexer.TransferBlock{{BlockID: 2, BlockHash: "0x02"}}, blocks)
+}
+
+func TestIntegration_BalanceTransfer_DeleteBeforeBlockID(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	balanceTransferRepo, err := NewBalanceTransferRepository(db)
+	assert.Equal(t, nil, err)
+
+	nftBalanceRepo, err := NewNFTBalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	for _, transfer := range []struct {
+		chainID int64
+		opts    eventindexer.BalanceTransferOpts
+	}{
+		{1, eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01", LogIndex: 0}},
+		{1, eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x02", TxHash: "0x02", LogIndex: 0}},
+		{1, eventindexer.BalanceTransferOpts{BlockID: 3, BlockHash: "0x03", TxHash: "0x03", LogIndex: 0}},
+		{2, eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x11", TxHash: "0x11", LogIndex: 0}},
+	} {
+		_, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+			eventindexer.UpdateNFTBalanceOpts{
+				ChainID:         transfer.chainID,
+				Address:         "0x456",
+				TokenID:         int64(transfer.opts.BlockID),
+				ContractAddress: "0x123",
+				ContractType:    "ERC1155",
+				Amount:          1,
+			}, eventindexer.UpdateNFTBalanceOpts{}, transfer.opts)
+		assert.Nil(t, err)
+	}
+
+	assert.Nil(t, balanceTransferRepo.DeleteBeforeBlockID(context.Background(), 1, 3))
+
+	blocks, err := balanceTransferRepo.FindBlocksFromBlockID(context.Background(), 1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []eventindexer.TransferBlock{{BlockID: 3, BlockHash: "0x03"}}, blocks)
+
+	// the transfers of the other chains are kept.
+	blocks, err = balanceTransferRepo.FindBlocksFromBlockID(context.Background(), 2, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []eventindexer.TransferBlock{{BlockID: 1, BlockHash: "0x11"}}, blocks)
+}
@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
+	"log/slog"
 	"math/big"
 	"net/http"
 	"strings"
@@ -118,19 +119,54 @@ func (r *ERC20BalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	ctx context.Context,
 	increaseOpts eventindexer.UpdateERC20BalanceOpts,
 	decreaseOpts eventindexer.UpdateERC20BalanceOpts,
+	transferOpts eventindexer.BalanceTransferOpts,
 ) (increasedBalance *eventindexer.ERC20Balance, decreasedBalance *eventindexer.ERC20Balance, err error) {
+	// Skip no-op or zero-address increases to avoid creating balances for 0x000... or zero amount
+	increase := increaseOpts.Amount != "0" && increaseOpts.Amount != "" && increaseOpts.Address != ZeroAddress.Hex()
+	decrease := decreaseOpts.Amount != "0" && decreaseOpts.Amount != ""
+
+	if !increase && !decrease {
+		return nil, nil, nil
+	}
+
+	transfer := &eventindexer.BalanceTransfer{
+		BlockID:      transferOpts.BlockID,
+		BlockHash:    transferOpts.BlockHash,
+		TxHash:       transferOpts.TxHash,
+		LogIndex:     transferOpts.LogIndex,
+		BatchIndex:   transferOpts.BatchIndex,
+		ContractType: eventindexer.ContractTypeERC20,
+	}
+
+	if increase {
+		transfer.ChainID = increaseOpts.ChainID
+		transfer.ContractAddress = increaseOpts.ContractAddress
+		transfer.ToAddress = increaseOpts.Address
+		transfer.Amount = increaseOpts.Amount
+	}
+
+	if decrease {
+		transfer.ChainID = decreaseOpts.ChainID
+		transfer.ContractAddress = decreaseOpts.ContractAddress
+		transfer.FromAddress = decreaseOpts.Address
+		transfer.Amount = decreaseOpts.Amount
+	}
+
 	retries := 10
 	for retries > 0 {
 		err = r.db.GormDB().Transaction(func(tx *gorm.DB) (err error) {
-			// Skip no-op or zero-address increases to avoid creating balances for 0x000... or zero amount
-			if increaseOpts.Amount != "0" && increaseOpts.Amount != "" && increaseOpts.Address != ZeroAddress.Hex() {
+			if err := saveBalanceTransfer(tx.WithContext(ctx), transfer); err != nil {
+				return err
+			}
+
+			if increase {
 				increasedBalance, err = r.increaseBalanceInDB(tx.WithContext(ctx), increaseOpts)
 				if err != nil {
 					return err
 				}
 			}
 
-			if decreaseOpts.Amount != "0" && decreaseOpts.Amount != "" {
+			if decrease {
 				decreasedBalance, err = r.decreaseBalanceInDB(tx.WithContext(ctx), decreaseOpts)
 			}
 
@@ -141,6 +177,12 @@ func (r *ERC20BalanceRepository) IncreaseAndDecreaseBalancesInTx(
 			break
 		}
 
+		if errors.Is(err, errTransferAlreadyApplied) {
+			slog.Info("erc20 transfer already applied", "txHash", transferOpts.TxHash, "logIndex", transferOpts.LogIndex)
+
+			return nil, nil, nil
+		}
+
 		if strings.Contains(err.Error(), "Deadlock") {
 			retries--
 
@@ -159,6 +201,102 @@ func (r *ERC20BalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	return increasedBalance, decreasedBalance, nil
 }
 
+// RollbackAllAfterBlockID reverts the ERC20 transfers applied from blockID on, when the
+// blocks were reorged out and their events deleted by DeleteAllAfterBlockID.
+func (r *ERC20BalanceRepository) RollbackAllAfterBlockID(ctx context.Context, blockID uint64, chainID int64) error {
+	return r.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transfers, err := findBalanceTransfersToRollback(tx, blockID, chainID, []string{eventindexer.ContractTypeERC20})
+		if err != nil {
+			return err
+		}
+
+		for _, t := range transfers {
+			if t.ToAddress != "" {
+				if _, err := r.decreaseBalanceInDB(tx, eventindexer.UpdateERC20BalanceOpts{
+					ChainID:         t.ChainID,
+					Address:         t.ToAddress,
+					ContractAddress: t.ContractAddress,
+					Amount:          t.Amount,
+				}); err != nil {
+					return err
+				}
+			}
+
+			if t.FromAddress != "" {
+				var metadataID int64
+
+				if err := tx.Raw(
+					"SELECT id FROM erc20_metadata WHERE contract_address = ? AND chain_id = ?",
+					t.ContractAddress, t.ChainID,
+				).Scan(&metadataID).Error; err != nil {
+					return errors.Wrap(err, "r.db.Scan")
+				}
+
+				if _, err := r.increaseBalanceInDB(tx, eventindexer.UpdateERC20BalanceOpts{
+					ERC20MetadataID: metadataID,
+					ChainID:         t.ChainID,
+					Address:         t.FromAddress,
+					ContractAddress: t.ContractAddress,
+					Amount:          t.Amount,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		return deleteBalanceTransfers(tx, transfers)
+	})
+}
+
+// FindRandom returns a random sample of the balances of the chain, to reconcile.
+func (r *ERC20BalanceRepository) FindRandom(
+	ctx context.Context,
+	chainID int64,
+	limit int,
+) ([]*eventindexer.ERC20Balance, error) {
+	return findRandomSample[eventindexer.ERC20Balance](r.db.GormDB().WithContext(ctx), "erc20_balances", chainID, limit)
+}
+
+// SetBalance sets the balance of the address to the amount of the opts, as read on-chain.
+func (r *ERC20BalanceRepository) SetBalance(ctx context.Context, opts eventindexer.UpdateERC20BalanceOpts) error {
+	b := &eventindexer.ERC20Balance{
+		ContractAddress: opts.ContractAddress,
+		Address:         opts.Address,
+		ChainID:         opts.ChainID,
+		ERC20MetadataID: opts.ERC20MetadataID,
+	}
+
+	err := r.db.GormDB().WithContext(ctx).
+		Where("contract_address = ?", opts.ContractAddress).
+		Where("address = ?", opts.Address).
+		Where("chain_id = ?", opts.ChainID).
+		First(b).
+		Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.Wrap(err, "r.db.gormDB.First")
+	}
+
+	if opts.Amount == "0" {
+		if b.ID == 0 {
+			return nil
+		}
+
+		if err := r.db.GormDB().WithContext(ctx).Delete(b).Error; err != nil {
+			return errors.Wrap(err, "r.db.Delete")
+		}
+
+		return nil
+	}
+
+	b.Amount = opts.Amount
+
+	if err := r.db.GormDB().WithContext(ctx).Save(b).Error; err != nil {
+		return errors.Wrap(err, "r.db.Save")
+	}
+
+	return nil
+}
+
 func (r *ERC20BalanceRepository) FindByAddress(ctx context.Context,
 	req *http.Request,
 	address string,
@@ -178,6 +316,33 @@ func (r *ERC20BalanceRepository) FindByAddress(ctx context.Context,
 	return page, nil
 }
 
+// Find returns the non-zero balances matching opts, with the metadata of their token.
+func (r *ERC20BalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindERC20BalancesOpts,
+) ([]*eventindexer.ERC20Balance, error) {
+	balances := make([]*eventindexer.ERC20Balance, 0)
+
+	q := r.db.GormDB().WithContext(ctx).
+		Preload("Metadata").
+		Where("address = ?", opts.Address).
+		Where("amount > 0")
+
+	if opts.ChainID != 0 {
+		q = q.Where("chain_id = ?", opts.ChainID)
+	}
+
+	if opts.ContractAddress != "" {
+		q = q.Where("contract_address = ?", opts.ContractAddress)
+	}
+
+	if err := q.Order("id").Offset(opts.Offset).Limit(opts.Limit).Find(&balances).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return balances, nil
+}
+
 func (r *ERC20BalanceRepository) FindMetadata(
 	ctx context.Context,
 	chainID int64,
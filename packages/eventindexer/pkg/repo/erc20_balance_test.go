@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -58,7 +59,8 @@ func TestIntegration_ERC20Balance_Increase_And_Decrease(t *testing.T) {
 			Address:         "0x123",
 			ContractAddress: "0x123",
 			Amount:          "1",
-		}, eventindexer.UpdateERC20BalanceOpts{})
+		}, eventindexer.UpdateERC20BalanceOpts{},
+		eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01", LogIndex: 0})
 	assert.Equal(t, nil, err)
 	assert.NotNil(t, bal1)
 
@@ -69,7 +71,8 @@ func TestIntegration_ERC20Balance_Increase_And_Decrease(t *testing.T) {
 			Address:         "0x123",
 			ContractAddress: "0x123456",
 			Amount:          "2",
-		}, eventindexer.UpdateERC20BalanceOpts{})
+		}, eventindexer.UpdateERC20BalanceOpts{},
+		eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01", LogIndex: 1})
 	assert.Equal(t, nil, err)
 	assert.NotNil(t, bal2)
 
@@ -77,6 +80,7 @@ func TestIntegration_ERC20Balance_Increase_And_Decrease(t *testing.T) {
 		name         string
 		increaseOpts eventindexer.UpdateERC20BalanceOpts
 		decreaseOpts eventindexer.UpdateERC20BalanceOpts
+		transferOpts eventindexer.BalanceTransferOpts
 		wantErr      error
 	}{
 		{
@@ -95,6 +99,7 @@ func TestIntegration_ERC20Balance_Increase_And_Decrease(t *testing.T) {
 				ContractAddress: "0x123",
 				Amount:          "1",
 			},
+			eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x02", TxHash: "0x02", LogIndex: 2},
 			nil,
 		},
 		{
@@ -113,13 +118,19 @@ func TestIntegration_ERC20Balance_Increase_And_Decrease(t *testing.T) {
 				ContractAddress: "0x123456",
 				Amount:          "1",
 			},
+			eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x02", TxHash: "0x02", LogIndex: 3},
 			nil,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(), tt.increaseOpts, tt.decreaseOpts)
+			_, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(
+				context.Background(),
+				tt.increaseOpts,
+				tt.decreaseOpts,
+				tt.transferOpts,
+			)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
@@ -162,3 +173,210 @@ func TestIntegration_ERC20Balance_FindByAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_ERC20Balance_TransferAppliedOnce(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	pk, _ := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
+
+	increaseOpts := eventindexer.UpdateERC20BalanceOpts{
+		ERC20MetadataID: int64(pk),
+		ChainID:         1,
+		Address:         "0x456",
+		ContractAddress: "0x123",
+		Amount:          "5",
+	}
+	transferOpts := eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01"}
+
+	bal, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(
+		context.Background(), increaseOpts, eventindexer.UpdateERC20BalanceOpts{}, transferOpts,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, "5", bal.Amount)
+
+	bal, _, err = ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(
+		context.Background(), increaseOpts, eventindexer.UpdateERC20BalanceOpts{}, transferOpts,
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, bal)
+
+	balances, err := ERC20BalanceRepo.FindRandom(context.Background(), 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(balances))
+	assert.Equal(t, "5", balances[0].Amount)
+}
+
+func TestIntegration_ERC20Balance_RollbackAllAfterBlockID(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	pk, _ := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
+
+	opts := func(address string, amount string) eventindexer.UpdateERC20BalanceOpts {
+		return eventindexer.UpdateERC20BalanceOpts{
+			ERC20MetadataID: int64(pk),
+			ChainID:         1,
+			Address:         address,
+			ContractAddress: "0x123",
+			Amount:          amount,
+		}
+	}
+
+	// mint 10 to 0x456 in block 1, then send 4 of them to 0x789 in block 2
+	_, _, err = ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+		opts("0x456", "10"), eventindexer.UpdateERC20BalanceOpts{},
+		eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01"})
+	assert.Nil(t, err)
+
+	_, _, err = ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+		opts("0x789", "4"), opts("0x456", "4"),
+		eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x02", TxHash: "0x02"})
+	assert.Nil(t, err)
+
+	assert.Nil(t, ERC20BalanceRepo.RollbackAllAfterBlockID(context.Background(), 2, 1))
+
+	balances, err := ERC20BalanceRepo.FindRandom(context.Background(), 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(balances))
+	assert.Equal(t, "0x456", balances[0].Address)
+	assert.Equal(t, "10", balances[0].Amount)
+
+	// the rolled back transfer can be applied again from the canonical block
+	_, decreased, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+		opts("0x789", "4"), opts("0x456", "4"),
+		eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x03", TxHash: "0x02"})
+	assert.Nil(t, err)
+	assert.Equal(t, "6", decreased.Amount)
+}
+
+func TestIntegration_ERC20Balance_SetBalance(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	pk, _ := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
+
+	opts := eventindexer.UpdateERC20BalanceOpts{
+		ERC20MetadataID: int64(pk),
+		ChainID:         1,
+		Address:         "0x456",
+		ContractAddress: "0x123",
+		Amount:          "7",
+	}
+
+	assert.Nil(t, ERC20BalanceRepo.SetBalance(context.Background(), opts))
+
+	balances, err := ERC20BalanceRepo.FindRandom(context.Background(), 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(balances))
+	assert.Equal(t, "7", balances[0].Amount)
+
+	opts.Amount = "0"
+
+	assert.Nil(t, ERC20BalanceRepo.SetBalance(context.Background(), opts))
+
+	balances, err = ERC20BalanceRepo.FindRandom(context.Background(), 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(balances))
+}
+
+func TestIntegration_ERC20Balance_Find(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	pk, _ := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
+
+	for address, amount := range map[string]string{"0x456": "7", "0x789": "0"} {
+		assert.Nil(t, ERC20BalanceRepo.SetBalance(context.Background(), eventindexer.UpdateERC20BalanceOpts{
+			ERC20MetadataID: int64(pk),
+			ChainID:         1,
+			Address:         address,
+			ContractAddress: "0x123",
+			Amount:          amount,
+		}))
+	}
+
+	balances, err := ERC20BalanceRepo.Find(context.Background(), eventindexer.FindERC20BalancesOpts{
+		Address: "0x456",
+		ChainID: 1,
+		Limit:   10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(balances))
+	assert.Equal(t, "7", balances[0].Amount)
+	assert.Equal(t, "SYMBOL", balances[0].Metadata.Symbol)
+
+	balances, err = ERC20BalanceRepo.Find(context.Background(), eventindexer.FindERC20BalancesOpts{
+		Address: "0x789",
+		Limit:   10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(balances))
+}
+
+func TestIntegration_ERC20Balance_FindRandom(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	ERC20BalanceRepo, err := NewERC20BalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	pk, _ := ERC20BalanceRepo.CreateMetadata(context.Background(), 1, "0x123", "SYMBOL", 18)
+
+	for i, chainID := range []int64{1, 1, 2, 1, 1, 2} {
+		_, _, err := ERC20BalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+			eventindexer.UpdateERC20BalanceOpts{
+				ERC20MetadataID: int64(pk),
+				ChainID:         chainID,
+				Address:         fmt.Sprintf("0x%d", i),
+				ContractAddress: "0x123",
+				Amount:          "1",
+			}, eventindexer.UpdateERC20BalanceOpts{},
+			eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01", LogIndex: uint(i)})
+		assert.Nil(t, err)
+	}
+
+	// whichever id the sample starts from, it wraps around to return every balance of the
+	// chain once, or the limit.
+	for range 10 {
+		balances, err := ERC20BalanceRepo.FindRandom(context.Background(), 1, 10)
+		assert.Nil(t, err)
+
+		addresses := make([]string, 0, len(balances))
+		for _, b := range balances {
+			addresses = append(addresses, b.Address)
+		}
+
+		assert.ElementsMatch(t, []string{"0x0", "0x1", "0x3", "0x4"}, addresses)
+
+		balances, err = ERC20BalanceRepo.FindRandom(context.Background(), 1, 2)
+		assert.Nil(t, err)
+		assert.Len(t, balances, 2)
+	}
+
+	balances, err := ERC20BalanceRepo.FindRandom(context.Background(), 3, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, balances)
+}
@@ -38,6 +38,7 @@ func (r *EventRepository) Save(ctx context.Context, opts eventindexer.SaveEventO
 		Address:        opts.Address,
 		TransactedAt:   opts.TransactedAt,
 		EmittedBlockID: opts.EmittedBlockID,
+		LogIndex:       opts.LogIndex,
 	}
 
 	if opts.Tier != nil {
@@ -61,6 +62,20 @@ func (r *EventRepository) Save(ctx context.Context, opts eventindexer.SaveEventO
 		}
 	}
 
+	if opts.NumBlobs != nil {
+		e.NumBlobs = sql.NullInt64{
+			Valid: true,
+			Int64: *opts.NumBlobs,
+		}
+	}
+
+	if opts.ProvingWindow != nil {
+		e.ProvingWindow = sql.NullInt64{
+			Valid: true,
+			Int64: *opts.ProvingWindow,
+		}
+	}
+
 	if opts.Amount != nil {
 		amt, err := decimal.NewFromString(opts.Amount.String())
 		if err != nil {
@@ -134,6 +149,38 @@ func (r *EventRepository) Save(ctx context.Context, opts eventindexer.SaveEventO
 	return e, nil
 }
 
+// Find returns the events matching opts, the latest first.
+func (r *EventRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindEventsOpts,
+) ([]*eventindexer.Event, error) {
+	events := make([]*eventindexer.Event, 0)
+
+	q := r.db.GormDB().WithContext(ctx)
+
+	if opts.Event != "" {
+		q = q.Where("event = ?", opts.Event)
+	}
+
+	if opts.Address != "" {
+		q = q.Where("address = ?", opts.Address)
+	}
+
+	if opts.ChainID != 0 {
+		q = q.Where("chain_id = ?", opts.ChainID)
+	}
+
+	if opts.BatchID != nil {
+		q = q.Where("batch_id = ?", *opts.BatchID)
+	}
+
+	if err := q.Order("id DESC").Offset(opts.Offset).Limit(opts.Limit).Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) FindByEventTypeAndBlockID(
 	ctx context.Context,
 	eventType string,
@@ -255,11 +302,12 @@ func (r *EventRepository) FirstByAddressAndEventName(
 	return e, nil
 }
 
-// DeleteAllAfterBlockID is used when a reorg is detected
+// DeleteAllAfterBlockID is used when a reorg is detected, deleting the events emitted
+// from blockID on
 func (r *EventRepository) DeleteAllAfterBlockID(ctx context.Context, blockID uint64, srcChainID uint64) error {
 	query := `
 DELETE FROM events
-WHERE block_id >= ? AND chain_id = ?`
+WHERE emitted_block_id >= ? AND chain_id = ?`
 
 	return r.db.GormDB().WithContext(ctx).Table("events").Exec(query, blockID, srcChainID).Error
 }
@@ -318,3 +366,165 @@ func (r *EventRepository) GetProposalProvedBy(ctx context.Context, proposalID in
 
 	return nil, gorm.ErrRecordNotFound
 }
+
+// FindCurrentSgxInstances returns the SGX instances whose latest registration event is an
+// InstanceAdded one, the ones replaced or deleted since being left out.
+func (r *EventRepository) FindCurrentSgxInstances(
+	ctx context.Context,
+) ([]eventindexer.SgxInstanceResponse, error) {
+	instances := make([]eventindexer.SgxInstanceResponse, 0)
+
+	q := `SELECT token_id AS id, address, contract_address, transacted_at AS registered_at FROM (
+		SELECT *, ROW_NUMBER() OVER (
+			PARTITION BY contract_address, token_id ORDER BY emitted_block_id DESC, log_index DESC
+		) AS rn
+		FROM events WHERE event IN (?)
+	) latest
+	WHERE rn = 1 AND event = ?
+	ORDER BY token_id`
+
+	events := []string{
+		eventindexer.EventNameInstanceAdded,
+		eventindexer.EventNameInstanceDeleted,
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Raw(q, events, eventindexer.EventNameInstanceAdded).
+		Scan(&instances).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return instances, nil
+}
+
+// GetSgxInstanceHistory returns the registration and deletion events of the SGX instance ID,
+// the latest first.
+func (r *EventRepository) GetSgxInstanceHistory(
+	ctx context.Context,
+	req *http.Request,
+	instanceID int64,
+) (paginate.Page, error) {
+	pg := paginate.New(&paginate.Config{
+		DefaultSize: 100,
+	})
+
+	events := []string{
+		eventindexer.EventNameInstanceAdded,
+		eventindexer.EventNameInstanceDeleted,
+	}
+
+	q := r.db.GormDB().WithContext(ctx).
+		Raw(`SELECT * FROM events WHERE event IN (?) AND token_id = ?
+		ORDER BY emitted_block_id DESC, log_index DESC`, events, instanceID)
+
+	reqCtx := pg.With(q)
+
+	page := reqCtx.Request(req).Response(&[]eventindexer.Event{})
+
+	return page, nil
+}
+
+// FindTopDelegates returns the TaikoToken delegates with the most votes, as of their
+// latest DelegateVotesChanged event.
+func (r *EventRepository) FindTopDelegates(
+	ctx context.Context,
+	limit int,
+) ([]eventindexer.TopDelegatesResponse, error) {
+	delegates := make([]eventindexer.TopDelegatesResponse, 0)
+
+	q := `SELECT address, amount AS votes FROM (
+		SELECT address, amount, ROW_NUMBER() OVER (
+			PARTITION BY contract_address, address ORDER BY emitted_block_id DESC, log_index DESC
+		) AS rn
+		FROM events WHERE event = ?
+	) latest
+	WHERE rn = 1 AND amount > 0
+	ORDER BY amount DESC
+	LIMIT ?`
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Raw(q, eventindexer.EventNameDelegateVotesChanged, limit).
+		Scan(&delegates).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return delegates, nil
+}
+
+// proposalAnalyticsQuery joins the Proposed events with the Proved event and the
+// LivenessBondSettled event of their proposal, the proposal ID being stored as the batch ID.
+const proposalAnalyticsQuery = `FROM events p
+	LEFT JOIN events v ON v.event = ? AND v.chain_id = p.chain_id AND v.batch_id = p.batch_id
+	LEFT JOIN events b ON b.event = ? AND b.chain_id = p.chain_id AND b.batch_id = p.batch_id
+	WHERE p.event = ?`
+
+// missedProvingWindow is whether the proof of a proposal came after its proving window, null
+// while it is not proved or when its proving window is unknown.
+const missedProvingWindow = "v.transacted_at > p.transacted_at + INTERVAL p.proving_window SECOND"
+
+// GetProposalAnalytics returns the proposals, of the proposer if set, with their proofs and
+// liveness bond outcomes, the latest first.
+func (r *EventRepository) GetProposalAnalytics(
+	ctx context.Context,
+	req *http.Request,
+	proposer string,
+) (paginate.Page, error) {
+	pg := paginate.New(&paginate.Config{
+		DefaultSize: 100,
+	})
+
+	q := `SELECT p.batch_id AS proposal_id, p.address AS proposer, p.transacted_at AS proposed_at,
+	COALESCE(p.num_blobs, 0) AS num_blobs, v.address AS prover, v.transacted_at AS proved_at,
+	TIMESTAMPDIFF(SECOND, p.transacted_at, v.transacted_at) AS proving_latency,
+	v.address = p.address AS proved_by_proposer, p.proving_window,
+	` + missedProvingWindow + ` AS missed_proving_window, b.id IS NOT NULL AS liveness_bond_settled,
+	b.amount AS liveness_bond_slashed, b.proof_reward AS liveness_bond_credited ` + proposalAnalyticsQuery
+
+	args := []interface{}{
+		eventindexer.EventNameProved,
+		eventindexer.EventNameLivenessBondSettled,
+		eventindexer.EventNameProposed,
+	}
+
+	if proposer != "" {
+		q += " AND p.address = ?"
+
+		args = append(args, proposer)
+	}
+
+	q += " ORDER BY p.batch_id DESC"
+
+	reqCtx := pg.With(r.db.GormDB().WithContext(ctx).Raw(q, args...))
+
+	page := reqCtx.Request(req).Response(&[]eventindexer.ProposalAnalyticsResponse{})
+
+	return page, nil
+}
+
+// FindProverStats returns, for each proposer, how the proposals they are the designated
+// prover of were proved, the proposers with the most proposals first.
+func (r *EventRepository) FindProverStats(ctx context.Context) ([]eventindexer.ProverStatsResponse, error) {
+	stats := make([]eventindexer.ProverStatsResponse, 0)
+
+	q := `SELECT p.address, COUNT(*) AS proposals, COUNT(v.id) AS proved,
+	COALESCE(SUM(v.address = p.address), 0) AS proved_by_self,
+	COALESCE(SUM(v.address <> p.address), 0) AS proved_by_others,
+	COALESCE(SUM(` + missedProvingWindow + `), 0) AS missed_proving_window,
+	COUNT(b.id) AS liveness_bonds_settled,
+	AVG(TIMESTAMPDIFF(SECOND, p.transacted_at, v.transacted_at)) AS avg_proving_latency,
+	COALESCE(SUM(b.amount), 0) AS liveness_bond_slashed ` + proposalAnalyticsQuery + `
+	GROUP BY p.address
+	ORDER BY proposals DESC`
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Raw(q,
+			eventindexer.EventNameProved,
+			eventindexer.EventNameLivenessBondSettled,
+			eventindexer.EventNameProposed,
+		).
+		Scan(&stats).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return stats, nil
+}
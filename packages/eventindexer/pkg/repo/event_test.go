@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"math/big"
+	"net/http"
 	"testing"
 	"time"
 
@@ -427,3 +428,306 @@ func TestIntegration_Event_FirstByAddressAndEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_Event_FindCurrentSgxInstances(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	verifier := "0xverifier"
+
+	save := func(event string, address string, id int64, emittedBlockID uint64, logIndex uint) {
+		_, err := eventRepo.Save(context.Background(), eventindexer.SaveEventOpts{
+			Name:            event,
+			Address:         address,
+			Data:            "{\"data\":\"something\"}",
+			Event:           event,
+			ChainID:         big.NewInt(1),
+			TokenID:         &id,
+			ContractAddress: &verifier,
+			TransactedAt:    time.Now(),
+			EmittedBlockID:  emittedBlockID,
+			LogIndex:        logIndex,
+		})
+		assert.Equal(t, nil, err)
+	}
+
+	// instance 0 is replaced within the same block, instance 1 is deleted, and instance 2
+	// is added again after being deleted.
+	save(eventindexer.EventNameInstanceAdded, "0xaaaa", 0, 1, 0)
+	save(eventindexer.EventNameInstanceAdded, "0xbbbb", 0, 2, 3)
+	save(eventindexer.EventNameInstanceAdded, "0xcccc", 0, 2, 1)
+	save(eventindexer.EventNameInstanceAdded, "0xdddd", 1, 1, 1)
+	save(eventindexer.EventNameInstanceDeleted, "0xdddd", 1, 3, 0)
+	save(eventindexer.EventNameInstanceDeleted, "0xeeee", 2, 1, 2)
+	save(eventindexer.EventNameInstanceAdded, "0xeeee", 2, 4, 0)
+
+	instances, err := eventRepo.FindCurrentSgxInstances(context.Background())
+	assert.Equal(t, nil, err)
+
+	assert.Equal(t, 2, len(instances))
+	assert.Equal(t, int64(0), instances[0].ID)
+	assert.Equal(t, "0xbbbb", instances[0].Address)
+	assert.Equal(t, verifier, instances[0].ContractAddress)
+	assert.Equal(t, int64(2), instances[1].ID)
+	assert.Equal(t, "0xeeee", instances[1].Address)
+}
+
+func TestIntegration_Event_FindTopDelegates(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	token := "0xtoken"
+
+	save := func(delegate string, votes int64, emittedBlockID uint64, logIndex uint) {
+		_, err := eventRepo.Save(context.Background(), eventindexer.SaveEventOpts{
+			Name:            eventindexer.EventNameDelegateVotesChanged,
+			Address:         delegate,
+			Data:            "{\"data\":\"something\"}",
+			Event:           eventindexer.EventNameDelegateVotesChanged,
+			ChainID:         big.NewInt(1),
+			Amount:          big.NewInt(votes),
+			ContractAddress: &token,
+			TransactedAt:    time.Now(),
+			EmittedBlockID:  emittedBlockID,
+			LogIndex:        logIndex,
+		})
+		assert.Equal(t, nil, err)
+	}
+
+	save("0xaaaa", 100, 1, 0)
+	save("0xaaaa", 50, 2, 0)
+	save("0xbbbb", 70, 1, 1)
+	save("0xcccc", 300, 1, 2)
+	save("0xcccc", 0, 3, 0)
+	save("0xdddd", 10, 2, 1)
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []string
+	}{
+		{
+			"all",
+			10,
+			[]string{"0xbbbb", "0xaaaa", "0xdddd"},
+		},
+		{
+			"limited",
+			2,
+			[]string{"0xbbbb", "0xaaaa"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delegates, err := eventRepo.FindTopDelegates(context.Background(), tt.limit)
+			assert.Equal(t, nil, err)
+
+			addresses := make([]string, 0, len(delegates))
+			for _, d := range delegates {
+				addresses = append(addresses, d.Address)
+			}
+
+			assert.Equal(t, tt.want, addresses)
+		})
+	}
+
+	delegates, err := eventRepo.FindTopDelegates(context.Background(), 1)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "70", delegates[0].Votes.String())
+}
+
+func TestIntegration_Event_Find(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	for _, batchID := range []int64{1, 2, 3} {
+		opts := dummyShastaProvedEventOpts
+		opts.BatchID = &batchID
+
+		_, err = eventRepo.Save(context.Background(), opts)
+		assert.Equal(t, nil, err)
+	}
+
+	_, err = eventRepo.Save(context.Background(), dummyShastaProposedEventOpts)
+	assert.Equal(t, nil, err)
+
+	batchID := int64(2)
+
+	tests := []struct {
+		name         string
+		opts         eventindexer.FindEventsOpts
+		wantBatchIDs []int64
+	}{
+		{
+			"byEventLatestFirst",
+			eventindexer.FindEventsOpts{Event: eventindexer.EventNameProved, Limit: 10},
+			[]int64{3, 2, 1},
+		},
+		{
+			"byBatchID",
+			eventindexer.FindEventsOpts{Event: eventindexer.EventNameProved, BatchID: &batchID, Limit: 10},
+			[]int64{2},
+		},
+		{
+			"paginated",
+			eventindexer.FindEventsOpts{Event: eventindexer.EventNameProved, Offset: 1, Limit: 1},
+			[]int64{2},
+		},
+		{
+			"noMatch",
+			eventindexer.FindEventsOpts{Address: "0xnone", Limit: 10},
+			[]int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := eventRepo.Find(context.Background(), tt.opts)
+			assert.Equal(t, nil, err)
+
+			batchIDs := make([]int64, 0, len(events))
+			for _, e := range events {
+				batchIDs = append(batchIDs, e.BatchID.Int64)
+			}
+
+			assert.Equal(t, tt.wantBatchIDs, batchIDs)
+		})
+	}
+}
+
+func saveProposalAnalyticsEvents(t *testing.T, eventRepo *EventRepository) {
+	proposedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	save := func(event string, address string, proposalID int64, at time.Time, opts eventindexer.SaveEventOpts) {
+		opts.Name = event
+		opts.Event = event
+		opts.Address = address
+		opts.Data = "{\"data\":\"something\"}"
+		opts.ChainID = big.NewInt(1)
+		opts.TransactedAt = at
+		opts.BatchID = &proposalID
+
+		_, err := eventRepo.Save(context.Background(), opts)
+		assert.Equal(t, nil, err)
+	}
+
+	numBlobs := int64(3)
+	provingWindow := int64(120)
+
+	// proposal 1 is proved by its proposer, proposal 2 by another prover after its proving
+	// window, settling the liveness bond, and proposal 3 is not proved yet. Proposal 4 is
+	// proved after its proving window by a whitelisted prover, which settles no bond.
+	save(eventindexer.EventNameProposed, "0xaaaa", 1, proposedAt,
+		eventindexer.SaveEventOpts{NumBlobs: &numBlobs, ProvingWindow: &provingWindow})
+	save(eventindexer.EventNameProved, "0xaaaa", 1, proposedAt.Add(time.Minute), eventindexer.SaveEventOpts{})
+	save(eventindexer.EventNameProposed, "0xaaaa", 2, proposedAt, eventindexer.SaveEventOpts{ProvingWindow: &provingWindow})
+	save(eventindexer.EventNameProved, "0xbbbb", 2, proposedAt.Add(3*time.Minute), eventindexer.SaveEventOpts{})
+	save(eventindexer.EventNameLivenessBondSettled, "0xaaaa", 2, proposedAt.Add(3*time.Minute),
+		eventindexer.SaveEventOpts{Amount: big.NewInt(50), ProofReward: big.NewInt(50)})
+	save(eventindexer.EventNameProposed, "0xbbbb", 3, proposedAt, eventindexer.SaveEventOpts{ProvingWindow: &provingWindow})
+	save(eventindexer.EventNameProposed, "0xaaaa", 4, proposedAt, eventindexer.SaveEventOpts{ProvingWindow: &provingWindow})
+	save(eventindexer.EventNameProved, "0xbbbb", 4, proposedAt.Add(4*time.Minute), eventindexer.SaveEventOpts{})
+}
+
+func TestIntegration_Event_GetProposalAnalytics(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	saveProposalAnalyticsEvents(t, eventRepo)
+
+	req, err := http.NewRequest("GET", "/", nil)
+	assert.Equal(t, nil, err)
+
+	page, err := eventRepo.GetProposalAnalytics(context.Background(), req, "0xaaaa")
+	assert.Equal(t, nil, err)
+
+	proposals := *page.Items.(*[]eventindexer.ProposalAnalyticsResponse)
+	assert.Equal(t, 3, len(proposals))
+
+	lateWithoutBond := proposals[0]
+	assert.Equal(t, int64(4), lateWithoutBond.ProposalID)
+	assert.Equal(t, int64(240), *lateWithoutBond.ProvingLatency)
+	assert.True(t, *lateWithoutBond.MissedProvingWindow)
+	assert.False(t, lateWithoutBond.LivenessBondSettled)
+	assert.False(t, lateWithoutBond.LivenessBondSlashed.Valid)
+
+	late := proposals[1]
+	assert.Equal(t, int64(2), late.ProposalID)
+	assert.Equal(t, "0xbbbb", *late.Prover)
+	assert.Equal(t, int64(180), *late.ProvingLatency)
+	assert.False(t, *late.ProvedByProposer)
+	assert.Equal(t, int64(120), *late.ProvingWindow)
+	assert.True(t, *late.MissedProvingWindow)
+	assert.True(t, late.LivenessBondSettled)
+	assert.Equal(t, "50", late.LivenessBondSlashed.Decimal.String())
+
+	onTime := proposals[2]
+	assert.Equal(t, int64(1), onTime.ProposalID)
+	assert.Equal(t, int64(3), onTime.NumBlobs)
+	assert.Equal(t, int64(60), *onTime.ProvingLatency)
+	assert.True(t, *onTime.ProvedByProposer)
+	assert.False(t, *onTime.MissedProvingWindow)
+	assert.False(t, onTime.LivenessBondSettled)
+	assert.False(t, onTime.LivenessBondSlashed.Valid)
+
+	page, err = eventRepo.GetProposalAnalytics(context.Background(), req, "0xbbbb")
+	assert.Equal(t, nil, err)
+
+	proposals = *page.Items.(*[]eventindexer.ProposalAnalyticsResponse)
+	assert.Equal(t, 1, len(proposals))
+	assert.Nil(t, proposals[0].Prover)
+	assert.Nil(t, proposals[0].ProvingLatency)
+	assert.Nil(t, proposals[0].MissedProvingWindow)
+}
+
+func TestIntegration_Event_FindProverStats(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	eventRepo, err := NewEventRepository(db)
+	assert.Equal(t, nil, err)
+
+	saveProposalAnalyticsEvents(t, eventRepo)
+
+	stats, err := eventRepo.FindProverStats(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(stats))
+
+	assert.Equal(t, "0xaaaa", stats[0].Address)
+	assert.Equal(t, 3, stats[0].Proposals)
+	assert.Equal(t, 3, stats[0].Proved)
+	assert.Equal(t, 1, stats[0].ProvedBySelf)
+	assert.Equal(t, 2, stats[0].ProvedByOthers)
+	assert.Equal(t, 2, stats[0].MissedProvingWindow)
+	assert.Equal(t, 1, stats[0].LivenessBondsSettled)
+	assert.Equal(t, float64(160), *stats[0].AvgProvingLatency)
+	assert.Equal(t, "50", stats[0].LivenessBondSlashed.String())
+
+	assert.Equal(t, "0xbbbb", stats[1].Address)
+	assert.Equal(t, 1, stats[1].Proposals)
+	assert.Equal(t, 0, stats[1].Proved)
+	assert.Nil(t, stats[1].AvgProvingLatency)
+}
@@ -2,8 +2,10 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -116,10 +118,33 @@ func (r *NFTBalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	ctx context.Context,
 	increaseOpts eventindexer.UpdateNFTBalanceOpts,
 	decreaseOpts eventindexer.UpdateNFTBalanceOpts,
+	transferOpts eventindexer.BalanceTransferOpts,
 ) (increasedBalance *eventindexer.NFTBalance, decreasedBalance *eventindexer.NFTBalance, err error) {
+	transfer := &eventindexer.BalanceTransfer{
+		ChainID:         increaseOpts.ChainID,
+		BlockID:         transferOpts.BlockID,
+		BlockHash:       transferOpts.BlockHash,
+		TxHash:          transferOpts.TxHash,
+		LogIndex:        transferOpts.LogIndex,
+		BatchIndex:      transferOpts.BatchIndex,
+		ContractAddress: increaseOpts.ContractAddress,
+		ContractType:    increaseOpts.ContractType,
+		TokenID:         sql.NullInt64{Valid: true, Int64: increaseOpts.TokenID},
+		ToAddress:       increaseOpts.Address,
+		Amount:          strconv.FormatInt(increaseOpts.Amount, 10),
+	}
+
+	if decreaseOpts.Amount != 0 {
+		transfer.FromAddress = decreaseOpts.Address
+	}
+
 	retries := 10
 	for retries > 0 {
 		err = r.db.GormDB().Transaction(func(tx *gorm.DB) (err error) {
+			if err := saveBalanceTransfer(tx.WithContext(ctx), transfer); err != nil {
+				return err
+			}
+
 			increasedBalance, err = r.increaseBalanceInDB(ctx, tx, increaseOpts)
 			if err != nil {
 				return err
@@ -136,6 +161,12 @@ func (r *NFTBalanceRepository) IncreaseAndDecreaseBalancesInTx(
 			break
 		}
 
+		if errors.Is(err, errTransferAlreadyApplied) {
+			slog.Info("nft transfer already applied", "txHash", transferOpts.TxHash, "logIndex", transferOpts.LogIndex)
+
+			return nil, nil, nil
+		}
+
 		if strings.Contains(err.Error(), "Deadlock") {
 			slog.Warn("database deadlock")
 
@@ -156,6 +187,132 @@ func (r *NFTBalanceRepository) IncreaseAndDecreaseBalancesInTx(
 	return increasedBalance, decreasedBalance, nil
 }
 
+// RollbackAllAfterBlockID reverts the ERC721 and ERC1155 transfers applied from blockID on,
+// when the blocks were reorged out and their events deleted by DeleteAllAfterBlockID.
+func (r *NFTBalanceRepository) RollbackAllAfterBlockID(ctx context.Context, blockID uint64, chainID int64) error {
+	return r.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		transfers, err := findBalanceTransfersToRollback(
+			tx,
+			blockID,
+			chainID,
+			[]string{eventindexer.ContractTypeERC721, eventindexer.ContractTypeERC1155},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range transfers {
+			amount, err := strconv.ParseInt(t.Amount, 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "strconv.ParseInt")
+			}
+
+			opts := eventindexer.UpdateNFTBalanceOpts{
+				ChainID:         t.ChainID,
+				TokenID:         t.TokenID.Int64,
+				ContractAddress: t.ContractAddress,
+				ContractType:    t.ContractType,
+				Amount:          amount,
+			}
+
+			if t.ToAddress != "" {
+				opts.Address = t.ToAddress
+
+				if _, err := r.decreaseBalanceInDB(ctx, tx, opts); err != nil {
+					return err
+				}
+			}
+
+			if t.FromAddress != "" {
+				opts.Address = t.FromAddress
+
+				if _, err := r.increaseBalanceInDB(ctx, tx, opts); err != nil {
+					return err
+				}
+			}
+		}
+
+		return deleteBalanceTransfers(tx, transfers)
+	})
+}
+
+// FindRandom returns a random sample of the balances of the chain, to reconcile.
+func (r *NFTBalanceRepository) FindRandom(
+	ctx context.Context,
+	chainID int64,
+	limit int,
+) ([]*eventindexer.NFTBalance, error) {
+	return findRandomSample[eventindexer.NFTBalance](r.db.GormDB().WithContext(ctx), "nft_balances", chainID, limit)
+}
+
+// SetBalance sets the balance of the address to the amount of the opts, as read on-chain.
+func (r *NFTBalanceRepository) SetBalance(ctx context.Context, opts eventindexer.UpdateNFTBalanceOpts) error {
+	b := &eventindexer.NFTBalance{
+		ContractAddress: opts.ContractAddress,
+		TokenID:         opts.TokenID,
+		Address:         opts.Address,
+		ContractType:    opts.ContractType,
+		ChainID:         opts.ChainID,
+	}
+
+	err := r.db.GormDB().WithContext(ctx).
+		Where("contract_address = ?", opts.ContractAddress).
+		Where("token_id = ?", opts.TokenID).
+		Where("address = ?", opts.Address).
+		Where("chain_id = ?", opts.ChainID).
+		First(b).
+		Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return errors.Wrap(err, "r.db.gormDB.First")
+	}
+
+	if opts.Amount == 0 {
+		if b.ID == 0 {
+			return nil
+		}
+
+		if err := r.db.GormDB().WithContext(ctx).Delete(b).Error; err != nil {
+			return errors.Wrap(err, "r.db.Delete")
+		}
+
+		return nil
+	}
+
+	b.Amount = opts.Amount
+
+	if err := r.db.GormDB().WithContext(ctx).Save(b).Error; err != nil {
+		return errors.Wrap(err, "r.db.Save")
+	}
+
+	return nil
+}
+
+// Find returns the non-zero balances matching opts.
+func (r *NFTBalanceRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindNFTBalancesOpts,
+) ([]*eventindexer.NFTBalance, error) {
+	balances := make([]*eventindexer.NFTBalance, 0)
+
+	q := r.db.GormDB().WithContext(ctx).
+		Where("address = ?", opts.Address).
+		Where("amount > 0")
+
+	if opts.ChainID != 0 {
+		q = q.Where("chain_id = ?", opts.ChainID)
+	}
+
+	if opts.ContractAddress != "" {
+		q = q.Where("contract_address = ?", opts.ContractAddress)
+	}
+
+	if err := q.Order("id").Offset(opts.Offset).Limit(opts.Limit).Find(&balances).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return balances, nil
+}
+
 func (r *NFTBalanceRepository) FindByAddress(ctx context.Context,
 	req *http.Request,
 	address string,
@@ -57,7 +57,8 @@ func TestIntegration_NFTBalance_Increase_And_Decrease(t *testing.T) {
 			ContractAddress: "0x123",
 			ContractType:    "ERC721",
 			Amount:          1,
-		}, eventindexer.UpdateNFTBalanceOpts{})
+		}, eventindexer.UpdateNFTBalanceOpts{},
+		eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01", LogIndex: 0})
 	assert.Equal(t, nil, err)
 	assert.NotNil(t, bal1)
 
@@ -69,7 +70,8 @@ func TestIntegration_NFTBalance_Increase_And_Decrease(t *testing.T) {
 			ContractAddress: "0x123456",
 			ContractType:    "ERC721",
 			Amount:          2,
-		}, eventindexer.UpdateNFTBalanceOpts{})
+		}, eventindexer.UpdateNFTBalanceOpts{},
+		eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01", LogIndex: 1})
 	assert.Equal(t, nil, err)
 	assert.NotNil(t, bal2)
 
@@ -77,6 +79,7 @@ func TestIntegration_NFTBalance_Increase_And_Decrease(t *testing.T) {
 		name         string
 		increaseOpts eventindexer.UpdateNFTBalanceOpts
 		decreaseOpts eventindexer.UpdateNFTBalanceOpts
+		transferOpts eventindexer.BalanceTransferOpts
 		wantErr      error
 	}{
 		{
@@ -97,6 +100,7 @@ func TestIntegration_NFTBalance_Increase_And_Decrease(t *testing.T) {
 				ContractType:    "ERC721",
 				Amount:          1,
 			},
+			eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x02", TxHash: "0x02", LogIndex: 2},
 			nil,
 		},
 		{
@@ -117,13 +121,19 @@ func TestIntegration_NFTBalance_Increase_And_Decrease(t *testing.T) {
 				ContractType:    "ERC721",
 				Amount:          1,
 			},
+			eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x02", TxHash: "0x02", LogIndex: 3},
 			nil,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(), tt.increaseOpts, tt.decreaseOpts)
+			_, _, err := nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(
+				context.Background(),
+				tt.increaseOpts,
+				tt.decreaseOpts,
+				tt.transferOpts,
+			)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
@@ -166,3 +176,100 @@ func TestIntegration_NFTBalance_FindByAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_NFTBalance_RollbackAllAfterBlockID(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	nftBalanceRepo, err := NewNFTBalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	opts := func(address string) eventindexer.UpdateNFTBalanceOpts {
+		return eventindexer.UpdateNFTBalanceOpts{
+			ChainID:         1,
+			Address:         address,
+			TokenID:         1,
+			ContractAddress: "0x123",
+			ContractType:    "ERC721",
+			Amount:          1,
+		}
+	}
+
+	// mint the token to 0x456 in block 1, then send it to 0x789 in block 2
+	_, _, err = nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+		opts("0x456"), eventindexer.UpdateNFTBalanceOpts{},
+		eventindexer.BalanceTransferOpts{BlockID: 1, BlockHash: "0x01", TxHash: "0x01"})
+	assert.Nil(t, err)
+
+	_, _, err = nftBalanceRepo.IncreaseAndDecreaseBalancesInTx(context.Background(),
+		opts("0x789"), opts("0x456"),
+		eventindexer.BalanceTransferOpts{BlockID: 2, BlockHash: "0x02", TxHash: "0x02"})
+	assert.Nil(t, err)
+
+	assert.Nil(t, nftBalanceRepo.RollbackAllAfterBlockID(context.Background(), 2, 1))
+
+	balances, err := nftBalanceRepo.FindRandom(context.Background(), 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(balances))
+	assert.Equal(t, "0x456", balances[0].Address)
+	assert.Equal(t, int64(1), balances[0].Amount)
+}
+
+func TestIntegration_NFTBalance_Find(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	nftBalanceRepo, err := NewNFTBalanceRepository(db)
+	assert.Equal(t, nil, err)
+
+	for _, tokenID := range []int64{1, 2} {
+		assert.Nil(t, nftBalanceRepo.SetBalance(context.Background(), eventindexer.UpdateNFTBalanceOpts{
+			ChainID:         1,
+			Address:         "0x456",
+			TokenID:         tokenID,
+			ContractAddress: "0x123",
+			ContractType:    eventindexer.ContractTypeERC1155,
+			Amount:          tokenID,
+		}))
+	}
+
+	tests := []struct {
+		name         string
+		opts         eventindexer.FindNFTBalancesOpts
+		wantTokenIDs []int64
+	}{
+		{
+			"success",
+			eventindexer.FindNFTBalancesOpts{Address: "0x456", ChainID: 1, Limit: 10},
+			[]int64{1, 2},
+		},
+		{
+			"paginated",
+			eventindexer.FindNFTBalancesOpts{Address: "0x456", Offset: 1, Limit: 10},
+			[]int64{2},
+		},
+		{
+			"otherContract",
+			eventindexer.FindNFTBalancesOpts{Address: "0x456", ContractAddress: "0x789", Limit: 10},
+			[]int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balances, err := nftBalanceRepo.Find(context.Background(), tt.opts)
+			assert.Nil(t, err)
+
+			tokenIDs := make([]int64, 0, len(balances))
+			for _, b := range balances {
+				tokenIDs = append(tokenIDs, b.TokenID)
+			}
+
+			assert.Equal(t, tt.wantTokenIDs, tokenIDs)
+		})
+	}
+}
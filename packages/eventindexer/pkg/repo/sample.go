@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"math/rand/v2"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// findRandomSample returns up to limit rows of the chain from a table, read by id from a
+// random id on and wrapping around to the first ids, as ordering a large table by RAND()
+// scans and sorts all of its rows.
+func findRandomSample[T any](db *gorm.DB, table string, chainID int64, limit int) ([]*T, error) {
+	rows := make([]*T, 0, limit)
+
+	var bounds struct {
+		MinID int64
+		MaxID int64
+	}
+
+	if err := db.Raw("SELECT COALESCE(MIN(id), 0) AS min_id, COALESCE(MAX(id), 0) AS max_id FROM " + table).
+		Scan(&bounds).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	if bounds.MaxID == 0 || limit <= 0 {
+		return rows, nil
+	}
+
+	from := bounds.MinID + rand.Int64N(bounds.MaxID-bounds.MinID+1)
+
+	if err := db.Raw("SELECT * FROM "+table+" WHERE chain_id = ? AND id >= ? ORDER BY id LIMIT ?",
+		chainID, from, limit).Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	if len(rows) == limit {
+		return rows, nil
+	}
+
+	var wrapped []*T
+
+	if err := db.Raw("SELECT * FROM "+table+" WHERE chain_id = ? AND id < ? ORDER BY id LIMIT ?",
+		chainID, from, limit-len(rows)).Scan(&wrapped).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return append(rows, wrapped...), nil
+}
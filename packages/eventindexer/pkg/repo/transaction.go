@@ -40,12 +40,14 @@ func (r *TransactionRepository) Save(
 	blockID *big.Int,
 	transactedAt time.Time,
 	contractAddress common.Address,
+	gasUsed uint64,
 ) error {
 	t := &eventindexer.Transaction{
 		ChainID:         tx.ChainId().Int64(),
 		Sender:          sender.Hex(),
 		BlockID:         blockID.Int64(),
 		GasPrice:        tx.GasPrice().String(),
+		GasUsed:         gasUsed,
 		TransactedAt:    transactedAt,
 		ContractAddress: contractAddress.Hex(),
 	}
@@ -76,3 +78,27 @@ func (r *TransactionRepository) Save(
 
 	return nil
 }
+
+// Find returns the transactions matching opts, the latest first.
+func (r *TransactionRepository) Find(
+	ctx context.Context,
+	opts eventindexer.FindTransactionsOpts,
+) ([]*eventindexer.Transaction, error) {
+	txs := make([]*eventindexer.Transaction, 0)
+
+	q := r.db.GormDB().WithContext(ctx)
+
+	if opts.Sender != "" {
+		q = q.Where("sender = ?", opts.Sender)
+	}
+
+	if opts.ChainID != 0 {
+		q = q.Where("chain_id = ?", opts.ChainID)
+	}
+
+	if err := q.Order("id DESC").Offset(opts.Offset).Limit(opts.Limit).Find(&txs).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return txs, nil
+}
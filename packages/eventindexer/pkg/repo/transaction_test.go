@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/taikoxyz/taiko-mono/packages/eventindexer"
 	"github.com/taikoxyz/taiko-mono/packages/eventindexer/pkg/db"
 )
 
@@ -71,6 +72,7 @@ func TestIntegration_Transaction_Save(t *testing.T) {
 		blockID         *big.Int
 		transactedAt    time.Time
 		contractAddress common.Address
+		gasUsed         uint64
 		wantErr         error
 	}{
 		{
@@ -80,6 +82,7 @@ func TestIntegration_Transaction_Save(t *testing.T) {
 			big.NewInt(1),
 			time.Now(),
 			common.HexToAddress("0x3a537c89809712367218bb171b3b1c46aa95df3dee7200ae9dc78f4052024068"),
+			21000,
 			nil,
 		},
 	}
@@ -93,8 +96,66 @@ func TestIntegration_Transaction_Save(t *testing.T) {
 				tt.blockID,
 				tt.transactedAt,
 				tt.contractAddress,
+				tt.gasUsed,
 			)
 			assert.Equal(t, tt.wantErr, err)
 		})
 	}
 }
+
+func TestIntegration_Transaction_Find(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	txRepo, err := NewTransactionRepository(db)
+	assert.Equal(t, nil, err)
+
+	sender := common.HexToAddress("0x123")
+	to := common.HexToAddress("0x456")
+
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		tx := types.NewTx(&types.AccessListTx{
+			Nonce:      nonce,
+			GasPrice:   big.NewInt(10),
+			Gas:        21000,
+			To:         &to,
+			Value:      big.NewInt(1),
+			Data:       []byte{},
+			V:          big.NewInt(1),
+			R:          big.NewInt(1),
+			S:          big.NewInt(1),
+			ChainID:    big.NewInt(1),
+			AccessList: make(types.AccessList, 0),
+		})
+
+		err := txRepo.Save(
+			context.Background(),
+			tx,
+			sender,
+			new(big.Int).SetUint64(nonce),
+			time.Now(),
+			ZeroAddress,
+			21000,
+		)
+		assert.Equal(t, nil, err)
+	}
+
+	txs, err := txRepo.Find(context.Background(), eventindexer.FindTransactionsOpts{
+		Sender: sender.Hex(),
+		Offset: 1,
+		Limit:  10,
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(txs))
+	assert.Equal(t, int64(1), txs[0].BlockID)
+	assert.Equal(t, uint64(21000), txs[0].GasUsed)
+
+	txs, err = txRepo.Find(context.Background(), eventindexer.FindTransactionsOpts{
+		Sender: to.Hex(),
+		Limit:  10,
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(txs))
+}
@@ -102,6 +102,30 @@ var (
 		Name: "liquidity_added_events_processed_error_ops_total",
 		Help: "The total number of processed LiquidityAdded event errors encountered",
 	})
+	TaikoTokenEventsProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "taiko_token_events_processed_ops_total",
+		Help: "The total number of processed TaikoToken delegation and transfer events",
+	})
+	TaikoTokenEventsProcessedError = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "taiko_token_events_processed_error_ops_total",
+		Help: "The total number of processed TaikoToken delegation and transfer event errors encountered",
+	})
+	SgxInstanceEventsProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "sgx_instance_events_processed_ops_total",
+		Help: "The total number of processed SGX instance added and deleted events",
+	})
+	SgxInstanceEventsProcessedError = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "sgx_instance_events_processed_error_ops_total",
+		Help: "The total number of processed SGX instance added and deleted event errors encountered",
+	})
+	LivenessBondSettledEventsProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "liveness_bond_settled_events_processed_ops_total",
+		Help: "The total number of processed LivenessBondSettled events",
+	})
+	LivenessBondSettledEventsProcessedError = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "liveness_bond_settled_events_processed_error_ops_total",
+		Help: "The total number of processed LivenessBondSettled event errors encountered",
+	})
 	BlocksProcessed = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "blocks_processed_ops_total",
 		Help: "The total number of processed blocks",
@@ -110,4 +134,28 @@ var (
 		Name: "errors_encountered_during_subscription_opts_total",
 		Help: "The total number of errors that occurred during active subscription",
 	})
+	TimeSeriesDataGenerated = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "time_series_data_generated_ops_total",
+		Help: "The total number of generated time series data dates",
+	})
+	TimeSeriesDataGeneratedError = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "time_series_data_generated_error_ops_total",
+		Help: "The total number of time series data generation errors encountered",
+	})
+	ReorgsDetected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "reorgs_detected_ops_total",
+		Help: "The total number of reorgs detected from the blocks transfers were applied from",
+	})
+	BalancesReconciled = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "balances_reconciled_ops_total",
+		Help: "The total number of indexed balances compared with the chain",
+	})
+	ERC20BalancesDrifted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "erc20_balances_drifted_ops_total",
+		Help: "The total number of indexed ERC20 balances found to differ from the chain, and corrected",
+	})
+	NFTBalancesDrifted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "nft_balances_drifted_ops_total",
+		Help: "The total number of indexed NFT balances found to differ from the chain, and corrected",
+	})
 )
@@ -18,10 +18,19 @@ type Transaction struct {
 	BlockID         int64               `json:"blockID"`
 	Amount          decimal.NullDecimal `json:"amount"`
 	GasPrice        string              `json:"gasPrice"`
+	GasUsed         uint64              `json:"gasUsed"`
 	TransactedAt    time.Time           `json:"transactedAt"`
 	ContractAddress string              `json:"contractAddress"`
 }
 
+// FindTransactionsOpts filters the transactions found, an empty field matching any value.
+type FindTransactionsOpts struct {
+	Sender  string
+	ChainID int64
+	Offset  int
+	Limit   int
+}
+
 type TransactionRepository interface {
 	Save(
 		ctx context.Context,
@@ -29,5 +38,7 @@ type TransactionRepository interface {
 		sender common.Address,
 		blockID *big.Int,
 		timestamp time.Time,
-		contractAddress common.Address) error
+		contractAddress common.Address,
+		gasUsed uint64) error
+	Find(ctx context.Context, opts FindTransactionsOpts) ([]*Transaction, error)
 }
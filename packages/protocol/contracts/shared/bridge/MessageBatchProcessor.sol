@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+import "@openzeppelin/contracts/security/ReentrancyGuard.sol";
+import "../libs/LibAddress.sol";
+import "./IBridge.sol";
+
+/// @title MessageBatchProcessor
+/// @notice Processes a batch of messages on the bridge in a single transaction. The bridge pays
+/// the processing fee of a message to its caller, this contract, which forwards the fees of the
+/// batch to the relayer that sent it.
+/// @dev A message failing to be processed does not revert the batch. The contract holds no
+/// Ether between transactions, and any Ether sent to it is forwarded to the next caller.
+/// @custom:security-contact security@taiko.xyz
+contract MessageBatchProcessor is ReentrancyGuard {
+    using LibAddress for address;
+
+    /// @notice The bridge processing the messages.
+    IBridge public immutable bridge;
+
+    error MBP_INVALID_CALL();
+    error MBP_INVALID_PARAM();
+
+    constructor(address _bridge) {
+        require(_bridge != address(0), MBP_INVALID_PARAM());
+        bridge = IBridge(_bridge);
+    }
+
+    /// @notice Receives the processing fees paid by the bridge.
+    receive() external payable { }
+
+    /// @notice Processes the messages, each with a processMessage call to the bridge, and sends
+    /// the processing fees paid to this contract to the caller.
+    /// @dev Nonreentrant, so a message invoking this contract can not take the fees of the
+    /// messages processed before it.
+    /// @param _calls The calldata of the processMessage calls.
+    /// @return succeeded_ Whether each call succeeded.
+    function processMessages(bytes[] calldata _calls)
+        external
+        nonReentrant
+        returns (bool[] memory succeeded_)
+    {
+        succeeded_ = new bool[](_calls.length);
+
+        for (uint256 i; i < _calls.length; ++i) {
+            require(
+                _calls[i].length >= 4 && bytes4(_calls[i][:4]) == IBridge.processMessage.selector,
+                MBP_INVALID_CALL()
+            );
+
+            (succeeded_[i],) = address(bridge).call(_calls[i]);
+        }
+
+        msg.sender.sendEtherAndVerify(address(this).balance);
+    }
+}
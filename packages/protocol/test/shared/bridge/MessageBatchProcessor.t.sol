@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.24;
+
+import "./TestBridge2Base.sol";
+import "src/shared/bridge/MessageBatchProcessor.sol";
+
+contract TestMessageBatchProcessor is TestBridge2Base {
+    MessageBatchProcessor internal processor;
+
+    function setUp() public override {
+        super.setUp();
+        processor = new MessageBatchProcessor(address(eBridge));
+    }
+
+    function test_messageBatchProcessor_processMessages_forwards_fees() public transactBy(Alice) {
+        IBridge.Message memory message1 = _message(1);
+        IBridge.Message memory message2 = _message(2);
+
+        bytes[] memory calls = new bytes[](2);
+        calls[0] = abi.encodeCall(IBridge.processMessage, (message1, FAKE_PROOF));
+        calls[1] = abi.encodeCall(IBridge.processMessage, (message2, FAKE_PROOF));
+
+        uint256 aliceBalance = Alice.balance;
+        uint256 bobBalance = Bob.balance;
+
+        bool[] memory succeeded = processor.processMessages(calls);
+
+        assertTrue(succeeded[0]);
+        assertTrue(succeeded[1]);
+        assertTrue(eBridge.messageStatus(eBridge.hashMessage(message1)) == IBridge.Status.DONE);
+        assertTrue(eBridge.messageStatus(eBridge.hashMessage(message2)) == IBridge.Status.DONE);
+
+        assertEq(Bob.balance, bobBalance + 4 ether);
+        assertEq(Alice.balance, aliceBalance + 10_000_000);
+        assertEq(address(processor).balance, 0);
+    }
+
+    function test_messageBatchProcessor_processMessages_failed_call_does_not_revert_batch()
+        public
+        transactBy(Alice)
+    {
+        IBridge.Message memory message1 = _message(1);
+        message1.srcChainId = ethereumChainId;
+        IBridge.Message memory message2 = _message(2);
+
+        bytes[] memory calls = new bytes[](2);
+        calls[0] = abi.encodeCall(IBridge.processMessage, (message1, FAKE_PROOF));
+        calls[1] = abi.encodeCall(IBridge.processMessage, (message2, FAKE_PROOF));
+
+        uint256 aliceBalance = Alice.balance;
+
+        bool[] memory succeeded = processor.processMessages(calls);
+
+        assertFalse(succeeded[0]);
+        assertTrue(succeeded[1]);
+        assertTrue(eBridge.messageStatus(eBridge.hashMessage(message1)) == IBridge.Status.NEW);
+        assertTrue(eBridge.messageStatus(eBridge.hashMessage(message2)) == IBridge.Status.DONE);
+
+        assertEq(Alice.balance, aliceBalance + 5_000_000);
+        assertEq(address(processor).balance, 0);
+    }
+
+    function test_messageBatchProcessor_processMessages_reverts_on_other_calls()
+        public
+        transactBy(Alice)
+    {
+        bytes[] memory calls = new bytes[](1);
+        calls[0] = abi.encodeCall(IBridge.retryMessage, (_message(1), false));
+
+        vm.expectRevert(MessageBatchProcessor.MBP_INVALID_CALL.selector);
+        processor.processMessages(calls);
+
+        calls[0] = hex"1234";
+
+        vm.expectRevert(MessageBatchProcessor.MBP_INVALID_CALL.selector);
+        processor.processMessages(calls);
+    }
+
+    function _message(uint64 _id) private view returns (IBridge.Message memory message_) {
+        message_.id = _id;
+        message_.destChainId = ethereumChainId;
+        message_.srcChainId = taikoChainId;
+        message_.gasLimit = 1;
+        message_.fee = 5_000_000;
+        message_.value = 2 ether;
+        message_.destOwner = Bob;
+    }
+}
@@ -46,6 +46,16 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 		return err
 	}
 
+	messageHistoryRepository, err := repo.NewMessageHistoryRepository(db)
+	if err != nil {
+		return err
+	}
+
+	webhookRepository, err := repo.NewWebhookRepository(db)
+	if err != nil {
+		return err
+	}
+
 	ctxDial, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
@@ -60,12 +70,19 @@ func InitFromConfig(ctx context.Context, api *API, cfg *Config) (err error) {
 	}
 
 	srv, err := http.NewServer(http.NewServerOpts{
-		EventRepo:               eventRepository,
-		Echo:                    echo.New(),
-		CorsOrigins:             cfg.CORSOrigins,
-		SrcEthClient:            srcEthClient,
-		DestEthClient:           destEthClient,
-		ProcessingFeeMultiplier: cfg.ProcessingFeeMultiplier,
+		EventRepo:                   eventRepository,
+		MessageHistoryRepo:          messageHistoryRepository,
+		WebhookRepo:                 webhookRepository,
+		Echo:                        echo.New(),
+		CorsOrigins:                 cfg.CORSOrigins,
+		SrcEthClient:                srcEthClient,
+		DestEthClient:               destEthClient,
+		ProcessingFeeMultiplier:     cfg.ProcessingFeeMultiplier,
+		FeeHistorySize:              cfg.FeeHistorySize,
+		BaseFeeForecastBlocks:       cfg.BaseFeeForecastBlocks,
+		AdminToken:                  cfg.AdminToken,
+		MessageStreamInterval:       cfg.MessageStreamInterval,
+		MessageStreamMaxConnections: cfg.MessageStreamMaxConns,
 	})
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package api
 
 import (
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
@@ -26,8 +27,13 @@ type Config struct {
 	SrcRPCUrl               string
 	DestRPCUrl              string
 	ProcessingFeeMultiplier float64
+	FeeHistorySize          int
+	BaseFeeForecastBlocks   uint64
 	DestTaikoAddress        common.Address
 	HTTPPort                uint64
+	AdminToken              string
+	MessageStreamInterval   time.Duration
+	MessageStreamMaxConns   int
 	OpenDBFunc              func() (db.DB, error)
 }
 
@@ -46,7 +52,12 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		SrcRPCUrl:               c.String(flags.SrcRPCUrl.Name),
 		DestRPCUrl:              c.String(flags.DestRPCUrl.Name),
 		ProcessingFeeMultiplier: c.Float64(flags.ProcessingFeeMultiplier.Name),
+		FeeHistorySize:          c.Int(flags.FeeHistorySize.Name),
+		BaseFeeForecastBlocks:   c.Uint64(flags.BaseFeeForecastBlocks.Name),
 		DestTaikoAddress:        common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
+		AdminToken:              c.String(flags.AdminToken.Name),
+		MessageStreamInterval:   c.Duration(flags.MessageStreamInterval.Name),
+		MessageStreamMaxConns:   c.Int(flags.MessageStreamMaxConnections.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
@@ -48,6 +48,8 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, "srcRpcUrl", c.SrcRPCUrl)
 		assert.Equal(t, "destRpcUrl", c.DestRPCUrl)
 		assert.Equal(t, destTaikoAddress, c.DestTaikoAddress.Hex())
+		assert.Equal(t, 50, c.FeeHistorySize)
+		assert.Equal(t, uint64(3), c.BaseFeeForecastBlocks)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -72,5 +74,7 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
 		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
 		"--" + flags.DestTaikoAddress.Name, destTaikoAddress,
+		"--" + flags.FeeHistorySize.Name, "50",
+		"--" + flags.BaseFeeForecastBlocks.Name, "3",
 	}))
 }
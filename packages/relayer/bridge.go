@@ -12,6 +12,8 @@ type Bridge interface {
 	FilterMessageProcessed(opts *bind.FilterOpts, msgHash [][32]byte) (*bridge.BridgeMessageProcessedIterator, error)
 	MessageStatus(opts *bind.CallOpts, msgHash [32]byte) (uint8, error)
 	ProcessMessage(opts *bind.TransactOpts, _message bridge.IBridgeMessage, _proof []byte) (*types.Transaction, error)
+	RetryMessage(opts *bind.TransactOpts, _message bridge.IBridgeMessage, _isLastAttempt bool) (*types.Transaction, error)
+	SignalForFailedMessage(opts *bind.CallOpts, _msgHash [32]byte) ([32]byte, error)
 	FilterMessageStatusChanged(
 		opts *bind.FilterOpts,
 		msgHash [][32]byte,
@@ -3,7 +3,6 @@ package bridge
 import (
 	"context"
 	"crypto/ecdsa"
-	"encoding/hex"
 	"log/slog"
 	"math/big"
 	"sync"
@@ -19,6 +18,10 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/erc1155vault"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/erc20vault"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/erc721vault"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 )
 
 type ethClient interface {
@@ -28,11 +31,24 @@ type ethClient interface {
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
 	BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error)
 	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 	ChainID(ctx context.Context) (*big.Int, error)
 }
 
+type erc20Vault interface {
+	SendToken(opts *bind.TransactOpts, op erc20vault.ERC20VaultBridgeTransferOp) (*types.Transaction, error)
+}
+
+type erc721Vault interface {
+	SendToken(opts *bind.TransactOpts, op erc721vault.BaseNFTVaultBridgeTransferOp) (*types.Transaction, error)
+}
+
+type erc1155Vault interface {
+	SendToken(opts *bind.TransactOpts, op erc1155vault.BaseNFTVaultBridgeTransferOp) (*types.Transaction, error)
+}
+
 type Bridge struct {
 	cancel context.CancelFunc
 
@@ -44,11 +60,14 @@ type Bridge struct {
 	srcBridge  relayer.Bridge
 	destBridge relayer.Bridge
 
+	srcBridgeAddress common.Address
+
 	addr common.Address
 
 	backOffRetryInterval time.Duration
 	backOffMaxRetries    uint64
 	ethClientTimeout     time.Duration
+	confTimeout          time.Duration
 
 	wg sync.WaitGroup
 
@@ -56,6 +75,32 @@ type Bridge struct {
 	destChainId *big.Int
 
 	bridgeMessageValue *big.Int
+
+	messageCount     uint64
+	txInterval       time.Duration
+	concurrency      uint64
+	trafficMix       TrafficMix
+	minFee           uint64
+	maxFee           uint64
+	randomRecipients bool
+	reportTimeout    time.Duration
+
+	erc20Vault     erc20Vault
+	erc721Vault    erc721Vault
+	erc1155Vault   erc1155Vault
+	tokenApprovals []tokenApproval
+
+	erc20Address   common.Address
+	erc721Address  common.Address
+	erc721TokenIDs []*big.Int
+	erc1155Address common.Address
+	erc1155TokenID *big.Int
+	tokenAmount    *big.Int
+
+	nonceMu sync.Mutex
+	nonce   *big.Int
+
+	messageHistoryRepo relayer.MessageHistoryRepository
 }
 
 func (b *Bridge) InitFromCli(ctx context.Context, c *cli.Context) error {
@@ -106,11 +151,47 @@ func InitFromConfig(ctx context.Context, b *Bridge, cfg *Config) error {
 		return errors.New("unable to convert public key")
 	}
 
+	if cfg.TrafficMix[transferKindERC20] > 0 {
+		if b.erc20Vault, err = erc20vault.NewERC20Vault(cfg.SrcERC20VaultAddress, srcEthClient); err != nil {
+			return err
+		}
+	}
+
+	if cfg.TrafficMix[transferKindERC721] > 0 {
+		if b.erc721Vault, err = erc721vault.NewERC721Vault(cfg.SrcERC721VaultAddress, srcEthClient); err != nil {
+			return err
+		}
+	}
+
+	if cfg.TrafficMix[transferKindERC1155] > 0 {
+		if b.erc1155Vault, err = erc1155vault.NewERC1155Vault(cfg.SrcERC1155VaultAddress, srcEthClient); err != nil {
+			return err
+		}
+	}
+
+	tokenApprovals, err := newTokenApprovals(cfg, srcEthClient)
+	if err != nil {
+		return err
+	}
+
+	// the processing of the messages is only reported when waiting for it.
+	if cfg.ReportTimeout > 0 {
+		db, err := cfg.OpenDBFunc()
+		if err != nil {
+			return err
+		}
+
+		if b.messageHistoryRepo, err = repo.NewMessageHistoryRepository(db); err != nil {
+			return err
+		}
+	}
+
 	b.srcEthClient = srcEthClient
 	b.destEthClient = destEthClient
 
 	b.destBridge = destBridge
 	b.srcBridge = srcBridge
+	b.srcBridgeAddress = cfg.SrcBridgeAddress
 
 	b.ecdsaKey = cfg.BridgePrivateKey
 	b.addr = crypto.PubkeyToAddress(*publicKeyECDSA)
@@ -121,9 +202,27 @@ func InitFromConfig(ctx context.Context, b *Bridge, cfg *Config) error {
 	b.backOffRetryInterval = time.Duration(cfg.BackoffRetryInterval) * time.Second
 	b.backOffMaxRetries = cfg.BackOffMaxRetries
 	b.ethClientTimeout = time.Duration(cfg.ETHClientTimeout) * time.Second
+	b.confTimeout = time.Duration(cfg.ConfirmationsTimeout) * time.Second
 
 	b.bridgeMessageValue = cfg.BridgeMessageValue
 
+	b.messageCount = cfg.MessageCount
+	b.txInterval = time.Duration(float64(time.Second) / cfg.TxsPerSecond)
+	b.concurrency = cfg.Concurrency
+	b.trafficMix = cfg.TrafficMix
+	b.minFee = cfg.MinFee
+	b.maxFee = cfg.MaxFee
+	b.randomRecipients = cfg.RandomRecipients
+	b.reportTimeout = cfg.ReportTimeout
+
+	b.tokenApprovals = tokenApprovals
+	b.erc20Address = cfg.ERC20Address
+	b.erc721Address = cfg.ERC721Address
+	b.erc721TokenIDs = cfg.ERC721TokenIDs
+	b.erc1155Address = cfg.ERC1155Address
+	b.erc1155TokenID = cfg.ERC1155TokenID
+	b.tokenAmount = cfg.TokenAmount
+
 	return nil
 }
 
@@ -137,29 +236,86 @@ func (b *Bridge) Close(ctx context.Context) {
 	b.wg.Wait()
 }
 
+// WaitForInterrupt returns false, the bridge exits once its transfers are sent and reported.
+func (b *Bridge) WaitForInterrupt() bool {
+	return false
+}
+
 func (b *Bridge) Start() error {
-	slog.Info("Start bridge")
+	slog.Info("Start bridge",
+		"messageCount", b.messageCount,
+		"txInterval", b.txInterval,
+		"concurrency", b.concurrency,
+		"trafficMix", b.trafficMix,
+	)
 
 	ctx, cancel := context.WithCancel(context.Background())
 
 	b.cancel = cancel
 
-	if err := b.submitBridgeTx(ctx); err != nil {
-		return err
+	if err := b.approveTokens(ctx); err != nil {
+		return errors.Wrap(err, "b.approveTokens")
+	}
+
+	startedAt := time.Now()
+
+	transfers := b.sendTransfers(ctx)
+
+	report := newLoadReport(time.Since(startedAt))
+
+	if err := b.waitForProcessing(ctx, transfers, report); err != nil {
+		return errors.Wrap(err, "b.waitForProcessing")
 	}
 
+	report.log()
+
 	return nil
 }
 
-func (b *Bridge) setLatestNonce(ctx context.Context, auth *bind.TransactOpts) error {
-	pendingNonce, err := b.srcEthClient.PendingNonceAt(ctx, b.addr)
+// nextNonce returns the nonce of the next transaction, the transactions being sent
+// concurrently from the same account.
+func (b *Bridge) nextNonce(ctx context.Context) (*big.Int, error) {
+	b.nonceMu.Lock()
+	defer b.nonceMu.Unlock()
+
+	if b.nonce == nil {
+		pendingNonce, err := b.srcEthClient.PendingNonceAt(ctx, b.addr)
+		if err != nil {
+			return nil, err
+		}
+
+		b.nonce = new(big.Int).SetUint64(pendingNonce)
+	}
+
+	nonce := new(big.Int).Set(b.nonce)
+
+	b.nonce.Add(b.nonce, common.Big1)
+
+	return nonce, nil
+}
+
+// resetNonce makes the next transaction use the pending nonce of the account again, after a
+// transaction failed to be sent and left its nonce unused.
+func (b *Bridge) resetNonce() {
+	b.nonceMu.Lock()
+	defer b.nonceMu.Unlock()
+
+	b.nonce = nil
+}
+
+func (b *Bridge) newTransactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	auth, err := bind.NewKeyedTransactorWithChainID(b.ecdsaKey, b.srcChainId)
 	if err != nil {
-		return err
+		return nil, errors.Wrap(err, "bind.NewKeyedTransactorWithChainID")
 	}
 
-	auth.Nonce = big.NewInt(int64(pendingNonce))
+	auth.Context = ctx
+
+	if auth.Nonce, err = b.nextNonce(ctx); err != nil {
+		return nil, errors.Wrap(err, "b.nextNonce")
+	}
 
-	return nil
+	return auth, nil
 }
 
 func (b *Bridge) estimateGas(
@@ -185,30 +341,14 @@ func (b *Bridge) estimateGas(
 	return tx.Gas() + gasPaddingAmt, nil
 }
 
-func (b *Bridge) submitBridgeTx(ctx context.Context) error {
-	srcChainId, err := b.srcEthClient.ChainID(ctx)
-	if err != nil {
-		return errors.Wrap(err, "b.srcEthClient.ChainID")
-	}
-
-	destChainId, err := b.destEthClient.ChainID(ctx)
-	if err != nil {
-		return errors.Wrap(err, "b.destEthClient.ChainID")
-	}
-
-	auth, err := bind.NewKeyedTransactorWithChainID(b.ecdsaKey, new(big.Int).SetUint64(srcChainId.Uint64()))
-	if err != nil {
-		return errors.Wrap(err, "b.NewKeyedTransactorWithChainID")
-	}
-
-	auth.Context = ctx
-
-	err = b.setLatestNonce(ctx, auth)
-	if err != nil {
-		return errors.Wrap(err, "b.setLatestNonce")
-	}
-
-	processingFee := big.NewInt(10000)
+// sendETH sends a message bridging the bridge message value of ETH.
+func (b *Bridge) sendETH(
+	ctx context.Context,
+	auth *bind.TransactOpts,
+	to common.Address,
+	fee uint64,
+) (*types.Transaction, error) {
+	processingFee := new(big.Int).SetUint64(fee)
 	value := new(big.Int)
 	value.Add(b.bridgeMessageValue, processingFee)
 	auth.Value = value
@@ -216,11 +356,11 @@ func (b *Bridge) submitBridgeTx(ctx context.Context) error {
 	message := bridge.IBridgeMessage{
 		Id:          0,
 		From:        b.addr,
-		SrcChainId:  srcChainId.Uint64(),
-		DestChainId: destChainId.Uint64(),
+		SrcChainId:  b.srcChainId.Uint64(),
+		DestChainId: b.destChainId.Uint64(),
 		SrcOwner:    b.addr,
-		DestOwner:   b.addr,
-		To:          b.addr,
+		DestOwner:   to,
+		To:          to,
 		Value:       b.bridgeMessageValue,
 		Fee:         processingFee.Uint64(),
 		GasLimit:    140000,
@@ -237,10 +377,8 @@ func (b *Bridge) submitBridgeTx(ctx context.Context) error {
 	tx, err := b.srcBridge.SendMessage(auth, message)
 	if err != nil {
 		slog.Error("Failed to send bridge message", "method", "b.srcBridge.SendMessage", "error", err)
-		return errors.Wrap(err, "rcBridge.SendMessage")
+		return nil, errors.Wrap(err, "rcBridge.SendMessage")
 	}
 
-	slog.Info("Sent tx", "txHash", hex.EncodeToString(tx.Hash().Bytes()))
-
-	return nil
+	return tx, nil
 }
@@ -5,11 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/urfave/cli/v2"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 type Config struct {
@@ -36,9 +42,33 @@ type Config struct {
 
 	// BridgeMessage
 	BridgeMessageValue *big.Int
+
+	// load generation configs
+	MessageCount     uint64
+	TxsPerSecond     float64
+	Concurrency      uint64
+	TrafficMix       TrafficMix
+	MinFee           uint64
+	MaxFee           uint64
+	RandomRecipients bool
+	ReportTimeout    time.Duration
+
+	// token transfer configs
+	SrcERC20VaultAddress   common.Address
+	SrcERC721VaultAddress  common.Address
+	SrcERC1155VaultAddress common.Address
+	ERC20Address           common.Address
+	ERC721Address          common.Address
+	ERC721TokenIDs         []*big.Int
+	ERC1155Address         common.Address
+	ERC1155TokenID         *big.Int
+	TokenAmount            *big.Int
+
+	OpenDBFunc func() (db.DB, error)
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
+// nolint: funlen
 func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 	bridgePrivateKey, err := crypto.ToECDSA(
 		common.Hex2Bytes(c.String(flags.BridgePrivateKey.Name)),
@@ -62,7 +92,17 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, err
 	}
 
-	return &Config{
+	trafficMix, err := parseTrafficMix(c.String(flags.BridgeTrafficMix.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	tokenAmount, ok := new(big.Int).SetString(c.String(flags.BridgeTokenAmount.Name), 10)
+	if !ok {
+		return nil, errors.New("invalid bridgeTokenAmount")
+	}
+
+	cfg := &Config{
 		BridgePrivateKey:     bridgePrivateKey,
 		DestBridgeAddress:    destBridgeAddress,
 		SrcBridgeAddress:     srcBridgeAddress,
@@ -75,7 +115,119 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		BackOffMaxRetries:    c.Uint64(flags.BackOffMaxRetries.Name),
 		ETHClientTimeout:     c.Uint64(flags.ETHClientTimeout.Name),
 		BridgeMessageValue:   bridgeMessageValue,
-	}, nil
+		MessageCount:         c.Uint64(flags.BridgeMessageCount.Name),
+		TxsPerSecond:         c.Float64(flags.BridgeTxsPerSecond.Name),
+		Concurrency:          c.Uint64(flags.BridgeConcurrency.Name),
+		TrafficMix:           trafficMix,
+		MinFee:               c.Uint64(flags.BridgeMinFee.Name),
+		MaxFee:               c.Uint64(flags.BridgeMaxFee.Name),
+		RandomRecipients:     c.Bool(flags.BridgeRandomRecipients.Name),
+		ReportTimeout:        c.Duration(flags.BridgeReportTimeout.Name),
+		ERC1155TokenID:       new(big.Int).SetUint64(c.Uint64(flags.BridgeERC1155TokenID.Name)),
+		TokenAmount:          tokenAmount,
+		OpenDBFunc: func() (db.DB, error) {
+			return db.OpenDBConnection(db.DBConnectionOpts{
+				Name:            c.String(flags.DatabaseUsername.Name),
+				Password:        c.String(flags.DatabasePassword.Name),
+				Database:        c.String(flags.DatabaseName.Name),
+				Host:            c.String(flags.DatabaseHost.Name),
+				MaxIdleConns:    c.Uint64(flags.DatabaseMaxIdleConns.Name),
+				MaxOpenConns:    c.Uint64(flags.DatabaseMaxOpenConns.Name),
+				MaxConnLifetime: c.Uint64(flags.DatabaseConnMaxLifetime.Name),
+				OpenFunc: func(dsn string) (db.DB, error) {
+					gormDB, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+						Logger: logger.Default.LogMode(logger.Silent),
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					return db.New(gormDB), nil
+				},
+			})
+		},
+	}
+
+	if cfg.MessageCount == 0 {
+		return nil, fmt.Errorf("%s must be greater than 0", flags.BridgeMessageCount.Name)
+	}
+
+	if cfg.TxsPerSecond <= 0 {
+		return nil, fmt.Errorf("%s must be greater than 0", flags.BridgeTxsPerSecond.Name)
+	}
+
+	if cfg.Concurrency == 0 {
+		return nil, fmt.Errorf("%s must be greater than 0", flags.BridgeConcurrency.Name)
+	}
+
+	if cfg.MinFee > cfg.MaxFee {
+		return nil, fmt.Errorf("%s must not be greater than %s", flags.BridgeMinFee.Name, flags.BridgeMaxFee.Name)
+	}
+
+	if err := cfg.parseTokenTransfers(c); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// parseTokenTransfers parses the vaults and tokens of the kinds of token transfers in the
+// traffic mix, which are required to send them.
+func (cfg *Config) parseTokenTransfers(c *cli.Context) error {
+	var err error
+
+	if cfg.TrafficMix[transferKindERC20] > 0 {
+		if cfg.SrcERC20VaultAddress, err = parseRequiredAddress(
+			c.String(flags.SrcERC20VaultAddress.Name), flags.SrcERC20VaultAddress.Name,
+		); err != nil {
+			return err
+		}
+
+		if cfg.ERC20Address, err = parseRequiredAddress(
+			c.String(flags.BridgeERC20Address.Name), flags.BridgeERC20Address.Name,
+		); err != nil {
+			return err
+		}
+	}
+
+	if cfg.TrafficMix[transferKindERC721] > 0 {
+		if cfg.SrcERC721VaultAddress, err = parseRequiredAddress(
+			c.String(flags.SrcERC721VaultAddress.Name), flags.SrcERC721VaultAddress.Name,
+		); err != nil {
+			return err
+		}
+
+		if cfg.ERC721Address, err = parseRequiredAddress(
+			c.String(flags.BridgeERC721Address.Name), flags.BridgeERC721Address.Name,
+		); err != nil {
+			return err
+		}
+
+		for _, id := range strings.Split(c.String(flags.BridgeERC721TokenIDs.Name), ",") {
+			tokenID, ok := new(big.Int).SetString(strings.TrimSpace(id), 10)
+			if !ok {
+				return fmt.Errorf("invalid %s: %s", flags.BridgeERC721TokenIDs.Name, id)
+			}
+
+			cfg.ERC721TokenIDs = append(cfg.ERC721TokenIDs, tokenID)
+		}
+	}
+
+	if cfg.TrafficMix[transferKindERC1155] > 0 {
+		if cfg.SrcERC1155VaultAddress, err = parseRequiredAddress(
+			c.String(flags.SrcERC1155VaultAddress.Name), flags.SrcERC1155VaultAddress.Name,
+		); err != nil {
+			return err
+		}
+
+		if cfg.ERC1155Address, err = parseRequiredAddress(
+			c.String(flags.BridgeERC1155Address.Name), flags.BridgeERC1155Address.Name,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func parseRequiredAddress(value string, name string) (common.Address, error) {
@@ -0,0 +1,277 @@
+package bridge
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/cyberhorsey/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// reportPollInterval is the interval between the checks of the processing of the messages.
+const reportPollInterval = 5 * time.Second
+
+// kindReport sums up the transfers of a kind, or of all of them.
+type kindReport struct {
+	sent       int
+	sendFailed int
+	processed  int
+	failed     int
+	retriable  int
+	pending    int
+	latencies  []time.Duration
+}
+
+// loadReport sums up a load generation run. The latency of a message is the time between
+// the blocks its MessageSent event and its processing were included in, as recorded on its
+// timeline by the relayer.
+type loadReport struct {
+	sendDuration time.Duration
+	total        *kindReport
+	kinds        map[string]*kindReport
+}
+
+func newLoadReport(sendDuration time.Duration) *loadReport {
+	return &loadReport{
+		sendDuration: sendDuration,
+		total:        &kindReport{},
+		kinds:        make(map[string]*kindReport),
+	}
+}
+
+// add applies the update to the report of the kind, and of all the kinds.
+func (r *loadReport) add(kind string, update func(k *kindReport)) {
+	if _, ok := r.kinds[kind]; !ok {
+		r.kinds[kind] = &kindReport{}
+	}
+
+	update(r.total)
+	update(r.kinds[kind])
+}
+
+func (r *loadReport) log() {
+	attrs := func(k *kindReport) []any {
+		return []any{
+			"sent", k.sent,
+			"sendFailed", k.sendFailed,
+			"processed", k.processed,
+			"failed", k.failed,
+			"retriable", k.retriable,
+			"pending", k.pending,
+			"latencyP50", percentile(k.latencies, 50),
+			"latencyP90", percentile(k.latencies, 90),
+			"latencyP99", percentile(k.latencies, 99),
+			"latencyMax", percentile(k.latencies, 100),
+		}
+	}
+
+	rate := float64(r.total.sent) / r.sendDuration.Seconds()
+
+	slog.Info("Bridge load report",
+		append([]any{"sendDuration", r.sendDuration, "txsPerSecond", rate}, attrs(r.total)...)...,
+	)
+
+	for _, kind := range transferKinds {
+		if k, ok := r.kinds[kind]; ok {
+			slog.Info("Bridge load report by kind", append([]any{"kind", kind}, attrs(k)...)...)
+		}
+	}
+}
+
+// messageOutcome is how far a message got, according to its timeline.
+type messageOutcome struct {
+	sentBlockID      uint64
+	processedBlockID uint64
+	status           relayer.EventStatus
+}
+
+func messageOutcomeFromHistory(histories []*relayer.MessageHistory) messageOutcome {
+	var o messageOutcome
+
+	for _, h := range histories {
+		switch h.Kind {
+		case relayer.MessageHistoryKindMessageSent:
+			o.sentBlockID = h.BlockID
+		case relayer.MessageHistoryKindStatusChanged:
+			if h.Status == nil {
+				continue
+			}
+
+			o.status = *h.Status
+
+			if o.status == relayer.EventStatusDone && o.processedBlockID == 0 {
+				o.processedBlockID = h.BlockID
+			}
+		case relayer.MessageHistoryKindMessageProcessed:
+			if o.processedBlockID == 0 {
+				o.processedBlockID = h.BlockID
+			}
+		}
+	}
+
+	if o.processedBlockID != 0 && o.status == relayer.EventStatusNew {
+		o.status = relayer.EventStatusDone
+	}
+
+	return o
+}
+
+// done returns whether the message will not make any more progress.
+func (o messageOutcome) done() bool {
+	if o.sentBlockID == 0 {
+		return false
+	}
+
+	return o.processedBlockID != 0 ||
+		o.status == relayer.EventStatusFailed ||
+		o.status == relayer.EventStatusRecalled
+}
+
+// processed returns whether the message was processed.
+func (o messageOutcome) processed() bool {
+	return o.sentBlockID != 0 && o.processedBlockID != 0
+}
+
+// waitForProcessing adds the transfers to the report, waiting up to the report timeout for
+// the relayer to process their messages. The messages still in progress once it is reached
+// are reported as retriable, or pending.
+func (b *Bridge) waitForProcessing(ctx context.Context, transfers []*transfer, report *loadReport) error {
+	outcomes := make(map[common.Hash]messageOutcome)
+
+	for _, t := range transfers {
+		report.add(t.kind, func(k *kindReport) {
+			k.sent++
+
+			if t.err != nil || t.msgHash == (common.Hash{}) {
+				k.sendFailed++
+			}
+		})
+
+		if t.err == nil && t.msgHash != (common.Hash{}) {
+			outcomes[t.msgHash] = messageOutcome{}
+		}
+	}
+
+	if b.messageHistoryRepo != nil && len(outcomes) > 0 {
+		slog.Info("Waiting for the messages to be processed", "messages", len(outcomes), "timeout", b.reportTimeout)
+
+		if err := b.pollOutcomes(ctx, outcomes); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range transfers {
+		o, ok := outcomes[t.msgHash]
+		if !ok || t.err != nil {
+			continue
+		}
+
+		var latency time.Duration
+
+		if o.processed() {
+			var err error
+			if latency, err = b.latency(ctx, o); err != nil {
+				return errors.Wrap(err, "b.latency")
+			}
+		}
+
+		report.add(t.kind, func(k *kindReport) {
+			switch {
+			case o.processed():
+				k.processed++
+				k.latencies = append(k.latencies, latency)
+			case o.done():
+				k.failed++
+			case o.status == relayer.EventStatusRetriable:
+				k.retriable++
+			default:
+				k.pending++
+			}
+		})
+	}
+
+	return nil
+}
+
+// pollOutcomes updates the outcomes of the messages from their timelines until they are all
+// done, or the report timeout is reached.
+func (b *Bridge) pollOutcomes(ctx context.Context, outcomes map[common.Hash]messageOutcome) error {
+	ctx, cancel := context.WithTimeout(ctx, b.reportTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(reportPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		inProgress := 0
+
+		for msgHash, o := range outcomes {
+			if o.done() {
+				continue
+			}
+
+			histories, err := b.messageHistoryRepo.FindAllByMsgHash(ctx, msgHash.Hex())
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+
+				return errors.Wrap(err, "b.messageHistoryRepo.FindAllByMsgHash")
+			}
+
+			outcomes[msgHash] = messageOutcomeFromHistory(histories)
+
+			if !outcomes[msgHash].done() {
+				inProgress++
+			}
+		}
+
+		if inProgress == 0 {
+			return nil
+		}
+	}
+}
+
+// latency returns the time between the blocks the message was sent and processed in.
+func (b *Bridge) latency(ctx context.Context, o messageOutcome) (time.Duration, error) {
+	sent, err := b.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(o.sentBlockID))
+	if err != nil {
+		return 0, err
+	}
+
+	processed, err := b.destEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(o.processedBlockID))
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(int64(processed.Time)-int64(sent.Time)) * time.Second, nil
+}
+
+// percentile returns the nearest-rank percentile of the durations, 0 when there are none.
+func percentile(durations []time.Duration, p float64) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	sorted := slices.Clone(durations)
+	slices.Sort(sorted)
+
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
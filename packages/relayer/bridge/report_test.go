@@ -0,0 +1,117 @@
+package bridge
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_messageOutcomeFromHistory(t *testing.T) {
+	status := func(s relayer.EventStatus) *relayer.EventStatus {
+		return &s
+	}
+
+	sent := &relayer.MessageHistory{Kind: relayer.MessageHistoryKindMessageSent, BlockID: 5}
+
+	tests := []struct {
+		name          string
+		histories     []*relayer.MessageHistory
+		want          messageOutcome
+		wantDone      bool
+		wantProcessed bool
+	}{
+		{
+			"notIndexed",
+			nil,
+			messageOutcome{},
+			false,
+			false,
+		},
+		{
+			"sent",
+			[]*relayer.MessageHistory{sent},
+			messageOutcome{sentBlockID: 5},
+			false,
+			false,
+		},
+		{
+			"retriable",
+			[]*relayer.MessageHistory{
+				sent,
+				{Kind: relayer.MessageHistoryKindStatusChanged, Status: status(relayer.EventStatusRetriable), BlockID: 8},
+			},
+			messageOutcome{sentBlockID: 5, status: relayer.EventStatusRetriable},
+			false,
+			false,
+		},
+		{
+			"processed",
+			[]*relayer.MessageHistory{
+				sent,
+				{Kind: relayer.MessageHistoryKindProcessingAttempt, BlockID: 8},
+				{Kind: relayer.MessageHistoryKindStatusChanged, Status: status(relayer.EventStatusDone), BlockID: 9},
+			},
+			messageOutcome{sentBlockID: 5, processedBlockID: 9, status: relayer.EventStatusDone},
+			true,
+			true,
+		},
+		{
+			"processedByRelayer",
+			[]*relayer.MessageHistory{
+				sent,
+				{Kind: relayer.MessageHistoryKindMessageProcessed, BlockID: 9},
+			},
+			messageOutcome{sentBlockID: 5, processedBlockID: 9, status: relayer.EventStatusDone},
+			true,
+			true,
+		},
+		{
+			"failed",
+			[]*relayer.MessageHistory{
+				sent,
+				{Kind: relayer.MessageHistoryKindStatusChanged, Status: status(relayer.EventStatusFailed), BlockID: 9},
+			},
+			messageOutcome{sentBlockID: 5, status: relayer.EventStatusFailed},
+			true,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := messageOutcomeFromHistory(tt.histories)
+
+			assert.Equal(t, tt.want, o)
+			assert.Equal(t, tt.wantDone, o.done())
+			assert.Equal(t, tt.wantProcessed, o.processed())
+		})
+	}
+}
+
+func Test_percentile(t *testing.T) {
+	assert.Equal(t, time.Duration(0), percentile(nil, 50))
+
+	durations := []time.Duration{4 * time.Second, 1 * time.Second, 3 * time.Second, 2 * time.Second}
+
+	assert.Equal(t, 2*time.Second, percentile(durations, 50))
+	assert.Equal(t, 4*time.Second, percentile(durations, 90))
+	assert.Equal(t, 4*time.Second, percentile(durations, 100))
+	assert.Equal(t, 1*time.Second, percentile(durations, 0))
+	// the durations are left unsorted.
+	assert.Equal(t, 4*time.Second, durations[0])
+}
+
+func Test_loadReport_add(t *testing.T) {
+	report := newLoadReport(time.Second)
+
+	report.add(transferKindETH, func(k *kindReport) { k.sent++ })
+	report.add(transferKindERC20, func(k *kindReport) { k.sent++ })
+	report.add(transferKindETH, func(k *kindReport) { k.processed++ })
+
+	assert.Equal(t, &kindReport{sent: 2, processed: 1}, report.total)
+	assert.Equal(t, &kindReport{sent: 1, processed: 1}, report.kinds[transferKindETH])
+	assert.Equal(t, &kindReport{sent: 1}, report.kinds[transferKindERC20])
+}
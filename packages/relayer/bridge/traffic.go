@@ -0,0 +1,121 @@
+package bridge
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	mathrand "math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Kinds of the transfers sent by the load generator.
+const (
+	transferKindETH     = "eth"
+	transferKindERC20   = "erc20"
+	transferKindERC721  = "erc721"
+	transferKindERC1155 = "erc1155"
+)
+
+var transferKinds = []string{transferKindETH, transferKindERC20, transferKindERC721, transferKindERC1155}
+
+// TrafficMix is the relative weight of each kind of transfer sent.
+type TrafficMix map[string]uint64
+
+// parseTrafficMix parses comma-delimited kind=weight pairs, such as "eth=3,erc20=1".
+func parseTrafficMix(s string) (TrafficMix, error) {
+	mix := make(TrafficMix)
+
+	for _, pair := range strings.Split(s, ",") {
+		kind, weight, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid traffic mix pair: %s", pair)
+		}
+
+		kind = strings.ToLower(strings.TrimSpace(kind))
+
+		if !slices.Contains(transferKinds, kind) {
+			return nil, fmt.Errorf("invalid traffic mix kind: %s", kind)
+		}
+
+		w, err := strconv.ParseUint(strings.TrimSpace(weight), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid traffic mix weight of %s: %w", kind, err)
+		}
+
+		mix[kind] = w
+	}
+
+	if mix.total() == 0 {
+		return nil, errors.New("invalid traffic mix, all weights are 0")
+	}
+
+	return mix, nil
+}
+
+func (m TrafficMix) total() uint64 {
+	var total uint64
+
+	for _, w := range m {
+		total += w
+	}
+
+	return total
+}
+
+// pick returns the kind of transfer the n-th unit of the total weight belongs to,
+// in the order of the transfer kinds, skipping the excluded kinds.
+func (m TrafficMix) pick(n uint64, excluded map[string]bool) string {
+	for _, kind := range transferKinds {
+		if excluded[kind] {
+			continue
+		}
+
+		if n < m[kind] {
+			return kind
+		}
+
+		n -= m[kind]
+	}
+
+	return ""
+}
+
+// random returns a random kind of transfer, in proportion to the weights of the kinds
+// that are not excluded, or an empty kind when all of them are.
+func (m TrafficMix) random(excluded map[string]bool) string {
+	var total uint64
+
+	for kind, w := range m {
+		if !excluded[kind] {
+			total += w
+		}
+	}
+
+	if total == 0 {
+		return ""
+	}
+
+	return m.pick(mathrand.Uint64N(total), excluded) // nolint: gosec
+}
+
+// randomFee returns a random fee between min and max, inclusive.
+func randomFee(minFee uint64, maxFee uint64) uint64 {
+	if maxFee <= minFee {
+		return minFee
+	}
+
+	return minFee + mathrand.Uint64N(maxFee-minFee+1) // nolint: gosec
+}
+
+// randomAddress returns a random address, a recipient nobody holds the key of.
+func randomAddress() common.Address {
+	var addr common.Address
+
+	_, _ = rand.Read(addr[:])
+
+	return addr
+}
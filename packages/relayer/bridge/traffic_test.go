@@ -0,0 +1,71 @@
+package bridge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseTrafficMix(t *testing.T) {
+	tests := []struct {
+		name    string
+		mix     string
+		want    TrafficMix
+		wantErr string
+	}{
+		{"eth", "eth=1", TrafficMix{transferKindETH: 1}, ""},
+		{"all", "eth=3, ERC20=1,erc721=0,erc1155=2", TrafficMix{"eth": 3, "erc20": 1, "erc721": 0, "erc1155": 2}, ""},
+		{"invalidPair", "eth", nil, "invalid traffic mix pair: eth"},
+		{"invalidKind", "eth=1,erc777=1", nil, "invalid traffic mix kind: erc777"},
+		{"invalidWeight", "eth=-1", nil, "invalid traffic mix weight of eth"},
+		{"allZero", "eth=0", nil, "invalid traffic mix, all weights are 0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mix, err := parseTrafficMix(tt.mix)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, mix)
+		})
+	}
+}
+
+func Test_TrafficMix_pick(t *testing.T) {
+	mix := TrafficMix{transferKindETH: 2, transferKindERC20: 1, transferKindERC1155: 1}
+
+	assert.Equal(t, transferKindETH, mix.pick(0, nil))
+	assert.Equal(t, transferKindETH, mix.pick(1, nil))
+	assert.Equal(t, transferKindERC20, mix.pick(2, nil))
+	assert.Equal(t, transferKindERC1155, mix.pick(3, nil))
+	assert.Equal(t, "", mix.pick(4, nil))
+
+	excluded := map[string]bool{transferKindETH: true}
+
+	assert.Equal(t, transferKindERC20, mix.pick(0, excluded))
+	assert.Equal(t, transferKindERC1155, mix.pick(1, excluded))
+}
+
+func Test_TrafficMix_random(t *testing.T) {
+	mix := TrafficMix{transferKindETH: 1, transferKindERC721: 1}
+
+	for range 10 {
+		assert.Equal(t, transferKindERC721, mix.random(map[string]bool{transferKindETH: true}))
+	}
+
+	assert.Equal(t, "", mix.random(map[string]bool{transferKindETH: true, transferKindERC721: true}))
+}
+
+func Test_randomFee(t *testing.T) {
+	assert.Equal(t, uint64(5), randomFee(5, 5))
+
+	for range 10 {
+		fee := randomFee(5, 7)
+		assert.GreaterOrEqual(t, fee, uint64(5))
+		assert.LessOrEqual(t, fee, uint64(7))
+	}
+}
@@ -0,0 +1,289 @@
+package bridge
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cyberhorsey/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/erc1155vault"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/erc20vault"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/erc721vault"
+)
+
+// tokenMessageGasLimit is the gas limit of the messages of the token transfers, which
+// deploy the bridged token on the destination chain the first time it is bridged.
+const tokenMessageGasLimit = 1000000
+
+// tokenApprovalABI holds the methods approving the vaults to transfer the tokens sent.
+const tokenApprovalABI = `[
+	{"type":"function","name":"approve","stateMutability":"nonpayable",
+		"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"setApprovalForAll","stateMutability":"nonpayable",
+		"inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],
+		"outputs":[]}
+]`
+
+// transferRequest is a transfer for a worker to send.
+type transferRequest struct {
+	kind    string
+	tokenID *big.Int
+}
+
+// transfer is a bridge message sent by the load generator.
+type transfer struct {
+	kind    string
+	txHash  common.Hash
+	msgHash common.Hash
+	err     error
+}
+
+// tokenApproval approves a vault to transfer the tokens sent through it.
+type tokenApproval struct {
+	token    common.Address
+	contract *bind.BoundContract
+	method   string
+	args     []interface{}
+}
+
+// newTokenApprovals returns the approvals of the tokens of the kinds of transfers in the
+// traffic mix to their vaults.
+func newTokenApprovals(cfg *Config, backend bind.ContractBackend) ([]tokenApproval, error) {
+	parsed, err := abi.JSON(strings.NewReader(tokenApprovalABI))
+	if err != nil {
+		return nil, err
+	}
+
+	approval := func(token common.Address, method string, args ...interface{}) tokenApproval {
+		return tokenApproval{
+			token:    token,
+			contract: bind.NewBoundContract(token, parsed, backend, backend, backend),
+			method:   method,
+			args:     args,
+		}
+	}
+
+	var approvals []tokenApproval
+
+	if cfg.TrafficMix[transferKindERC20] > 0 {
+		approvals = append(approvals, approval(cfg.ERC20Address, "approve", cfg.SrcERC20VaultAddress, math.MaxBig256))
+	}
+
+	if cfg.TrafficMix[transferKindERC721] > 0 {
+		approvals = append(approvals, approval(cfg.ERC721Address, "setApprovalForAll", cfg.SrcERC721VaultAddress, true))
+	}
+
+	if cfg.TrafficMix[transferKindERC1155] > 0 {
+		approvals = append(approvals, approval(cfg.ERC1155Address, "setApprovalForAll", cfg.SrcERC1155VaultAddress, true))
+	}
+
+	return approvals, nil
+}
+
+// approveTokens approves the vaults to transfer the tokens sent, before sending them.
+func (b *Bridge) approveTokens(ctx context.Context) error {
+	for _, approval := range b.tokenApprovals {
+		auth, err := b.newTransactOpts(ctx)
+		if err != nil {
+			return err
+		}
+
+		tx, err := approval.contract.Transact(auth, approval.method, approval.args...)
+		if err != nil {
+			b.resetNonce()
+
+			return errors.Wrap(err, "approval.contract.Transact")
+		}
+
+		if _, err := b.waitReceipt(ctx, tx.Hash()); err != nil {
+			return errors.Wrap(err, "b.waitReceipt")
+		}
+
+		slog.Info("Approved token", "token", approval.token.Hex(), "method", approval.method)
+	}
+
+	return nil
+}
+
+// sendTransfers sends the transfers of the traffic mix, one every tx interval, with up to
+// concurrency of them being sent at once, and returns them once they have all been sent.
+// Each ERC721 token is only sent once, the ERC721 transfers stop when they run out.
+func (b *Bridge) sendTransfers(ctx context.Context) []*transfer {
+	requests := make(chan transferRequest)
+	results := make(chan *transfer, b.messageCount)
+
+	var wg sync.WaitGroup
+
+	for range b.concurrency {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for req := range requests {
+				results <- b.sendTransfer(ctx, req)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(b.txInterval)
+	defer ticker.Stop()
+
+	erc721TokenIDs := b.erc721TokenIDs
+	excluded := map[string]bool{transferKindERC721: len(erc721TokenIDs) == 0}
+
+send:
+	for range b.messageCount {
+		req := transferRequest{kind: b.trafficMix.random(excluded)}
+		if req.kind == "" {
+			break
+		}
+
+		if req.kind == transferKindERC721 {
+			req.tokenID, erc721TokenIDs = erc721TokenIDs[0], erc721TokenIDs[1:]
+
+			if len(erc721TokenIDs) == 0 {
+				excluded[transferKindERC721] = true
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			break send
+		case requests <- req:
+		}
+
+		select {
+		case <-ctx.Done():
+			break send
+		case <-ticker.C:
+		}
+	}
+
+	close(requests)
+	wg.Wait()
+	close(results)
+
+	transfers := make([]*transfer, 0, b.messageCount)
+	for t := range results {
+		transfers = append(transfers, t)
+	}
+
+	return transfers
+}
+
+// sendTransfer sends a transfer to the sender or a random recipient, with a random fee,
+// and waits for its MessageSent event.
+func (b *Bridge) sendTransfer(ctx context.Context, req transferRequest) *transfer {
+	t := &transfer{kind: req.kind}
+
+	to := b.addr
+	if b.randomRecipients {
+		to = randomAddress()
+	}
+
+	fee := randomFee(b.minFee, b.maxFee)
+
+	auth, err := b.newTransactOpts(ctx)
+	if err != nil {
+		t.err = err
+		return t
+	}
+
+	var tx *types.Transaction
+
+	// the vaults take the processing fee as the value of the transaction.
+	switch req.kind {
+	case transferKindETH:
+		tx, err = b.sendETH(ctx, auth, to, fee)
+	case transferKindERC20:
+		auth.Value = new(big.Int).SetUint64(fee)
+		tx, err = b.erc20Vault.SendToken(auth, erc20vault.ERC20VaultBridgeTransferOp{
+			DestChainId: b.destChainId.Uint64(),
+			DestOwner:   to,
+			To:          to,
+			Fee:         fee,
+			Token:       b.erc20Address,
+			GasLimit:    tokenMessageGasLimit,
+			Amount:      b.tokenAmount,
+		})
+	case transferKindERC721:
+		auth.Value = new(big.Int).SetUint64(fee)
+		tx, err = b.erc721Vault.SendToken(auth, erc721vault.BaseNFTVaultBridgeTransferOp{
+			DestChainId: b.destChainId.Uint64(),
+			DestOwner:   to,
+			To:          to,
+			Fee:         fee,
+			Token:       b.erc721Address,
+			GasLimit:    tokenMessageGasLimit,
+			TokenIds:    []*big.Int{req.tokenID},
+			Amounts:     []*big.Int{common.Big0},
+		})
+	case transferKindERC1155:
+		auth.Value = new(big.Int).SetUint64(fee)
+		tx, err = b.erc1155Vault.SendToken(auth, erc1155vault.BaseNFTVaultBridgeTransferOp{
+			DestChainId: b.destChainId.Uint64(),
+			DestOwner:   to,
+			To:          to,
+			Fee:         fee,
+			Token:       b.erc1155Address,
+			GasLimit:    tokenMessageGasLimit,
+			TokenIds:    []*big.Int{b.erc1155TokenID},
+			Amounts:     []*big.Int{b.tokenAmount},
+		})
+	}
+
+	if err != nil {
+		b.resetNonce()
+
+		slog.Error("Failed to send transfer", "kind", req.kind, "error", err)
+
+		t.err = err
+
+		return t
+	}
+
+	t.txHash = tx.Hash()
+
+	receipt, err := b.waitReceipt(ctx, tx.Hash())
+	if err != nil {
+		slog.Error("Failed to get transfer receipt", "kind", req.kind, "txHash", t.txHash.Hex(), "error", err)
+
+		t.err = err
+
+		return t
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != b.srcBridgeAddress {
+			continue
+		}
+
+		if event, err := b.srcBridge.ParseMessageSent(*log); err == nil {
+			t.msgHash = event.MsgHash
+			break
+		}
+	}
+
+	slog.Info("Sent transfer", "kind", req.kind, "txHash", t.txHash.Hex(), "msgHash", t.msgHash.Hex())
+
+	return t
+}
+
+func (b *Bridge) waitReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, b.confTimeout)
+	defer cancel()
+
+	return relayer.WaitReceipt(ctx, b.srcEthClient, txHash)
+}
@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -27,6 +29,42 @@ var (
 		Value:    2.5,
 		EnvVars:  []string{"PROCESSING_FEE_MULTIPLIER"},
 	}
+	FeeHistorySize = &cli.IntFlag{
+		Name: "feeHistorySize",
+		Usage: "Number of the most recently processed messages, per event type and destination chain, " +
+			"used to recommend processing fees. 0 recommends from the gas limit estimates only",
+		Category: indexerCategory,
+		Value:    200,
+		EnvVars:  []string{"FEE_HISTORY_SIZE"},
+	}
+	BaseFeeForecastBlocks = &cli.Uint64Flag{
+		Name: "baseFeeForecastBlocks",
+		Usage: "Number of blocks to forecast the base fee over when recommending processing fees, " +
+			"extrapolated from the base fee history of as many past blocks",
+		Category: indexerCategory,
+		Value:    5,
+		EnvVars:  []string{"BASE_FEE_FORECAST_BLOCKS"},
+	}
+	AdminToken = &cli.StringFlag{
+		Name:     "http.adminToken",
+		Usage:    "Bearer token of the admin endpoints, which are disabled if unset",
+		Category: indexerCategory,
+		EnvVars:  []string{"HTTP_ADMIN_TOKEN"},
+	}
+	MessageStreamInterval = &cli.DurationFlag{
+		Name:     "messageStreamInterval",
+		Usage:    "Interval between the checks for new message status changes to stream over WebSocket",
+		Category: indexerCategory,
+		Value:    2 * time.Second,
+		EnvVars:  []string{"MESSAGE_STREAM_INTERVAL"},
+	}
+	MessageStreamMaxConnections = &cli.IntFlag{
+		Name:     "messageStreamMaxConnections",
+		Usage:    "Maximum number of WebSocket message status streams open at once",
+		Category: indexerCategory,
+		Value:    1000,
+		EnvVars:  []string{"MESSAGE_STREAM_MAX_CONNECTIONS"},
+	}
 )
 
 var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
@@ -34,5 +72,10 @@ var APIFlags = MergeFlags(CommonFlags, []cli.Flag{
 	HTTPPort,
 	CORSOrigins,
 	ProcessingFeeMultiplier,
+	FeeHistorySize,
+	BaseFeeForecastBlocks,
 	DestTaikoAddress,
+	AdminToken,
+	MessageStreamInterval,
+	MessageStreamMaxConnections,
 })
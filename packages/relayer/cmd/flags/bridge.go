@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -19,11 +21,140 @@ var (
 		Category: bridgeCategory,
 		EnvVars:  []string{"BRIDGE_MESSAGE_VALUE"},
 	}
+	BridgeMessageCount = &cli.Uint64Flag{
+		Name:     "bridgeMessageCount",
+		Usage:    "Number of bridge messages to send",
+		Value:    1,
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_MESSAGE_COUNT"},
+	}
+	BridgeTxsPerSecond = &cli.Float64Flag{
+		Name:     "bridgeTxsPerSecond",
+		Usage:    "Target rate of the bridge transactions sent, per second",
+		Value:    1,
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_TXS_PER_SECOND"},
+	}
+	BridgeConcurrency = &cli.Uint64Flag{
+		Name:     "bridgeConcurrency",
+		Usage:    "Number of bridge transactions sent concurrently",
+		Value:    1,
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_CONCURRENCY"},
+	}
+	BridgeTrafficMix = &cli.StringFlag{
+		Name: "bridgeTrafficMix",
+		Usage: "Comma-delimited weights of the kinds of transfers sent, as kind=weight pairs, " +
+			"the kinds being eth, erc20, erc721 and erc1155",
+		Value:    "eth=1",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_TRAFFIC_MIX"},
+	}
+	BridgeMinFee = &cli.Uint64Flag{
+		Name:     "bridgeMinFee",
+		Usage:    "Minimum processing fee of the bridge messages, the fees are random between the min and max",
+		Value:    10000,
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_MIN_FEE"},
+	}
+	BridgeMaxFee = &cli.Uint64Flag{
+		Name:     "bridgeMaxFee",
+		Usage:    "Maximum processing fee of the bridge messages",
+		Value:    10000,
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_MAX_FEE"},
+	}
+	BridgeRandomRecipients = &cli.BoolFlag{
+		Name:     "bridgeRandomRecipients",
+		Usage:    "Send the bridge messages to random recipients, instead of the sender",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_RANDOM_RECIPIENTS"},
+	}
+	SrcERC20VaultAddress = &cli.StringFlag{
+		Name:     "srcERC20VaultAddress",
+		Usage:    "ERC20Vault address for the source chain, required to send erc20 transfers",
+		Category: bridgeCategory,
+		EnvVars:  []string{"SRC_ERC20_VAULT_ADDRESS"},
+	}
+	SrcERC721VaultAddress = &cli.StringFlag{
+		Name:     "srcERC721VaultAddress",
+		Usage:    "ERC721Vault address for the source chain, required to send erc721 transfers",
+		Category: bridgeCategory,
+		EnvVars:  []string{"SRC_ERC721_VAULT_ADDRESS"},
+	}
+	SrcERC1155VaultAddress = &cli.StringFlag{
+		Name:     "srcERC1155VaultAddress",
+		Usage:    "ERC1155Vault address for the source chain, required to send erc1155 transfers",
+		Category: bridgeCategory,
+		EnvVars:  []string{"SRC_ERC1155_VAULT_ADDRESS"},
+	}
+	BridgeERC20Address = &cli.StringFlag{
+		Name:     "bridgeERC20Address",
+		Usage:    "ERC20 token held by the sender, to send erc20 transfers of",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_ERC20_ADDRESS"},
+	}
+	BridgeERC721Address = &cli.StringFlag{
+		Name:     "bridgeERC721Address",
+		Usage:    "ERC721 token held by the sender, to send erc721 transfers of",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_ERC721_ADDRESS"},
+	}
+	BridgeERC721TokenIDs = &cli.StringFlag{
+		Name:     "bridgeERC721TokenIDs",
+		Usage:    "Comma-delimited ERC721 token IDs held by the sender, each one is sent once",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_ERC721_TOKEN_IDS"},
+	}
+	BridgeERC1155Address = &cli.StringFlag{
+		Name:     "bridgeERC1155Address",
+		Usage:    "ERC1155 token held by the sender, to send erc1155 transfers of",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_ERC1155_ADDRESS"},
+	}
+	BridgeERC1155TokenID = &cli.Uint64Flag{
+		Name:     "bridgeERC1155TokenID",
+		Usage:    "ERC1155 token ID held by the sender, to send erc1155 transfers of",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_ERC1155_TOKEN_ID"},
+	}
+	BridgeTokenAmount = &cli.StringFlag{
+		Name:     "bridgeTokenAmount",
+		Usage:    "Amount of the erc20 and erc1155 transfers",
+		Value:    "1",
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_TOKEN_AMOUNT"},
+	}
+	BridgeReportTimeout = &cli.DurationFlag{
+		Name:     "bridgeReportTimeout",
+		Usage:    "Time to wait for the relayer to process the messages sent before reporting, 0 to not wait",
+		Value:    10 * time.Minute,
+		Category: bridgeCategory,
+		EnvVars:  []string{"BRIDGE_REPORT_TIMEOUT"},
+	}
 )
 
 var BridgeFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
 	BridgePrivateKey,
 	BridgeMessageValue,
+	BridgeMessageCount,
+	BridgeTxsPerSecond,
+	BridgeConcurrency,
+	BridgeTrafficMix,
+	BridgeMinFee,
+	BridgeMaxFee,
+	BridgeRandomRecipients,
+	SrcERC20VaultAddress,
+	SrcERC721VaultAddress,
+	SrcERC1155VaultAddress,
+	BridgeERC20Address,
+	BridgeERC721Address,
+	BridgeERC721TokenIDs,
+	BridgeERC1155Address,
+	BridgeERC1155TokenID,
+	BridgeTokenAmount,
+	BridgeReportTimeout,
+	ConfirmationTimeout,
 	SrcBridgeAddress,
 	DestBridgeAddress,
 	SrcTaikoAddress,
@@ -129,6 +129,48 @@ var (
 		Category: indexerCategory,
 		EnvVars:  []string{"CONFIRMATIONS_BEFORE_INDEXING"},
 	}
+	Webhooks = &cli.BoolFlag{
+		Name:     "webhooks",
+		Usage:    "Whether to send signed webhook notifications of the message status changes to the subscriptions",
+		Value:    false,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS"},
+	}
+	WebhooksInterval = &cli.DurationFlag{
+		Name:     "webhooks.interval",
+		Usage:    "Interval between the checks for webhook notifications due to be sent",
+		Value:    5 * time.Second,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_INTERVAL"},
+	}
+	WebhooksRetryInterval = &cli.DurationFlag{
+		Name:     "webhooks.retryInterval",
+		Usage:    "Initial interval between the attempts to send a webhook notification, doubled after each attempt",
+		Value:    30 * time.Second,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_RETRY_INTERVAL"},
+	}
+	WebhooksMaxAttempts = &cli.Uint64Flag{
+		Name:     "webhooks.maxAttempts",
+		Usage:    "Maximum attempts to send a webhook notification",
+		Value:    10,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_MAX_ATTEMPTS"},
+	}
+	WebhooksTimeout = &cli.DurationFlag{
+		Name:     "webhooks.timeout",
+		Usage:    "Timeout of a webhook notification request",
+		Value:    10 * time.Second,
+		Category: indexerCategory,
+		EnvVars:  []string{"WEBHOOKS_TIMEOUT"},
+	}
+	ReorgCheckDepth = &cli.Uint64Flag{
+		Name:     "reorgCheckDepth",
+		Usage:    "Number of latest blocks whose indexed block hashes are verified against the canonical chain",
+		Value:    64,
+		Category: indexerCategory,
+		EnvVars:  []string{"REORG_CHECK_DEPTH"},
+	}
 )
 
 var IndexerFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
@@ -149,4 +191,10 @@ var IndexerFlags = MergeFlags(CommonFlags, QueueFlags, []cli.Flag{
 	TargetBlockNumber,
 	WaitForConfirmationTimeout,
 	IndexingConfirmations,
+	Webhooks,
+	WebhooksInterval,
+	WebhooksRetryInterval,
+	WebhooksMaxAttempts,
+	WebhooksTimeout,
+	ReorgCheckDepth,
 })
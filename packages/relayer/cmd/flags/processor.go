@@ -121,6 +121,99 @@ var (
 		Value:    0,
 		EnvVars:  []string{"MIN_FEE_TO_PROCESS"},
 	}
+	RetryMessages = &cli.BoolFlag{
+		Name:     "retryMessages",
+		Usage:    "Whether to retry the Retriable messages the processor is authorized to retry",
+		Value:    false,
+		Category: processorCategory,
+		EnvVars:  []string{"RETRY_MESSAGES"},
+	}
+	RetryMessagesMaxAttempts = &cli.Uint64Flag{
+		Name:     "retryMessages.maxAttempts",
+		Usage:    "Maximum attempts to retry a Retriable message",
+		Value:    3,
+		Category: processorCategory,
+		EnvVars:  []string{"RETRY_MESSAGES_MAX_ATTEMPTS"},
+	}
+	RetryMessagesInterval = &cli.Uint64Flag{
+		Name:     "retryMessages.interval",
+		Usage:    "Initial interval between the attempts to retry a Retriable message in seconds, doubled after each attempt",
+		Value:    30,
+		Category: processorCategory,
+		EnvVars:  []string{"RETRY_MESSAGES_INTERVAL"},
+	}
+	RetryMessagesMaxGasLimit = &cli.Uint64Flag{
+		Name:     "retryMessages.maxGasLimit",
+		Usage:    "Maximum gas limit of a retryMessage transaction",
+		Value:    3_000_000,
+		Category: processorCategory,
+		EnvVars:  []string{"RETRY_MESSAGES_MAX_GAS_LIMIT"},
+	}
+	RetryMessagesMaxGasPrice = &cli.Uint64Flag{
+		Name:     "retryMessages.maxGasPrice",
+		Usage:    "Maximum gas price in wei to send a retryMessage transaction at, 0 means unlimited",
+		Value:    0,
+		Category: processorCategory,
+		EnvVars:  []string{"RETRY_MESSAGES_MAX_GAS_PRICE"},
+	}
+	RecallCandidates = &cli.BoolFlag{
+		Name: "recallCandidates",
+		Usage: "Whether to save the Failed messages as RecallCandidate events listed by the events API, " +
+			"along with the proof needed to recall them on the source chain",
+		Value:    false,
+		Category: processorCategory,
+		EnvVars:  []string{"RECALL_CANDIDATES"},
+	}
+	DestSignalServiceAddress = &cli.StringFlag{
+		Name:     "destSignalServiceAddress",
+		Usage:    "SignalService address for the destination chain, required by recallCandidates",
+		Category: processorCategory,
+		Required: false,
+		EnvVars:  []string{"DEST_SIGNAL_SERVICE_ADDRESS"},
+	}
+	RoutesFile = &cli.StringFlag{
+		Name: "routesFile",
+		Usage: "Path to a JSON file of additional (source, destination) routes to process messages for, " +
+			"each with its own RPC URLs, contract addresses, queue and tx manager",
+		Category: processorCategory,
+		Required: false,
+		EnvVars:  []string{"PROCESSOR_ROUTES_FILE"},
+	}
+	ProfitMarginBps = &cli.Uint64Flag{
+		Name:     "profitMarginBps",
+		Usage:    "Margin in basis points required over the simulated processing cost, with profitableOnly",
+		Value:    0,
+		Category: processorCategory,
+		EnvVars:  []string{"PROFIT_MARGIN_BPS"},
+	}
+	TokenAllowlist = &cli.StringFlag{
+		Name:     "tokenAllowlist",
+		Usage:    "Comma-delimited canonical token addresses, zero address for ETH, processed with profitableOnly",
+		Category: processorCategory,
+		EnvVars:  []string{"TOKEN_ALLOWLIST"},
+	}
+	BatchProcessorAddress = &cli.StringFlag{
+		Name: "batch.processorAddress",
+		Usage: "Address of the MessageBatchProcessor contract to batch the processMessage calls through, " +
+			"which forwards the processing fees of a batch to the processor. Batching is disabled if unset",
+		Category: processorCategory,
+		Required: false,
+		EnvVars:  []string{"BATCH_PROCESSOR_ADDRESS"},
+	}
+	BatchWindow = &cli.Uint64Flag{
+		Name:     "batch.window",
+		Usage:    "Time in seconds to collect the messages of a batch for, after the first one is ready",
+		Value:    2,
+		Category: processorCategory,
+		EnvVars:  []string{"BATCH_WINDOW"},
+	}
+	BatchMaxSize = &cli.Uint64Flag{
+		Name:     "batch.maxSize",
+		Usage:    "Maximum number of messages processed by a batch transaction",
+		Value:    10,
+		Category: processorCategory,
+		EnvVars:  []string{"BATCH_MAX_SIZE"},
+	}
 )
 
 var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
@@ -142,4 +235,17 @@ var ProcessorFlags = MergeFlags(CommonFlags, QueueFlags, TxmgrFlags, []cli.Flag{
 	MaxMessageRetries,
 	MinFeeToProcess,
 	DestQuotaManagerAddress,
+	RetryMessages,
+	RetryMessagesMaxAttempts,
+	RetryMessagesInterval,
+	RetryMessagesMaxGasLimit,
+	RetryMessagesMaxGasPrice,
+	RecallCandidates,
+	DestSignalServiceAddress,
+	RoutesFile,
+	ProfitMarginBps,
+	TokenAllowlist,
+	BatchProcessorAddress,
+	BatchWindow,
+	BatchMaxSize,
 })
@@ -1,36 +1,58 @@
 package flags
 
-import "github.com/urfave/cli/v2"
+import (
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
 
 var (
 	QueueUsername = &cli.StringFlag{
 		Name:     "queue.username",
-		Usage:    "Queue connection username",
-		Required: true,
+		Usage:    "Queue connection username, required by the rabbitmq driver",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_USER"},
 	}
 	QueuePassword = &cli.StringFlag{
 		Name:     "queue.password",
-		Usage:    "Queue connection password",
-		Required: true,
+		Usage:    "Queue connection password, required by the rabbitmq driver",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PASSWORD"},
 	}
 	QueueHost = &cli.StringFlag{
 		Name:     "queue.host",
-		Usage:    "Queue connection host",
-		Required: true,
+		Usage:    "Queue connection host, required by the rabbitmq driver",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_HOST"},
 	}
 	QueuePort = &cli.Uint64Flag{
 		Name:     "queue.port",
-		Usage:    "Queue connection port",
-		Required: true,
+		Usage:    "Queue connection port, required by the rabbitmq driver",
 		Category: commonCategory,
 		EnvVars:  []string{"QUEUE_PORT"},
 	}
+	QueueDriver = &cli.StringFlag{
+		Name: "queue.driver",
+		Usage: "Queue driver, either rabbitmq, or mysql to keep the queue in a table of the relayer database " +
+			"without a broker",
+		Value:    "rabbitmq",
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_DRIVER"},
+	}
+	QueueVisibilityTimeout = &cli.DurationFlag{
+		Name:     "queue.visibilityTimeout",
+		Usage:    "How long a message delivered by the mysql driver stays hidden if its consumer stops responding",
+		Value:    5 * time.Minute,
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_VISIBILITY_TIMEOUT"},
+	}
+	QueueDeadRetention = &cli.DurationFlag{
+		Name:     "queue.deadRetention",
+		Usage:    "How long a message dead lettered by the mysql driver is kept before being deleted, 0 keeps it forever",
+		Value:    7 * 24 * time.Hour,
+		Category: commonCategory,
+		EnvVars:  []string{"QUEUE_DEAD_RETENTION"},
+	}
 )
 
 var QueueFlags = []cli.Flag{
@@ -38,4 +60,7 @@ var QueueFlags = []cli.Flag{
 	QueuePassword,
 	QueueHost,
 	QueuePort,
+	QueueDriver,
+	QueueVisibilityTimeout,
+	QueueDeadRetention,
 }
@@ -11,6 +11,14 @@ var (
 		"ERR_NO_BLOCK_REPOSITORY",
 		"BlockRepository is required",
 	)
+	ErrNoMessageHistoryRepository = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_MESSAGE_HISTORY_REPOSITORY",
+		"MessageHistoryRepository is required",
+	)
+	ErrNoWebhookRepository = errors.Validation.NewWithKeyAndDetail(
+		"ERR_NO_WEBHOOK_REPOSITORY",
+		"WebhookRepository is required",
+	)
 	ErrNoCORSOrigins = errors.Validation.NewWithKeyAndDetail("ERR_NO_CORS_ORIGINS", "CORS Origins are required")
 	ErrNoProver      = errors.Validation.NewWithKeyAndDetail("ERR_NO_PROVER", "Prover is required")
 	ErrNoRPCClient   = errors.Validation.NewWithKeyAndDetail("ERR_NO_RPC_CLIENT", "RPCClient is required")
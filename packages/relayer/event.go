@@ -16,6 +16,9 @@ var (
 	EventNameMessageStatusChanged = "MessageStatusChanged"
 	EventNameMessageProcessed     = "MessageProcessed"
 	EventNameCheckpointSaved      = "CheckpointSaved"
+	// EventNameRecallCandidate is not emitted by the bridge, it is saved by the processor for
+	// the failed messages whose owners can recall them on the source chain.
+	EventNameRecallCandidate = "RecallCandidate"
 )
 
 // EventStatus is used to indicate whether processing has been attempted
@@ -61,6 +64,7 @@ type Event struct {
 	DestChainID             int64          `json:"destChainID"`
 	SyncedChainID           uint64         `json:"syncedChainID"`
 	EmittedBlockID          uint64         `json:"emittedBlockID"`
+	EmittedBlockHash        string         `json:"emittedBlockHash"`
 	BlockID                 uint64         `json:"blockID"`
 	SyncedInBlockID         uint64         `json:"syncedInBlockID"`
 	SyncData                string         `json:"syncData"`
@@ -82,6 +86,16 @@ type Event struct {
 	IsProfitable            *bool          `json:"isProfitable"`
 	EstimatedOnchainFee     *uint64        `json:"estimatedOnchainFee"`
 	IsProfitableEvaluatedAt *time.Time     `json:"isProfitableEvaluatedAt"`
+	// cost at the declared gas limit, and the simulated gas and L1 data cost of processing
+	DeclaredOnchainFee *uint64 `json:"declaredOnchainFee"`
+	SimulatedGasUsed   *uint64 `json:"simulatedGasUsed"`
+	DataCost           *uint64 `json:"dataCost"`
+	// processing cost of the processMessage transaction sent by this relayer
+	ProcessedGasUsed    *uint64    `json:"processedGasUsed"`
+	ProcessedGasPrice   *uint64    `json:"processedGasPrice"`
+	ProcessedRelayerFee *uint64    `json:"processedRelayerFee"`
+	ProcessedAt         *time.Time `json:"processedAt"`
+	CreatedAt           time.Time  `json:"createdAt"`
 }
 
 // SaveEventOpts
@@ -103,11 +117,15 @@ type SaveEventOpts struct {
 	SyncedChainID          uint64
 	BlockID                uint64
 	EmittedBlockID         uint64
+	EmittedBlockHash       string
 	SyncData               string
 	Kind                   string
 	SyncedInBlockID        uint64
 }
 
+// UpdateFeesAndProfitabilityOpts records the profitability evaluation of a message. The
+// DeclaredOnchainFee is the cost at the declared gas limit, while the EstimatedOnchainFee is
+// the cost at the SimulatedGasUsed plus the DataCost of posting the calldata to L1.
 type UpdateFeesAndProfitabilityOpts struct {
 	Fee                     uint64
 	DestChainBaseFee        uint64
@@ -116,6 +134,71 @@ type UpdateFeesAndProfitabilityOpts struct {
 	IsProfitable            bool
 	EstimatedOnchainFee     uint64
 	IsProfitableEvaluatedAt time.Time
+	DeclaredOnchainFee      uint64
+	SimulatedGasUsed        uint64
+	DataCost                uint64
+}
+
+// UpdateProcessingCostOpts records the cost of a processMessage transaction sent
+// by the relayer. RelayerFee is nil when it could not be determined from the receipt.
+type UpdateProcessingCostOpts struct {
+	GasUsed           uint64
+	EffectiveGasPrice uint64
+	RelayerFee        *uint64
+	ProcessedAt       time.Time
+}
+
+// ProcessingCost is the recorded cost of a processed message.
+type ProcessingCost struct {
+	GasUsed           uint64
+	EffectiveGasPrice uint64
+}
+
+type FindProcessingCostsOpts struct {
+	EventType   EventType
+	DestChainID uint64
+	// Limit is the maximum number of the most recent costs to return.
+	Limit int
+}
+
+// FindStatusChangesOpts finds the MessageSent and MessageStatusChanged events saved after an
+// event, of the messages of an owner, of a single message, or of all the messages.
+type FindStatusChangesOpts struct {
+	AfterID int
+	Owner   string
+	MsgHash string
+	Limit   int
+}
+
+// EmittedBlock is a block events were emitted in, with its hash when they were indexed.
+type EmittedBlock struct {
+	EmittedBlockID   uint64
+	EmittedBlockHash string
+}
+
+// FindEmittedBlocksOpts finds the blocks the events of the given names, of a chain pair, were
+// emitted in, from a block on.
+type FindEmittedBlocksOpts struct {
+	ChainID     uint64
+	DestChainID uint64
+	Names       []string
+	FromBlockID uint64
+}
+
+// FindAllMessagesOpts filters the messages of all owners, their MessageSent events, newest
+// first. Nil or empty fields do not filter, and the page of messages starts before the event
+// with the BeforeID, the cursor of the page, when it is set.
+type FindAllMessagesOpts struct {
+	Statuses              []EventStatus
+	IsProfitable          *bool
+	DestChainID           *uint64
+	CanonicalTokenAddress string
+	MinFee                *uint64
+	MaxFee                *uint64
+	CreatedAfter          *time.Time
+	CreatedBefore         *time.Time
+	BeforeID              int
+	Limit                 int
 }
 
 type FindAllByAddressOpts struct {
@@ -132,11 +215,16 @@ type EventRepository interface {
 	Save(ctx context.Context, opts *SaveEventOpts) (*Event, error)
 	UpdateStatus(ctx context.Context, id int, status EventStatus) error
 	UpdateFeesAndProfitability(ctx context.Context, id int, opts *UpdateFeesAndProfitabilityOpts) error
+	UpdateProcessingCost(ctx context.Context, id int, opts *UpdateProcessingCostOpts) error
+	FindProcessingCosts(ctx context.Context, opts FindProcessingCostsOpts) ([]ProcessingCost, error)
+	FindStatusChanges(ctx context.Context, opts FindStatusChangesOpts) ([]*Event, error)
+	LatestEventID(ctx context.Context) (int, error)
 	FindAllByAddress(
 		ctx context.Context,
 		req *http.Request,
 		opts FindAllByAddressOpts,
 	) (*paginate.Page, error)
+	FindAllMessages(ctx context.Context, opts FindAllMessagesOpts) ([]*Event, error)
 	FirstByMsgHash(
 		ctx context.Context,
 		msgHash string,
@@ -158,11 +246,12 @@ type EventRepository interface {
 		chainId uint64,
 		syncedChainId uint64,
 	) (uint64, error)
-	DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error
 	FindLatestBlockID(
 		ctx context.Context,
 		event string,
 		srcChainID uint64,
 		destChainID uint64,
 	) (uint64, error)
+	FindEmittedBlocks(ctx context.Context, opts FindEmittedBlocksOpts) ([]EmittedBlock, error)
+	DeleteAllByEmittedBlock(ctx context.Context, opts FindEmittedBlocksOpts, block EmittedBlock) (int64, error)
 }
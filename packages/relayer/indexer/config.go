@@ -9,8 +9,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 	"github.com/urfave/cli/v2"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -38,6 +38,7 @@ type Config struct {
 	QueuePassword string
 	QueueHost     string
 	QueuePort     uint64
+	QueueDriver   string
 	// rpc configs
 	SrcRPCUrl                        string
 	DestRPCUrl                       string
@@ -59,6 +60,14 @@ type Config struct {
 	OpenDBFunc                       func() (db.DB, error)
 	ConfirmationTimeout              time.Duration
 	Confirmations                    uint64
+	// webhook configs
+	Webhooks              bool
+	WebhooksInterval      time.Duration
+	WebhooksRetryInterval time.Duration
+	WebhooksMaxAttempts   uint64
+	WebhooksTimeout       time.Duration
+	// ReorgCheckDepth is the number of latest blocks verified for reorgs on each pass.
+	ReorgCheckDepth uint64
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -68,7 +77,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, err
 	}
 
-	return &Config{
+	cfg := &Config{
 		SrcBridgeAddress:                 common.HexToAddress(c.String(flags.SrcBridgeAddress.Name)),
 		SrcTaikoAddress:                  common.HexToAddress(c.String(flags.SrcTaikoAddress.Name)),
 		SrcSignalServiceAddress:          common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
@@ -84,6 +93,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		QueuePassword:                    c.String(flags.QueuePassword.Name),
 		QueuePort:                        c.Uint64(flags.QueuePort.Name),
 		QueueHost:                        c.String(flags.QueueHost.Name),
+		QueueDriver:                      c.String(flags.QueueDriver.Name),
 		SrcRPCUrl:                        c.String(flags.SrcRPCUrl.Name),
 		DestRPCUrl:                       c.String(flags.DestRPCUrl.Name),
 		BlockBatchSize:                   c.Uint64(flags.BlockBatchSize.Name),
@@ -101,6 +111,12 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		IgnoredMsgHashes:                 ignoredMsgHashes,
 		ConfirmationTimeout:              c.Duration(flags.WaitForConfirmationTimeout.Name),
 		Confirmations:                    c.Uint64(flags.IndexingConfirmations.Name),
+		Webhooks:                         c.Bool(flags.Webhooks.Name),
+		WebhooksInterval:                 c.Duration(flags.WebhooksInterval.Name),
+		WebhooksRetryInterval:            c.Duration(flags.WebhooksRetryInterval.Name),
+		WebhooksMaxAttempts:              c.Uint64(flags.WebhooksMaxAttempts.Name),
+		WebhooksTimeout:                  c.Duration(flags.WebhooksTimeout.Name),
+		ReorgCheckDepth:                  c.Uint64(flags.ReorgCheckDepth.Name),
 		TargetBlockNumber: func() *uint64 {
 			if c.IsSet(flags.TargetBlockNumber.Name) {
 				value := c.Uint64(flags.TargetBlockNumber.Name)
@@ -129,22 +145,13 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username: c.String(flags.QueueUsername.Name),
-				Password: c.String(flags.QueuePassword.Name),
-				Host:     c.String(flags.QueueHost.Name),
-				Port:     c.String(flags.QueuePort.Name),
-			}
+	}
 
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
-			}
+	if cfg.OpenQueueFunc, err = pkgFlags.InitOpenQueueFuncFromCli(c, cfg.OpenDBFunc); err != nil {
+		return nil, err
+	}
 
-			return q, nil
-		},
-	}, nil
+	return cfg, nil
 }
 
 func parseIgnoredMsgHashes(value string) (map[common.Hash]struct{}, error) {
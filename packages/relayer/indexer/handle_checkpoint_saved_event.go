@@ -49,16 +49,17 @@ func (i *Indexer) handleCheckpointSavedEvent(
 	}
 
 	_, err = i.eventRepo.Save(ctx, &relayer.SaveEventOpts{
-		Name:            relayer.EventNameCheckpointSaved,
-		Event:           relayer.EventNameCheckpointSaved,
-		Data:            string(marshaled),
-		ChainID:         i.srcChainId,
-		DestChainID:     i.destChainId,
-		SyncedChainID:   i.destChainId.Uint64(),
-		BlockID:         event.BlockNumber.Uint64(),
-		EmittedBlockID:  event.Raw.BlockNumber,
-		SyncData:        common.Hash(event.StateRoot).Hex(),
-		SyncedInBlockID: event.Raw.BlockNumber,
+		Name:             relayer.EventNameCheckpointSaved,
+		Event:            relayer.EventNameCheckpointSaved,
+		Data:             string(marshaled),
+		ChainID:          i.srcChainId,
+		DestChainID:      i.destChainId,
+		SyncedChainID:    i.destChainId.Uint64(),
+		BlockID:          event.BlockNumber.Uint64(),
+		EmittedBlockID:   event.Raw.BlockNumber,
+		EmittedBlockHash: event.Raw.BlockHash.Hex(),
+		SyncData:         common.Hash(event.StateRoot).Hex(),
+		SyncedInBlockID:  event.Raw.BlockNumber,
 	})
 	if err != nil {
 		return errors.Wrap(err, "i.eventRepo.Save")
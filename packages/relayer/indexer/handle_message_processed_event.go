@@ -77,7 +77,7 @@ func (i *Indexer) handleMessageProcessedEvent(
 		return errors.Wrap(err, "json.Marshal(event)")
 	}
 
-	if _, err := i.saveEventToDB(
+	if _, _, err := i.saveEventToDB(
 		ctx,
 		marshaled,
 		"0x",
@@ -87,10 +87,43 @@ func (i *Indexer) handleMessageProcessedEvent(
 		message.Data,
 		message.Value,
 		event.Raw.BlockNumber,
+		event.Raw.BlockHash,
 	); err != nil {
 		return errors.Wrap(err, "i.saveEventToDB")
 	}
 
+	// a processed message is either done, or retriable when its call failed.
+	status, err := i.processedMessageStatus(ctx, event)
+	if err != nil {
+		slog.Warn("could not read the status of the processed message, assuming done",
+			"msgHash", common.Hash(event.MsgHash).Hex(),
+			"err", err.Error(),
+		)
+
+		status = relayer.EventStatusDone
+	}
+
+	i.saveMessageHistoryOnce(ctx, &relayer.SaveMessageHistoryOpts{
+		MsgHash:     common.Hash(event.MsgHash).Hex(),
+		Kind:        relayer.MessageHistoryKindMessageProcessed,
+		ChainID:     new(big.Int).SetUint64(message.SrcChainId),
+		DestChainID: new(big.Int).SetUint64(message.DestChainId),
+		Status:      &status,
+		TxHash:      event.Raw.TxHash.Hex(),
+		BlockID:     event.Raw.BlockNumber,
+	})
+
+	i.notifyStatusChange(ctx, &relayer.MessageStatusNotification{
+		MsgHash:     common.Hash(event.MsgHash).Hex(),
+		Owner:       message.SrcOwner.Hex(),
+		Event:       relayer.EventNameMessageProcessed,
+		Status:      status,
+		ChainID:     chainID.Int64(),
+		DestChainID: i.destChainId.Int64(),
+		TxHash:      event.Raw.TxHash.Hex(),
+		BlockID:     event.Raw.BlockNumber,
+	})
+
 	// Forged-message detection (relocated from the removed watchdog): if the
 	// bridge that should have originated this message has no record of sending
 	// it, alert. This runs after saveEventToDB and is best-effort: the indexer's
@@ -115,6 +148,26 @@ func (i *Indexer) handleMessageProcessedEvent(
 	return nil
 }
 
+// processedMessageStatus reads the status of a processed message on the bridge that
+// processed it, at the block of the event.
+func (i *Indexer) processedMessageStatus(
+	ctx context.Context,
+	event *bridge.BridgeMessageProcessed,
+) (relayer.EventStatus, error) {
+	callCtx, cancel := context.WithTimeout(ctx, i.ethClientTimeout)
+	defer cancel()
+
+	status, err := i.bridge.MessageStatus(&bind.CallOpts{
+		Context:     callCtx,
+		BlockNumber: new(big.Int).SetUint64(event.Raw.BlockNumber),
+	}, event.MsgHash)
+	if err != nil {
+		return 0, errors.Wrap(err, "i.bridge.MessageStatus")
+	}
+
+	return relayer.EventStatus(status), nil
+}
+
 // isForgedMessage reports whether the bridge that should have originated this
 // message (destBridge) has no record of having sent it — the signature of a
 // forged message. Relocated from the removed watchdog.
@@ -6,7 +6,10 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 )
@@ -70,3 +73,37 @@ func Test_handleMessageProcessedEvent_indexesWhenForgedCheckRPCFails(t *testing.
 	// ...and the event must still be indexed.
 	assert.Equal(t, 1, repo.SavedCount())
 }
+
+func Test_handleMessageProcessedEvent_savesMessageHistoryOnce(t *testing.T) {
+	svc, _ := newTestService(Sync, FilterAndSubscribe)
+
+	event := &bridge.BridgeMessageProcessed{
+		MsgHash: mock.RetriableMsgHash,
+		Message: bridge.IBridgeMessage{
+			Id:          5,
+			SrcChainId:  mock.MockChainID.Uint64(),
+			DestChainId: mock.MockChainID.Uint64(),
+			Value:       big.NewInt(0),
+		},
+		Raw: types.Log{BlockNumber: 7},
+	}
+
+	// the event is handled again when it is seen by another filter pass.
+	assert.Nil(t, svc.handleMessageProcessedEvent(context.Background(), mock.MockChainID, event, false))
+	assert.Nil(t, svc.handleMessageProcessedEvent(context.Background(), mock.MockChainID, event, false))
+
+	history, err := svc.messageHistoryRepo.FindAllByMsgHash(
+		context.Background(),
+		common.Hash(mock.RetriableMsgHash).Hex(),
+	)
+	assert.Nil(t, err)
+	assert.Len(t, history, 1)
+
+	assert.Equal(t, relayer.MessageHistoryKindMessageProcessed, history[0].Kind)
+	assert.Equal(t, uint64(7), history[0].BlockID)
+
+	// the call of the message failed, so it was left retriable.
+	if assert.NotNil(t, history[0].Status) {
+		assert.Equal(t, relayer.EventStatusRetriable, *history[0].Status)
+	}
+}
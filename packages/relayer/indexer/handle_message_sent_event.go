@@ -75,7 +75,7 @@ func (i *Indexer) handleMessageSentEvent(
 		return errors.Wrap(err, "json.Marshal(event)")
 	}
 
-	id, err := i.saveEventToDB(
+	id, created, err := i.saveEventToDB(
 		ctx,
 		marshaled,
 		common.Hash(event.MsgHash).Hex(),
@@ -85,11 +85,36 @@ func (i *Indexer) handleMessageSentEvent(
 		event.Message.Data,
 		event.Message.Value,
 		event.Raw.BlockNumber,
+		event.Raw.BlockHash,
 	)
 	if err != nil {
 		return errors.Wrap(err, "i.saveEventToDB")
 	}
 
+	// messages seen again, when crawling past blocks, are already on their timeline.
+	if created {
+		i.saveMessageHistory(ctx, &relayer.SaveMessageHistoryOpts{
+			MsgHash:     common.Hash(event.MsgHash).Hex(),
+			Kind:        relayer.MessageHistoryKindMessageSent,
+			ChainID:     chainID,
+			DestChainID: i.destChainId,
+			Status:      &eventStatus,
+			TxHash:      event.Raw.TxHash.Hex(),
+			BlockID:     event.Raw.BlockNumber,
+		})
+
+		i.notifyStatusChange(ctx, &relayer.MessageStatusNotification{
+			MsgHash:     common.Hash(event.MsgHash).Hex(),
+			Owner:       event.Message.SrcOwner.Hex(),
+			Event:       relayer.EventNameMessageSent,
+			Status:      eventStatus,
+			ChainID:     chainID.Int64(),
+			DestChainID: i.destChainId.Int64(),
+			TxHash:      event.Raw.TxHash.Hex(),
+			BlockID:     event.Raw.BlockNumber,
+		})
+	}
+
 	// only add messages with new status to queue
 	if eventStatus != relayer.EventStatusNew {
 		return nil
@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
+)
+
+func Test_handleMessageSentEvent_savesMessageHistoryOnce(t *testing.T) {
+	svc, _ := newTestService(Sync, FilterAndSubscribe)
+
+	event := &bridge.BridgeMessageSent{
+		MsgHash: mock.SuccessMsgHash,
+		Message: bridge.IBridgeMessage{
+			Id:          1,
+			SrcChainId:  mock.MockChainID.Uint64(),
+			DestChainId: mock.MockChainID.Uint64(),
+			GasLimit:    1,
+			Value:       big.NewInt(0),
+		},
+		Raw: types.Log{
+			TxHash:      common.HexToHash("0x1"),
+			BlockNumber: 5,
+		},
+	}
+
+	// the second time, the message is seen again after a restart.
+	assert.Nil(t, svc.handleMessageSentEvent(context.Background(), mock.MockChainID, event, false))
+	assert.Nil(t, svc.handleMessageSentEvent(context.Background(), mock.MockChainID, event, false))
+
+	history, err := svc.messageHistoryRepo.FindAllByMsgHash(
+		context.Background(),
+		common.Hash(mock.SuccessMsgHash).Hex(),
+	)
+	assert.Nil(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, relayer.MessageHistoryKindMessageSent, history[0].Kind)
+	assert.Equal(t, common.HexToHash("0x1").Hex(), history[0].TxHash)
+	assert.Equal(t, uint64(5), history[0].BlockID)
+	assert.Equal(t, relayer.EventStatusNew, *history[0].Status)
+}
+
+func Test_handleMessageSentEvent_notifiesWebhooks(t *testing.T) {
+	svc, _ := newTestService(Sync, FilterAndSubscribe)
+
+	webhookRepo := mock.NewWebhookRepository()
+
+	webhooks, err := webhook.NewDispatcher(webhook.NewDispatcherOpts{WebhookRepo: webhookRepo})
+	assert.Nil(t, err)
+
+	svc.webhooks = webhooks
+
+	owner := common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6")
+
+	_, err = webhookRepo.SaveSubscription(context.Background(), &relayer.SaveWebhookSubscriptionOpts{
+		URL:   "https://example.com",
+		Owner: owner.Hex(),
+	})
+	assert.Nil(t, err)
+
+	event := &bridge.BridgeMessageSent{
+		MsgHash: mock.SuccessMsgHash,
+		Message: bridge.IBridgeMessage{
+			Id:          1,
+			SrcChainId:  mock.MockChainID.Uint64(),
+			DestChainId: mock.MockChainID.Uint64(),
+			SrcOwner:    owner,
+			GasLimit:    1,
+			Value:       big.NewInt(0),
+		},
+		Raw: types.Log{
+			TxHash:      common.HexToHash("0x1"),
+			BlockNumber: 5,
+		},
+	}
+
+	assert.Nil(t, svc.handleMessageSentEvent(context.Background(), mock.MockChainID, event, false))
+
+	deliveries := webhookRepo.Deliveries()
+	assert.Len(t, deliveries, 1)
+
+	var n relayer.MessageStatusNotification
+
+	assert.Nil(t, json.Unmarshal(deliveries[0].Payload, &n))
+	assert.Equal(t, common.Hash(mock.SuccessMsgHash).Hex(), n.MsgHash)
+	assert.Equal(t, owner.Hex(), n.Owner)
+	assert.Equal(t, relayer.EventNameMessageSent, n.Event)
+	assert.Equal(t, relayer.EventStatusNew.String(), n.StatusName)
+}
@@ -34,20 +34,44 @@ func (i *Indexer) handleMessageStatusChangedEvent(
 	}
 
 	_, err = i.eventRepo.Save(ctx, &relayer.SaveEventOpts{
-		Name:           relayer.EventNameMessageStatusChanged,
-		Data:           string(marshaled),
-		ChainID:        chainID,
-		DestChainID:    i.destChainId,
-		Status:         relayer.EventStatus(event.Status),
-		MessageOwner:   e.MessageOwner,
-		MsgHash:        common.Hash(event.MsgHash).Hex(),
-		Event:          relayer.EventNameMessageStatusChanged,
-		EmittedBlockID: event.Raw.BlockNumber,
+		Name:             relayer.EventNameMessageStatusChanged,
+		Data:             string(marshaled),
+		ChainID:          chainID,
+		DestChainID:      i.destChainId,
+		Status:           relayer.EventStatus(event.Status),
+		MessageOwner:     e.MessageOwner,
+		MsgHash:          common.Hash(event.MsgHash).Hex(),
+		Event:            relayer.EventNameMessageStatusChanged,
+		EmittedBlockID:   event.Raw.BlockNumber,
+		EmittedBlockHash: event.Raw.BlockHash.Hex(),
 	})
 	if err != nil {
 		return errors.Wrap(err, "i.eventRepo.Save")
 	}
 
+	status := relayer.EventStatus(event.Status)
+
+	i.saveMessageHistory(ctx, &relayer.SaveMessageHistoryOpts{
+		MsgHash:     common.Hash(event.MsgHash).Hex(),
+		Kind:        relayer.MessageHistoryKindStatusChanged,
+		ChainID:     chainID,
+		DestChainID: i.destChainId,
+		Status:      &status,
+		TxHash:      event.Raw.TxHash.Hex(),
+		BlockID:     event.Raw.BlockNumber,
+	})
+
+	i.notifyStatusChange(ctx, &relayer.MessageStatusNotification{
+		MsgHash:     common.Hash(event.MsgHash).Hex(),
+		Owner:       e.MessageOwner,
+		Event:       relayer.EventNameMessageStatusChanged,
+		Status:      status,
+		ChainID:     chainID.Int64(),
+		DestChainID: i.destChainId.Int64(),
+		TxHash:      event.Raw.TxHash.Hex(),
+		BlockID:     event.Raw.BlockNumber,
+	})
+
 	relayer.MessageStatusChangedEventsIndexed.Inc()
 
 	return nil
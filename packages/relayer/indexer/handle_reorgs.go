@@ -0,0 +1,105 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// indexedEventNames returns the names of the events saved by the indexer, which are the
+// events it checks for reorgs.
+func (i *Indexer) indexedEventNames() []string {
+	if i.eventName == relayer.EventNameMessageSent {
+		return []string{
+			relayer.EventNameMessageSent,
+			relayer.EventNameMessageStatusChanged,
+			relayer.EventNameCheckpointSaved,
+		}
+	}
+
+	return []string{i.eventName}
+}
+
+// handleReorgs verifies the hashes of the blocks the events of the latest reorgCheckDepth
+// blocks were emitted in against the canonical chain. The events of the blocks that were
+// reorged out are deleted, and the indexing is rewound to the lowest of these blocks, so the
+// events of the canonical chain are indexed again. The queued messages of the deleted events
+// are skipped by the processor, which checks the block of a message is canonical.
+func (i *Indexer) handleReorgs(ctx context.Context, headBlockID uint64) error {
+	opts := relayer.FindEmittedBlocksOpts{
+		ChainID:     i.srcChainId.Uint64(),
+		DestChainID: i.destChainId.Uint64(),
+		Names:       i.indexedEventNames(),
+	}
+
+	if headBlockID > i.reorgCheckDepth {
+		opts.FromBlockID = headBlockID - i.reorgCheckDepth
+	}
+
+	blocks, err := i.eventRepo.FindEmittedBlocks(ctx, opts)
+	if err != nil {
+		return errors.Wrap(err, "i.eventRepo.FindEmittedBlocks")
+	}
+
+	var lowestReorgedBlockID uint64
+
+	for _, block := range blocks {
+		canonical, err := i.isCanonicalBlock(ctx, block)
+		if err != nil {
+			return err
+		}
+
+		if canonical {
+			continue
+		}
+
+		deleted, err := i.eventRepo.DeleteAllByEmittedBlock(ctx, opts, block)
+		if err != nil {
+			return errors.Wrap(err, "i.eventRepo.DeleteAllByEmittedBlock")
+		}
+
+		slog.Warn("reorg detected, deleted events of reorged out block",
+			"blockID", block.EmittedBlockID,
+			"blockHash", block.EmittedBlockHash,
+			"deletedEvents", deleted,
+		)
+
+		relayer.OrphanedEventsDeleted.Add(float64(deleted))
+
+		if lowestReorgedBlockID == 0 || block.EmittedBlockID < lowestReorgedBlockID {
+			lowestReorgedBlockID = block.EmittedBlockID
+		}
+	}
+
+	if lowestReorgedBlockID == 0 {
+		return nil
+	}
+
+	relayer.ReorgDepth.Observe(float64(headBlockID - lowestReorgedBlockID + 1))
+
+	if i.latestIndexedBlockNumber >= lowestReorgedBlockID {
+		i.latestIndexedBlockNumber = lowestReorgedBlockID - 1
+	}
+
+	return nil
+}
+
+// isCanonicalBlock returns whether the block is still part of the canonical chain. A block
+// beyond the head of a chain shortened by a reorg is not.
+func (i *Indexer) isCanonicalBlock(ctx context.Context, block relayer.EmittedBlock) (bool, error) {
+	header, err := i.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(block.EmittedBlockID))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "i.srcEthClient.HeaderByNumber")
+	}
+
+	return header.Hash().Hex() == block.EmittedBlockHash, nil
+}
@@ -0,0 +1,86 @@
+package indexer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_handleReorgs(t *testing.T) {
+	i, _ := newTestService(Sync, FilterAndSubscribe)
+	i.reorgCheckDepth = 64
+	i.eventName = relayer.EventNameMessageSent
+	i.latestIndexedBlockNumber = 10
+
+	canonical, err := i.srcEthClient.HeaderByNumber(context.Background(), big.NewInt(5))
+	require.NoError(t, err)
+
+	save := func(name string, blockID uint64, blockHash string) {
+		_, err := i.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:             name,
+			Event:            name,
+			ChainID:          mock.MockChainID,
+			DestChainID:      mock.MockChainID,
+			EmittedBlockID:   blockID,
+			EmittedBlockHash: blockHash,
+		})
+		require.NoError(t, err)
+	}
+
+	save(relayer.EventNameMessageSent, 5, canonical.Hash().Hex())
+	save(relayer.EventNameMessageSent, 7, common.HexToHash("0x7").Hex())
+	save(relayer.EventNameMessageStatusChanged, 7, common.HexToHash("0x7").Hex())
+	save(relayer.EventNameMessageSent, 8, common.HexToHash("0x8").Hex())
+	// events saved without their block hash can not be verified.
+	save(relayer.EventNameMessageSent, 9, "")
+	// events of other indexers are left for them to verify.
+	save(relayer.EventNameMessageProcessed, 6, common.HexToHash("0x6").Hex())
+
+	require.NoError(t, i.handleReorgs(context.Background(), 10))
+
+	eventRepo := i.eventRepo.(*mock.EventRepository)
+	assert.Equal(t, 3, eventRepo.SavedCount())
+	assert.Equal(t, uint64(6), i.latestIndexedBlockNumber)
+
+	blocks, err := i.eventRepo.FindEmittedBlocks(context.Background(), relayer.FindEmittedBlocksOpts{
+		ChainID:     mock.MockChainID.Uint64(),
+		DestChainID: mock.MockChainID.Uint64(),
+		Names:       i.indexedEventNames(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []relayer.EmittedBlock{{EmittedBlockID: 5, EmittedBlockHash: canonical.Hash().Hex()}}, blocks)
+
+	// a chain without reorgs leaves the indexing where it is.
+	i.latestIndexedBlockNumber = 10
+
+	require.NoError(t, i.handleReorgs(context.Background(), 10))
+	assert.Equal(t, uint64(10), i.latestIndexedBlockNumber)
+}
+
+func Test_isCanonicalBlock(t *testing.T) {
+	i, _ := newTestService(Sync, FilterAndSubscribe)
+
+	header := &types.Header{Number: big.NewInt(3)}
+
+	canonical, err := i.isCanonicalBlock(context.Background(), relayer.EmittedBlock{
+		EmittedBlockID:   3,
+		EmittedBlockHash: header.Hash().Hex(),
+	})
+	require.NoError(t, err)
+	assert.True(t, canonical)
+
+	canonical, err = i.isCanonicalBlock(context.Background(), relayer.EmittedBlock{
+		EmittedBlockID:   3,
+		EmittedBlockHash: common.HexToHash("0x3").Hex(),
+	})
+	require.NoError(t, err)
+	assert.False(t, canonical)
+}
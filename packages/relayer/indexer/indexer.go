@@ -25,6 +25,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/utils"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/webhook"
 )
 
 var (
@@ -80,8 +81,9 @@ type ethClient interface {
 // as its source, and vice versa for the L2-L1 indexer. They will add messages to a queue
 // specifically for a processor of the same configuration.
 type Indexer struct {
-	eventRepo    relayer.EventRepository
-	srcEthClient ethClient
+	eventRepo          relayer.EventRepository
+	messageHistoryRepo relayer.MessageHistoryRepository
+	srcEthClient       ethClient
 
 	latestIndexedBlockNumber uint64
 
@@ -124,6 +126,11 @@ type Indexer struct {
 	cfg *Config
 
 	confirmations uint64
+
+	// webhooks is only set when the webhook notifications are enabled.
+	webhooks *webhook.Dispatcher
+
+	reorgCheckDepth uint64
 }
 
 // InitFromCli inits a new Indexer from command line or environment variables.
@@ -148,6 +155,30 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 		return err
 	}
 
+	messageHistoryRepository, err := repo.NewMessageHistoryRepository(db)
+	if err != nil {
+		return err
+	}
+
+	var webhooks *webhook.Dispatcher
+
+	if cfg.Webhooks {
+		webhookRepository, err := repo.NewWebhookRepository(db)
+		if err != nil {
+			return err
+		}
+
+		if webhooks, err = webhook.NewDispatcher(webhook.NewDispatcherOpts{
+			WebhookRepo:   webhookRepository,
+			Interval:      cfg.WebhooksInterval,
+			RetryInterval: cfg.WebhooksRetryInterval,
+			MaxAttempts:   cfg.WebhooksMaxAttempts,
+			Timeout:       cfg.WebhooksTimeout,
+		}); err != nil {
+			return err
+		}
+	}
+
 	srcEthClient, err := ethclient.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
@@ -206,6 +237,8 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 	}
 
 	i.eventRepo = eventRepository
+	i.messageHistoryRepo = messageHistoryRepository
+	i.webhooks = webhooks
 	i.srcEthClient = srcEthClient
 
 	i.bridge = srcBridge
@@ -238,6 +271,8 @@ func InitFromConfig(ctx context.Context, i *Indexer, cfg *Config) (err error) {
 
 	i.confirmations = cfg.Confirmations
 
+	i.reorgCheckDepth = cfg.ReorgCheckDepth
+
 	i.ctx = ctx
 
 	i.minFeeToIndex = i.cfg.MinFeeToIndex
@@ -286,6 +321,10 @@ func (i *Indexer) Start() error {
 
 	go i.eventLoop(i.ctx)
 
+	if i.webhooks != nil {
+		go i.webhooks.Start(i.ctx, &i.wg)
+	}
+
 	go func() {
 		if err := backoff.Retry(func() error {
 			return utils.ScanBlocks(i.ctx, i.srcEthClient, &i.wg)
@@ -372,6 +411,13 @@ func (i *Indexer) filter(ctx context.Context) error {
 		}
 	}
 
+	// the events of the reorged out blocks are deleted, and their blocks indexed again.
+	if i.watchMode != CrawlPastBlocks {
+		if err := i.handleReorgs(ctx, header.Number.Uint64()); err != nil {
+			return errors.Wrap(err, "i.handleReorgs")
+		}
+	}
+
 	slog.Info("fetching batch block events",
 		"chainID", i.srcChainId.Uint64(),
 		"latestIndexedBlockNumber", i.latestIndexedBlockNumber,
@@ -445,19 +491,9 @@ func (i *Indexer) indexMessageSentEvents(ctx context.Context,
 	group, _ := errgroup.WithContext(ctx)
 	group.SetLimit(i.numGoroutines)
 
-	first := true
-
 	for events.Next() {
 		event := events.Event
 
-		if i.watchMode != CrawlPastBlocks && first {
-			first = false
-
-			if err := i.checkReorg(ctx, event.Raw.BlockNumber); err != nil {
-				return err
-			}
-		}
-
 		group.Go(func() error {
 			err := i.handleMessageSentEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
@@ -480,21 +516,6 @@ func (i *Indexer) indexMessageSentEvents(ctx context.Context,
 	return nil
 }
 
-func (i *Indexer) checkReorg(ctx context.Context, emittedInBlockNumber uint64) error {
-	n, err := i.eventRepo.FindLatestBlockID(ctx, i.eventName, i.srcChainId.Uint64(), i.destChainId.Uint64())
-	if err != nil {
-		return err
-	}
-
-	if n >= emittedInBlockNumber {
-		slog.Info("reorg detected", "event emitted in", emittedInBlockNumber, "latest emitted block id from db", n)
-		// reorg detected, we have seen a higher block number than this already.
-		return i.eventRepo.DeleteAllAfterBlockID(emittedInBlockNumber, i.srcChainId.Uint64(), i.destChainId.Uint64())
-	}
-
-	return nil
-}
-
 // indexMessageProcessedEvents indexes `MessageProcessed` events on the bridge contract
 // and stores them to the database, and adds the message to the queue if it has not been
 // seen before.
@@ -510,19 +531,9 @@ func (i *Indexer) indexMessageProcessedEvents(ctx context.Context,
 	group, _ := errgroup.WithContext(ctx)
 	group.SetLimit(i.numGoroutines)
 
-	first := true
-
 	for events.Next() {
 		event := events.Event
 
-		if i.watchMode != CrawlPastBlocks && first {
-			first = false
-
-			if err := i.checkReorg(ctx, event.Raw.BlockNumber); err != nil {
-				return err
-			}
-		}
-
 		group.Go(func() error {
 			err := i.handleMessageProcessedEvent(ctx, i.srcChainId, event, true)
 			if err != nil {
@@ -26,12 +26,13 @@ func newTestService(syncMode SyncMode, watchMode WatchMode) (*Indexer, relayer.B
 	}
 
 	return &Indexer{
-		eventRepo:     &mock.EventRepository{},
-		bridge:        b,
-		destBridge:    b,
-		srcEthClient:  ethClient,
-		signalService: ss,
-		numGoroutines: 10,
+		eventRepo:          &mock.EventRepository{},
+		messageHistoryRepo: mock.NewMessageHistoryRepository(),
+		bridge:             b,
+		destBridge:         b,
+		srcEthClient:       ethClient,
+		signalService:      ss,
+		numGoroutines:      10,
 
 		latestIndexedBlockNumber: 0,
 		blockBatchSize:           100,
@@ -0,0 +1,32 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// saveMessageHistory appends an entry to the lifecycle timeline of a message. The timeline
+// is informational, failing to save it does not fail the indexing of the event.
+func (i *Indexer) saveMessageHistory(ctx context.Context, opts *relayer.SaveMessageHistoryOpts) {
+	if _, err := i.messageHistoryRepo.Save(ctx, opts); err != nil {
+		slog.Warn("failed to save message history",
+			"msgHash", opts.MsgHash,
+			"kind", opts.Kind,
+			"error", err,
+		)
+	}
+}
+
+// saveMessageHistoryOnce appends an entry to the timeline of a message unless it already has
+// an entry of the same kind and status, saved by the processor or by an earlier indexing.
+func (i *Indexer) saveMessageHistoryOnce(ctx context.Context, opts *relayer.SaveMessageHistoryOpts) {
+	if _, err := i.messageHistoryRepo.SaveOnce(ctx, opts); err != nil {
+		slog.Warn("failed to save message history",
+			"msgHash", opts.MsgHash,
+			"kind", opts.Kind,
+			"error", err,
+		)
+	}
+}
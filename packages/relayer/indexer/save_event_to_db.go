@@ -6,11 +6,13 @@ import (
 	"log/slog"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 )
 
-// saveEventToDB is used to save any type of event to the database
+// saveEventToDB is used to save any type of event to the database, it returns the id of
+// the event, and whether it was created rather than already saved.
 func (i *Indexer) saveEventToDB(
 	ctx context.Context,
 	marshalledEvent []byte,
@@ -21,12 +23,13 @@ func (i *Indexer) saveEventToDB(
 	eventData []byte,
 	eventValue *big.Int,
 	emittedBlockNumber uint64,
-) (int, error) {
+	emittedBlockHash common.Hash,
+) (int, bool, error) {
 	eventType, canonicalToken, amount, err := relayer.DecodeMessageData(eventData, eventValue)
 	if err != nil {
 		slog.Error("error decoding message data", "error", err.Error())
 
-		return 0, errors.Wrap(err, "relayer.DecodeMessageData")
+		return 0, false, errors.Wrap(err, "relayer.DecodeMessageData")
 	}
 
 	if eventType == relayer.EventTypeSendETH {
@@ -42,26 +45,29 @@ func (i *Indexer) saveEventToDB(
 		msgHash,
 	)
 	if err != nil {
-		return 0, errors.Wrap(err, "i.eventRepo.FirstByEventAndMsgHash")
+		return 0, false, errors.Wrap(err, "i.eventRepo.FirstByEventAndMsgHash")
 	}
 
 	var id int
 
+	var created bool
+
 	// if we dont have an existing event, we want to create a database entry
 	// for the processor to be able to fetch it.
 	if existingEvent == nil {
 		opts := relayer.SaveEventOpts{
-			Name:           i.eventName,
-			Data:           string(marshalledEvent),
-			ChainID:        chainID,
-			DestChainID:    i.destChainId,
-			Status:         eventStatus,
-			EventType:      eventType,
-			Amount:         amount.String(),
-			MsgHash:        msgHash,
-			MessageOwner:   msgOwner,
-			Event:          i.eventName,
-			EmittedBlockID: emittedBlockNumber,
+			Name:             i.eventName,
+			Data:             string(marshalledEvent),
+			ChainID:          chainID,
+			DestChainID:      i.destChainId,
+			Status:           eventStatus,
+			EventType:        eventType,
+			Amount:           amount.String(),
+			MsgHash:          msgHash,
+			MessageOwner:     msgOwner,
+			Event:            i.eventName,
+			EmittedBlockID:   emittedBlockNumber,
+			EmittedBlockHash: emittedBlockHash.Hex(),
 		}
 
 		if canonicalToken != nil {
@@ -73,10 +79,11 @@ func (i *Indexer) saveEventToDB(
 
 		e, err := i.eventRepo.Save(ctx, &opts)
 		if err != nil {
-			return 0, errors.Wrap(err, "svc.eventRepo.Save")
+			return 0, false, errors.Wrap(err, "svc.eventRepo.Save")
 		}
 
 		id = e.ID
+		created = true
 	} else {
 		// otherwise, we can use the existing event ID for the body.
 		id = existingEvent.ID
@@ -87,19 +94,29 @@ func (i *Indexer) saveEventToDB(
 			if i.watchMode == CrawlPastBlocks && eventStatus == existingEvent.Status {
 				// If the status from contract matches the existing event status,
 				// we can return early as this message has been processed as expected.
-				return id, nil
+				return id, false, nil
 			}
 
 			// If the status from contract is done, update the database
 			if i.watchMode == CrawlPastBlocks && eventStatus == relayer.EventStatusDone {
 				if err := i.eventRepo.UpdateStatus(ctx, id, relayer.EventStatusDone); err != nil {
-					return 0, errors.Wrap(err, fmt.Sprintf("i.eventRepo.UpdateStatus, id: %v", id))
+					return 0, false, errors.Wrap(err, fmt.Sprintf("i.eventRepo.UpdateStatus, id: %v", id))
 				}
 
-				return id, nil
+				i.notifyStatusChange(ctx, &relayer.MessageStatusNotification{
+					MsgHash:     msgHash,
+					Owner:       msgOwner,
+					Event:       i.eventName,
+					Status:      relayer.EventStatusDone,
+					ChainID:     chainID.Int64(),
+					DestChainID: i.destChainId.Int64(),
+					BlockID:     emittedBlockNumber,
+				})
+
+				return id, false, nil
 			}
 		}
 	}
 
-	return id, nil
+	return id, created, nil
 }
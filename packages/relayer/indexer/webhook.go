@@ -0,0 +1,26 @@
+package indexer
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// notifyStatusChange notifies the webhook subscribers of a message status change, when the
+// webhooks are enabled. Notifying is best effort, failing does not fail the indexing of the event.
+func (i *Indexer) notifyStatusChange(ctx context.Context, n *relayer.MessageStatusNotification) {
+	if i.webhooks == nil {
+		return
+	}
+
+	n.StatusName = n.Status.String()
+
+	if err := i.webhooks.Notify(ctx, n); err != nil {
+		slog.Warn("failed to notify webhooks",
+			"msgHash", n.MsgHash,
+			"status", n.StatusName,
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,55 @@
+package relayer
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// Kinds of the entries of a message lifecycle timeline.
+var (
+	MessageHistoryKindMessageSent            = "MessageSent"
+	MessageHistoryKindHeaderSynced           = "HeaderSynced"
+	MessageHistoryKindProfitabilityEvaluated = "ProfitabilityEvaluated"
+	MessageHistoryKindProcessingAttempt      = "ProcessingAttempt"
+	MessageHistoryKindRetryAttempt           = "RetryAttempt"
+	MessageHistoryKindMessageProcessed       = "MessageProcessed"
+	MessageHistoryKindStatusChanged          = "MessageStatusChanged"
+)
+
+// MessageHistory is an entry of the lifecycle timeline of a bridge message. Entries are
+// only ever appended, by the indexer and the processor, as the message makes progress.
+type MessageHistory struct {
+	ID          int            `json:"id"`
+	MsgHash     string         `json:"msgHash"`
+	Kind        string         `json:"kind"`
+	ChainID     int64          `json:"chainID"`
+	DestChainID int64          `json:"destChainID"`
+	Status      *EventStatus   `json:"status,omitempty"`
+	TxHash      string         `json:"txHash,omitempty"`
+	BlockID     uint64         `json:"blockID,omitempty"`
+	Error       string         `json:"error,omitempty"`
+	Data        datatypes.JSON `json:"data,omitempty"`
+	CreatedAt   time.Time      `json:"createdAt"`
+}
+
+type SaveMessageHistoryOpts struct {
+	MsgHash     string
+	Kind        string
+	ChainID     *big.Int
+	DestChainID *big.Int
+	Status      *EventStatus
+	TxHash      string
+	BlockID     uint64
+	Error       string
+	Data        string
+}
+
+// MessageHistoryRepository is used to interact with message timelines in the store
+type MessageHistoryRepository interface {
+	Save(ctx context.Context, opts *SaveMessageHistoryOpts) (*MessageHistory, error)
+	SaveOnce(ctx context.Context, opts *SaveMessageHistoryOpts) (*MessageHistory, error)
+	FindAllByMsgHash(ctx context.Context, msgHash string) ([]*MessageHistory, error)
+}
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS queue_messages (
+    id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    queue_name VARCHAR(255) NOT NULL,
+    body LONGBLOB NOT NULL,
+    headers JSON NULL,
+    dead BOOLEAN NOT NULL DEFAULT false,
+    delivery_id VARCHAR(36) NOT NULL DEFAULT "",
+    deliveries INT UNSIGNED NOT NULL DEFAULT 0,
+    visible_at DATETIME(3) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    KEY `queue_name_dead_visible_at_index` (`queue_name`, `dead`, `visible_at`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE queue_messages;
+-- +goose StatementEnd
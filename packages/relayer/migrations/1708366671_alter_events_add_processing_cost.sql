@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `events`
+ADD COLUMN `processed_gas_used` BIGINT UNSIGNED NULL,
+ADD COLUMN `processed_gas_price` BIGINT UNSIGNED NULL,
+ADD COLUMN `processed_relayer_fee` BIGINT UNSIGNED NULL,
+ADD COLUMN `processed_at` TIMESTAMP NULL,
+ADD INDEX `event_type_dest_chain_id_processed_at_index` (`event_type`, `dest_chain_id`, `processed_at`);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `events`
+DROP INDEX `event_type_dest_chain_id_processed_at_index`,
+DROP COLUMN `processed_gas_used`,
+DROP COLUMN `processed_gas_price`,
+DROP COLUMN `processed_relayer_fee`,
+DROP COLUMN `processed_at`;
+-- +goose StatementEnd
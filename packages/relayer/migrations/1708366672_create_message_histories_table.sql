@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS message_histories (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    msg_hash VARCHAR(255) NOT NULL,
+    kind VARCHAR(255) NOT NULL,
+    chain_id int NOT NULL,
+    dest_chain_id int NOT NULL,
+    status int NULL,
+    tx_hash VARCHAR(255) NOT NULL DEFAULT "",
+    block_id BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    error TEXT NULL,
+    data JSON NULL,
+    created_at timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    INDEX `msg_hash_id_index` (`msg_hash`, `id`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE message_histories;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    owner VARCHAR(255) NOT NULL DEFAULT "",
+    msg_hash VARCHAR(255) NOT NULL DEFAULT "",
+    created_at timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    INDEX `owner_index` (`owner`),
+    INDEX `msg_hash_index` (`msg_hash`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_subscriptions;
+-- +goose StatementEnd
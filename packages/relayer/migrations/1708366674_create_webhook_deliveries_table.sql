@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id int NOT NULL PRIMARY KEY AUTO_INCREMENT,
+    subscription_id int NOT NULL,
+    msg_hash VARCHAR(255) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(255) NOT NULL,
+    attempts int UNSIGNED NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+    next_attempt_at DATETIME(3) NOT NULL,
+    delivered_at DATETIME(3) NULL,
+    created_at timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+    INDEX `status_next_attempt_at_index` (`status`, `next_attempt_at`),
+    INDEX `subscription_id_index` (`subscription_id`)
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `events`
+ADD COLUMN `declared_onchain_fee` BIGINT UNSIGNED NULL,
+ADD COLUMN `simulated_gas_used` BIGINT UNSIGNED NULL,
+ADD COLUMN `data_cost` BIGINT UNSIGNED NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `events`
+DROP COLUMN `declared_onchain_fee`,
+DROP COLUMN `simulated_gas_used`,
+DROP COLUMN `data_cost`;
+-- +goose StatementEnd
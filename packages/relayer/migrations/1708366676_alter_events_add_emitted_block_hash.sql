@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `events`
+ADD COLUMN `emitted_block_hash` VARCHAR(66) NOT NULL DEFAULT "",
+ADD INDEX `chain_id_dest_chain_id_emitted_block_id_index` (`chain_id`, `dest_chain_id`, `emitted_block_id`);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `events`
+DROP INDEX `chain_id_dest_chain_id_emitted_block_id_index`,
+DROP COLUMN `emitted_block_hash`;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE `events`
+ADD INDEX `name_status_id_index` (`name`, `status`, `id`),
+ADD INDEX `name_dest_chain_id_id_index` (`name`, `dest_chain_id`, `id`),
+ADD INDEX `name_canonical_token_address_id_index` (`name`, `canonical_token_address`, `id`),
+ADD INDEX `name_created_at_index` (`name`, `created_at`);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE `events`
+DROP INDEX `name_status_id_index`,
+DROP INDEX `name_dest_chain_id_id_index`,
+DROP INDEX `name_canonical_token_address_id_index`,
+DROP INDEX `name_created_at_index`;
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+	mysqlqueue "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/mysql"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
+	"github.com/urfave/cli/v2"
+)
+
+// InitOpenQueueFuncFromCli returns the function opening the queue of the driver selected by the
+// command line flags, the mysql driver keeps the queue in a database opened by openDB.
+func InitOpenQueueFuncFromCli(
+	c *cli.Context,
+	openDB func() (db.DB, error),
+) (func() (queue.Queue, error), error) {
+	switch driver := c.String(flags.QueueDriver.Name); driver {
+	case queue.DriverRabbitMQ:
+		for _, f := range []cli.Flag{flags.QueueUsername, flags.QueuePassword, flags.QueueHost, flags.QueuePort} {
+			if name := f.Names()[0]; !c.IsSet(name) {
+				return nil, fmt.Errorf("%s is required by the %s queue driver", name, driver)
+			}
+		}
+
+		return func() (queue.Queue, error) {
+			q, err := rabbitmq.NewQueue(queue.NewQueueOpts{
+				Username:      c.String(flags.QueueUsername.Name),
+				Password:      c.String(flags.QueuePassword.Name),
+				Host:          c.String(flags.QueueHost.Name),
+				Port:          c.String(flags.QueuePort.Name),
+				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return q, nil
+		}, nil
+	case queue.DriverMySQL:
+		return func() (queue.Queue, error) {
+			// the queue gets its own connection, which is closed along with the queue.
+			database, err := openDB()
+			if err != nil {
+				return nil, err
+			}
+
+			q, err := mysqlqueue.NewQueue(database, mysqlqueue.NewQueueOpts{
+				PrefetchCount:     c.Uint64(flags.QueuePrefetchCount.Name),
+				VisibilityTimeout: c.Duration(flags.QueueVisibilityTimeout.Name),
+				DeadRetention:     c.Duration(flags.QueueDeadRetention.Name),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return q, nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported queue driver: %s", driver)
+	}
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type getMessageTimelineResponse struct {
+	MsgHash  string                    `json:"msgHash"`
+	Timeline []*relayer.MessageHistory `json:"timeline"`
+}
+
+// GetMessageTimeline
+//
+//	 returns the lifecycle timeline of a message, oldest entry first
+//
+//			@Summary		Get message timeline
+//			@ID			   	get-message-timeline
+//		    @Param			msgHash	path		string		true	"msgHash to query"
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} getMessageTimelineResponse
+//			@Router			/messages/{msgHash} [get]
+func (srv *Server) GetMessageTimeline(c echo.Context) error {
+	b, err := hexutil.Decode(c.Param("msgHash"))
+	if err != nil || len(b) != common.HashLength {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid msgHash param"))
+	}
+
+	msgHash := common.BytesToHash(b).Hex()
+
+	timeline, err := srv.messageHistoryRepo.FindAllByMsgHash(c.Request().Context(), msgHash)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if len(timeline) == 0 {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, errors.New("message not found"))
+	}
+
+	return c.JSON(http.StatusOK, getMessageTimelineResponse{
+		MsgHash:  msgHash,
+		Timeline: timeline,
+	})
+}
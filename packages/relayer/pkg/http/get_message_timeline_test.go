@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_GetMessageTimeline(t *testing.T) {
+	srv := newTestServer()
+
+	msgHash := "0x47ce4d255907937aba12dfa09d87a0a707fea7eeac687924ac0a80fa291c3289"
+	status := relayer.EventStatusDone
+
+	for _, opts := range []*relayer.SaveMessageHistoryOpts{
+		{Kind: relayer.MessageHistoryKindMessageSent, TxHash: "0x1", BlockID: 5},
+		{Kind: relayer.MessageHistoryKindProcessingAttempt, Error: "transaction reverted"},
+		{Kind: relayer.MessageHistoryKindStatusChanged, Status: &status},
+	} {
+		opts.MsgHash = msgHash
+		opts.ChainID = big.NewInt(167001)
+		opts.DestChainID = big.NewInt(167002)
+
+		_, err := srv.messageHistoryRepo.Save(context.Background(), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		msgHash               string
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"success",
+			msgHash,
+			http.StatusOK,
+			[]string{
+				`"kind":"MessageSent","chainID":167001,"destChainID":167002,"txHash":"0x1","blockID":5`,
+				`"kind":"ProcessingAttempt".*"error":"transaction reverted"`,
+				`"kind":"MessageStatusChanged".*"status":2`,
+			},
+		},
+		{
+			"successUppercase",
+			"0x47CE4D255907937ABA12DFA09D87A0A707FEA7EEAC687924AC0A80FA291C3289",
+			http.StatusOK,
+			[]string{`"msgHash":"` + msgHash + `"`},
+		},
+		{
+			"notFound",
+			"0x0000000000000000000000000000000000000000000000000000000000000001",
+			http.StatusNotFound,
+			[]string{`message not found`},
+		},
+		{
+			"invalidMsgHash",
+			"0x1",
+			http.StatusBadRequest,
+			[]string{`invalid msgHash param`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(
+				echo.GET,
+				"/messages/"+tt.msgHash,
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
@@ -0,0 +1,254 @@
+package http
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+const (
+	defaultMessagesLimit = 100
+	maxMessagesLimit     = 1000
+)
+
+// messagesCSVHeader are the columns of the messages exported as CSV.
+var messagesCSVHeader = []string{
+	"id",
+	"msgHash",
+	"status",
+	"eventType",
+	"chainID",
+	"destChainID",
+	"messageOwner",
+	"canonicalTokenAddress",
+	"canonicalTokenSymbol",
+	"amount",
+	"fee",
+	"isProfitable",
+	"estimatedOnchainFee",
+	"emittedBlockID",
+	"createdAt",
+}
+
+type getMessagesResponse struct {
+	Items []*relayer.Event `json:"items"`
+	// NextCursor is the cursor of the next page, 0 when this page is the last one.
+	NextCursor int `json:"nextCursor"`
+}
+
+// GetMessages
+//
+//	 returns the messages of all owners matching the filters, newest first, as JSON or CSV.
+//	 The next page is requested with the nextCursor of the page, which is also returned
+//	 in the X-Next-Cursor header.
+//
+//			@Summary		Get messages
+//			@ID			   	get-messages
+//		    @Param			status	query		string		false	"comma-delimited statuses to query"
+//		    @Param			profitable	query		bool		false	"profitability to query"
+//		    @Param			destChainID	query		string		false	"destChainID to query"
+//		    @Param			token	query		string		false	"canonical token address to query"
+//		    @Param			minFee	query		string		false	"minimum fee to query"
+//		    @Param			maxFee	query		string		false	"maximum fee to query"
+//		    @Param			olderThan	query		string		false	"minimum age to query, as a duration"
+//		    @Param			newerThan	query		string		false	"maximum age to query, as a duration"
+//		    @Param			cursor	query		string		false	"cursor of the page"
+//		    @Param			limit	query		string		false	"size of the page"
+//		    @Param			format	query		string		false	"json or csv"
+//			@Accept			json
+//			@Produce		json
+//			@Produce		text/csv
+//			@Success		200	{object} getMessagesResponse
+//			@Router			/admin/messages [get]
+func (srv *Server) GetMessages(c echo.Context) error {
+	opts, err := findAllMessagesOptsFromQuery(c)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	format := c.QueryParam("format")
+	if format != "" && format != "json" && format != "csv" {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid format"))
+	}
+
+	events, err := srv.eventRepo.FindAllMessages(c.Request().Context(), opts)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	resp := getMessagesResponse{Items: events}
+
+	if len(events) == opts.Limit {
+		resp.NextCursor = events[len(events)-1].ID
+	}
+
+	c.Response().Header().Set("X-Next-Cursor", strconv.Itoa(resp.NextCursor))
+
+	if format != "csv" {
+		return c.JSON(http.StatusOK, resp)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="messages.csv"`)
+	c.Response().WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(c.Response())
+
+	if err := w.Write(messagesCSVHeader); err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		if err := w.Write(messageCSVRecord(e)); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
+
+// findAllMessagesOptsFromQuery parses the filters and the page of the messages query.
+func findAllMessagesOptsFromQuery(c echo.Context) (relayer.FindAllMessagesOpts, error) {
+	opts := relayer.FindAllMessagesOpts{
+		Limit: defaultMessagesLimit,
+	}
+
+	if status := c.QueryParam("status"); status != "" {
+		for _, s := range strings.Split(status, ",") {
+			eventStatus, err := parseEventStatus(strings.TrimSpace(s))
+			if err != nil {
+				return opts, err
+			}
+
+			opts.Statuses = append(opts.Statuses, eventStatus)
+		}
+	}
+
+	if profitable := c.QueryParam("profitable"); profitable != "" {
+		isProfitable, err := strconv.ParseBool(profitable)
+		if err != nil {
+			return opts, fmt.Errorf("invalid profitable: %w", err)
+		}
+
+		opts.IsProfitable = &isProfitable
+	}
+
+	if token := c.QueryParam("token"); token != "" {
+		if !common.IsHexAddress(token) {
+			return opts, errors.New("invalid token")
+		}
+
+		opts.CanonicalTokenAddress = common.HexToAddress(token).Hex()
+	}
+
+	for param, value := range map[string]**uint64{
+		"destChainID": &opts.DestChainID,
+		"minFee":      &opts.MinFee,
+		"maxFee":      &opts.MaxFee,
+	} {
+		if c.QueryParam(param) == "" {
+			continue
+		}
+
+		v, err := strconv.ParseUint(c.QueryParam(param), 10, 64)
+		if err != nil {
+			return opts, fmt.Errorf("invalid %v: %w", param, err)
+		}
+
+		*value = &v
+	}
+
+	now := time.Now().UTC()
+
+	for param, value := range map[string]**time.Time{
+		"olderThan": &opts.CreatedBefore,
+		"newerThan": &opts.CreatedAfter,
+	} {
+		if c.QueryParam(param) == "" {
+			continue
+		}
+
+		age, err := time.ParseDuration(c.QueryParam(param))
+		if err != nil {
+			return opts, fmt.Errorf("invalid %v: %w", param, err)
+		}
+
+		createdAt := now.Add(-age)
+		*value = &createdAt
+	}
+
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		beforeID, err := strconv.Atoi(cursor)
+		if err != nil || beforeID < 0 {
+			return opts, errors.New("invalid cursor")
+		}
+
+		opts.BeforeID = beforeID
+	}
+
+	if limit := c.QueryParam("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 || l > maxMessagesLimit {
+			return opts, fmt.Errorf("invalid limit, must be between 1 and %v", maxMessagesLimit)
+		}
+
+		opts.Limit = l
+	}
+
+	return opts, nil
+}
+
+// parseEventStatus returns the event status of its string representation.
+func parseEventStatus(s string) (relayer.EventStatus, error) {
+	for status := relayer.EventStatusNew; status <= relayer.EventStatusRecalled; status++ {
+		if status.String() == s {
+			return status, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid status %v", s)
+}
+
+func messageCSVRecord(e *relayer.Event) []string {
+	optionalUint64 := func(v *uint64) string {
+		if v == nil {
+			return ""
+		}
+
+		return strconv.FormatUint(*v, 10)
+	}
+
+	isProfitable := ""
+	if e.IsProfitable != nil {
+		isProfitable = strconv.FormatBool(*e.IsProfitable)
+	}
+
+	return []string{
+		strconv.Itoa(e.ID),
+		e.MsgHash,
+		e.Status.String(),
+		e.EventType.String(),
+		strconv.FormatInt(e.ChainID, 10),
+		strconv.FormatInt(e.DestChainID, 10),
+		e.MessageOwner,
+		e.CanonicalTokenAddress,
+		e.CanonicalTokenSymbol,
+		e.Amount,
+		optionalUint64(e.Fee),
+		isProfitable,
+		optionalUint64(e.EstimatedOnchainFee),
+		strconv.FormatUint(e.EmittedBlockID, 10),
+		e.CreatedAt.Format(time.RFC3339),
+	}
+}
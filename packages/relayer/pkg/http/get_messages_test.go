@@ -0,0 +1,162 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_GetMessages(t *testing.T) {
+	srv := newTestServer()
+
+	token := "0x4eC242468812B6fFC8Be8FF423Af7bd23108d991"
+
+	save := func(opts *relayer.SaveEventOpts, fees *relayer.UpdateFeesAndProfitabilityOpts) {
+		opts.Data = "{}"
+		opts.ChainID = big.NewInt(167001)
+
+		e, err := srv.eventRepo.Save(context.Background(), opts)
+		require.Nil(t, err)
+
+		if fees != nil {
+			require.Nil(t, srv.eventRepo.UpdateFeesAndProfitability(context.Background(), e.ID, fees))
+		}
+	}
+
+	save(&relayer.SaveEventOpts{
+		Name:                  relayer.EventNameMessageSent,
+		MsgHash:               "0x1",
+		Status:                relayer.EventStatusRetriable,
+		DestChainID:           big.NewInt(167002),
+		CanonicalTokenAddress: token,
+	}, &relayer.UpdateFeesAndProfitabilityOpts{Fee: 10, IsProfitable: false})
+	save(&relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		MsgHash:     "0x2",
+		Status:      relayer.EventStatusFailed,
+		DestChainID: big.NewInt(167003),
+	}, &relayer.UpdateFeesAndProfitabilityOpts{Fee: 100, IsProfitable: true})
+	save(&relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		MsgHash:     "0x3",
+		Status:      relayer.EventStatusNew,
+		DestChainID: big.NewInt(167002),
+	}, nil)
+	// status changes are not messages.
+	save(&relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageStatusChanged,
+		MsgHash:     "0x1",
+		Status:      relayer.EventStatusRetriable,
+		DestChainID: big.NewInt(167002),
+	}, nil)
+
+	tests := []struct {
+		name           string
+		query          string
+		wantStatus     int
+		wantIDs        []int
+		wantNextCursor int
+	}{
+		{"all", "", http.StatusOK, []int{3, 2, 1}, 0},
+		{"firstPage", "limit=2", http.StatusOK, []int{3, 2}, 2},
+		{"nextPage", "limit=2&cursor=2", http.StatusOK, []int{1}, 0},
+		{"statuses", "status=retriable,failed", http.StatusOK, []int{2, 1}, 0},
+		{"unprofitable", "profitable=false", http.StatusOK, []int{1}, 0},
+		{"feeRange", "minFee=50&maxFee=150", http.StatusOK, []int{2}, 0},
+		{"destChainID", "destChainID=167002", http.StatusOK, []int{3, 1}, 0},
+		{"token", "token=" + strings.ToLower(token), http.StatusOK, []int{1}, 0},
+		{"newerThan", "newerThan=1h", http.StatusOK, []int{3, 2, 1}, 0},
+		{"olderThan", "olderThan=1h", http.StatusOK, []int{}, 0},
+		{"invalidStatus", "status=stuck", http.StatusBadRequest, nil, 0},
+		{"invalidToken", "token=0x1", http.StatusBadRequest, nil, 0},
+		{"invalidAge", "olderThan=1", http.StatusBadRequest, nil, 0},
+		{"invalidLimit", "limit=1001", http.StatusBadRequest, nil, 0},
+		{"invalidFormat", "format=xml", http.StatusBadRequest, nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewAuthenticatedRequestWithJWT(
+				testAdminToken,
+				echo.GET,
+				"/admin/messages?"+tt.query,
+				nil,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			resp := struct {
+				Items []struct {
+					ID int `json:"id"`
+				} `json:"items"`
+				NextCursor int `json:"nextCursor"`
+			}{}
+
+			require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+			ids := make([]int, 0, len(resp.Items))
+			for _, item := range resp.Items {
+				ids = append(ids, item.ID)
+			}
+
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantNextCursor, resp.NextCursor)
+		})
+	}
+}
+
+func Test_GetMessages_csv(t *testing.T) {
+	srv := newTestServer()
+
+	_, err := srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		Data:        "{}",
+		MsgHash:     "0x1",
+		Status:      relayer.EventStatusRetriable,
+		ChainID:     big.NewInt(167001),
+		DestChainID: big.NewInt(167002),
+	})
+	require.Nil(t, err)
+
+	req := testutils.NewAuthenticatedRequestWithJWT(testAdminToken, echo.GET, "/admin/messages?format=csv&limit=1", nil)
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+
+	testutils.AssertStatusAndBody(t, rec, http.StatusOK, []string{
+		`^id,msgHash,status,eventType,chainID,destChainID,messageOwner,canonicalTokenAddress,`,
+		`\n1,0x1,retriable,sendETH,167001,167002,,,,,,,,0,[0-9-]+T[0-9:]+Z\n$`,
+	})
+	assert.Equal(t, "text/csv", rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "1", rec.Header().Get("X-Next-Cursor"))
+}
+
+func Test_GetMessages_unauthorized(t *testing.T) {
+	srv := newTestServer()
+
+	req := testutils.NewAuthenticatedRequestWithJWT("wrong", echo.GET, "/admin/messages", nil)
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -2,9 +2,11 @@ package http
 
 import (
 	"context"
+	"log/slog"
 	"math"
 	"math/big"
 	"net/http"
+	"slices"
 	"strconv"
 
 	"github.com/cyberhorsey/webutils"
@@ -15,7 +17,8 @@ import (
 )
 
 type getRecommendedProcessingFeesResponse struct {
-	Fees []fee `json:"fees"`
+	Fees             []fee             `json:"fees"`
+	BaseFeeForecasts []baseFeeForecast `json:"baseFeeForecasts"`
 }
 
 type fee struct {
@@ -23,6 +26,23 @@ type fee struct {
 	Amount      string `json:"amount"`
 	DestChainID uint64 `json:"destChainID"`
 	GasLimit    string `json:"gasLimit"`
+	// percentiles of the cost of the messages of this type recently processed by the relayer,
+	// empty when there is no history.
+	P50        string `json:"p50,omitempty"`
+	P90        string `json:"p90,omitempty"`
+	SampleSize int    `json:"sampleSize"`
+}
+
+type baseFeeForecast struct {
+	ChainID  uint64 `json:"chainID"`
+	BaseFee  string `json:"baseFee"`
+	Forecast string `json:"forecast"`
+	Blocks   uint64 `json:"blocks"`
+}
+
+type processingCostsKey struct {
+	eventType   relayer.EventType
+	destChainID uint64
 }
 
 type FeeType uint64
@@ -70,6 +90,21 @@ func (f FeeType) String() string {
 	}
 }
 
+// EventType returns the event type whose processing cost history is used for the fee type,
+// deployed and not deployed tokens share the same history.
+func (f FeeType) EventType() relayer.EventType {
+	switch f {
+	case ERC20NotDeployed, ERC20Deployed:
+		return relayer.EventTypeSendERC20
+	case ERC721NotDeployed, ERC721Deployed:
+		return relayer.EventTypeSendERC721
+	case ERC1155NotDeployed, ERC1155Deployed:
+		return relayer.EventTypeSendERC1155
+	default:
+		return relayer.EventTypeSendETH
+	}
+}
+
 type layer int
 
 const (
@@ -79,65 +114,170 @@ const (
 
 // GetRecommendedProcessingFees
 //
-//	 returns block info for the chains
+//	 returns the recommended processing fees for each fee type and destination chain.
+//	 The amount covers the current base fee forecast, and the p90 cost of the messages
+//	 recently processed by the relayer when there is enough history.
 //
-//			@Summary		Get block info
-//			@ID			   	get-block-info
+//			@Summary		Get recommended processing fees
+//			@ID			   	get-recommended-processing-fees
 //			@Accept			json
 //			@Produce		json
-//			@Success		200	{object} getBlockInfoResponse
-//			@Router			/blockInfo [get]
+//			@Success		200	{object} getRecommendedProcessingFeesResponse
+//			@Router			/recommendedProcessingFees [get]
 func (srv *Server) GetRecommendedProcessingFees(c echo.Context) error {
+	ctx := c.Request().Context()
 	fees := make([]fee, 0)
 
 	srcChainID := srv.srcChainID
 	destChainID := srv.destChainID
 
-	srcGasTipCap, err := srv.srcEthClient.SuggestGasTipCap(c.Request().Context())
+	srcGasTipCap, err := srv.srcEthClient.SuggestGasTipCap(ctx)
 	if err != nil {
 		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 	}
 
-	srcBaseFee, err := srv.getDestChainBaseFee(c.Request().Context(), Layer1, srcChainID)
+	srcBaseFee, err := srv.getDestChainBaseFee(ctx, Layer1, srcChainID)
 	if err != nil {
 		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 	}
 
-	destBaseFee, err := srv.getDestChainBaseFee(c.Request().Context(), Layer2, destChainID)
+	destBaseFee, err := srv.getDestChainBaseFee(ctx, Layer2, destChainID)
 	if err != nil {
 		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 	}
 
-	destGasTipCap, err := srv.destEthClient.SuggestGasTipCap(c.Request().Context())
+	destGasTipCap, err := srv.destEthClient.SuggestGasTipCap(ctx)
 	if err != nil {
 		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
 	}
 
-	for _, f := range feeTypes {
-		paddedGasLimit := relayer.PaddedMessageGasLimit(uint64(f), true)
+	srcForecast := srv.forecastBaseFee(ctx, srv.srcEthClient, srcBaseFee)
+	destForecast := srv.forecastBaseFee(ctx, srv.destEthClient, destBaseFee)
 
-		fees = append(fees, fee{
-			Type:        f.String(),
-			Amount:      srv.getCost(paddedGasLimit, destGasTipCap, destBaseFee, Layer2).String(),
-			DestChainID: destChainID.Uint64(),
-			GasLimit:    strconv.Itoa(int(f)),
-		})
+	costs := make(map[processingCostsKey][]relayer.ProcessingCost)
 
-		fees = append(fees, fee{
-			Type:        f.String(),
-			Amount:      srv.getCost(paddedGasLimit, srcGasTipCap, srcBaseFee, Layer1).String(),
-			DestChainID: srcChainID.Uint64(),
-			GasLimit:    strconv.Itoa(int(f)),
-		})
+	for _, f := range feeTypes {
+		fees = append(fees,
+			srv.recommendFee(ctx, costs, f, destGasTipCap, destForecast, destChainID, Layer2),
+			srv.recommendFee(ctx, costs, f, srcGasTipCap, srcForecast, srcChainID, Layer1),
+		)
 	}
 
 	resp := getRecommendedProcessingFeesResponse{
 		Fees: fees,
+		BaseFeeForecasts: []baseFeeForecast{
+			{
+				ChainID:  destChainID.Uint64(),
+				BaseFee:  destBaseFee.String(),
+				Forecast: destForecast.String(),
+				Blocks:   srv.baseFeeForecastBlocks,
+			},
+			{
+				ChainID:  srcChainID.Uint64(),
+				BaseFee:  srcBaseFee.String(),
+				Forecast: srcForecast.String(),
+				Blocks:   srv.baseFeeForecastBlocks,
+			},
+		},
 	}
 
 	return c.JSON(http.StatusOK, resp)
 }
 
+// recommendFee recommends the processing fee of a fee type on a destination chain. The
+// estimate from the fee type gas limit is raised to the p90 historical cost, if higher.
+func (srv *Server) recommendFee(
+	ctx context.Context,
+	costs map[processingCostsKey][]relayer.ProcessingCost,
+	f FeeType,
+	gasTipCap *big.Int,
+	baseFee *big.Int,
+	chainID *big.Int,
+	destLayer layer,
+) fee {
+	paddedGasLimit := relayer.PaddedMessageGasLimit(uint64(f), true)
+	amount := srv.getCost(paddedGasLimit, gasTipCap, baseFee, destLayer)
+
+	recommended := fee{
+		Type:        f.String(),
+		DestChainID: chainID.Uint64(),
+		GasLimit:    strconv.Itoa(int(f)),
+	}
+
+	key := processingCostsKey{eventType: f.EventType(), destChainID: chainID.Uint64()}
+
+	history, ok := costs[key]
+	if !ok {
+		history = srv.processingCosts(ctx, key)
+		costs[key] = history
+	}
+
+	if len(history) > 0 {
+		gasPrice := new(big.Int).Add(gasTipCap, baseFee)
+		p50 := srv.historicalCost(history, 50, gasPrice, destLayer)
+		p90 := srv.historicalCost(history, 90, gasPrice, destLayer)
+
+		recommended.P50 = p50.String()
+		recommended.P90 = p90.String()
+		recommended.SampleSize = len(history)
+
+		if p90.Cmp(amount) > 0 {
+			amount = p90
+		}
+	}
+
+	recommended.Amount = amount.String()
+
+	return recommended
+}
+
+// processingCosts returns the recent processing costs recorded for an event type, the
+// recommendation falls back to the gas limit estimate if they can not be loaded.
+func (srv *Server) processingCosts(ctx context.Context, key processingCostsKey) []relayer.ProcessingCost {
+	if srv.feeHistorySize <= 0 {
+		return nil
+	}
+
+	costs, err := srv.eventRepo.FindProcessingCosts(ctx, relayer.FindProcessingCostsOpts{
+		EventType:   key.eventType,
+		DestChainID: key.destChainID,
+		Limit:       srv.feeHistorySize,
+	})
+	if err != nil {
+		slog.Warn("failed to find processing costs",
+			"eventType", key.eventType.String(),
+			"destChainID", key.destChainID,
+			"error", err,
+		)
+
+		return nil
+	}
+
+	return costs
+}
+
+// historicalCost returns the cost at percentile pct of the recorded messages, each priced
+// at its effective gas price, but never below the current gas price.
+func (srv *Server) historicalCost(
+	costs []relayer.ProcessingCost,
+	pct int,
+	minGasPrice *big.Int,
+	destLayer layer,
+) *big.Int {
+	messageCosts := make([]*big.Int, 0, len(costs))
+
+	for _, c := range costs {
+		gasPrice := new(big.Int).SetUint64(c.EffectiveGasPrice)
+		if gasPrice.Cmp(minGasPrice) < 0 {
+			gasPrice = minGasPrice
+		}
+
+		messageCosts = append(messageCosts, new(big.Int).Mul(new(big.Int).SetUint64(c.GasUsed), gasPrice))
+	}
+
+	return srv.applyProcessingFeeMultiplier(percentile(messageCosts, pct), destLayer)
+}
+
 func (srv *Server) getCost(
 	gasLimit uint64,
 	gasTipCap *big.Int,
@@ -148,6 +288,10 @@ func (srv *Server) getCost(
 		new(big.Int).SetUint64(gasLimit),
 		new(big.Int).Add(gasTipCap, new(big.Int).Mul(baseFee, big.NewInt(2))))
 
+	return srv.applyProcessingFeeMultiplier(cost, destLayer)
+}
+
+func (srv *Server) applyProcessingFeeMultiplier(cost *big.Int, destLayer layer) *big.Int {
 	if destLayer == Layer2 {
 		return cost
 	}
@@ -155,7 +299,11 @@ func (srv *Server) getCost(
 	return mulRatCeil(cost, srv.processingFeeMultiplier)
 }
 
-func (srv *Server) getDestChainBaseFee(ctx context.Context, destLayer layer, chainID *big.Int) (*big.Int, error) {
+func (srv *Server) getDestChainBaseFee(
+	ctx context.Context,
+	destLayer layer,
+	chainID *big.Int,
+) (*big.Int, error) {
 	if destLayer == Layer2 {
 		latestL2Block, err := srv.destEthClient.BlockByNumber(ctx, nil)
 		if err != nil {
@@ -179,6 +327,63 @@ func (srv *Server) getDestChainBaseFee(ctx context.Context, destLayer layer, cha
 	return eip1559.CalcBaseFee(cfg, blk.Header()), nil
 }
 
+// forecastBaseFee projects the base fee over the next baseFeeForecastBlocks blocks at the
+// rate it moved over as many past blocks, falling back to the current base fee if the
+// history can not be fetched.
+func (srv *Server) forecastBaseFee(ctx context.Context, client ethClient, baseFee *big.Int) *big.Int {
+	if srv.baseFeeForecastBlocks == 0 {
+		return new(big.Int).Set(baseFee)
+	}
+
+	history, err := client.FeeHistory(ctx, srv.baseFeeForecastBlocks, nil, nil)
+	if err != nil {
+		slog.Warn("failed to get base fee history", "error", err)
+
+		return new(big.Int).Set(baseFee)
+	}
+
+	return forecastBaseFee(baseFee, history.BaseFee)
+}
+
+// forecastBaseFee scales the base fee by the change from the oldest to the newest base fee
+// of the history. It never forecasts below the current base fee, so the recommendation does
+// not drop while the base fee is falling.
+func forecastBaseFee(baseFee *big.Int, history []*big.Int) *big.Int {
+	forecast := new(big.Int).Set(baseFee)
+
+	if len(history) < 2 {
+		return forecast
+	}
+
+	oldest, newest := history[0], history[len(history)-1]
+	if oldest == nil || newest == nil || oldest.Sign() <= 0 || newest.Cmp(oldest) <= 0 {
+		return forecast
+	}
+
+	// rounded up, like the base fee increases.
+	forecast.Mul(forecast, newest)
+	forecast.Add(forecast, new(big.Int).Sub(oldest, big.NewInt(1)))
+
+	return forecast.Div(forecast, oldest)
+}
+
+// percentile returns the nearest-rank percentile of the values.
+func percentile(values []*big.Int, pct int) *big.Int {
+	if len(values) == 0 {
+		return new(big.Int)
+	}
+
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b *big.Int) int { return a.Cmp(b) })
+
+	rank := (len(sorted)*pct + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[min(rank, len(sorted))-1]
+}
+
 func messageMinGasLimit(dataLength uint64) uint64 {
 	return (((dataLength+31)/32)*32+416)*16 + 800_000
 }
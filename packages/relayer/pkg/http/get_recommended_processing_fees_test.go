@@ -2,10 +2,16 @@ package http
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
@@ -100,6 +106,155 @@ func TestGetDestChainBaseFee_Layer2MissingBaseFeeFails(t *testing.T) {
 	assert.Equal(t, 0, srcClient.blockByNumberCalls)
 }
 
+func TestGetRecommendedProcessingFees_UsesProcessingCostHistory(t *testing.T) {
+	srv := newTestServer()
+	srv.srcEthClient = &mock.EthClient{}
+	srv.destEthClient = &mock.EthClient{}
+	srv.srcChainID = big.NewInt(1)
+	srv.destChainID = mock.MockChainID
+	srv.feeHistorySize = 10
+
+	for _, gasUsed := range []uint64{1_000_000, 2_000_000} {
+		event, err := srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:        relayer.EventNameMessageSent,
+			ChainID:     srv.srcChainID,
+			DestChainID: srv.destChainID,
+			EventType:   relayer.EventTypeSendERC20,
+		})
+		assert.Nil(t, err)
+
+		assert.Nil(t, srv.eventRepo.UpdateProcessingCost(context.Background(), event.ID,
+			&relayer.UpdateProcessingCostOpts{GasUsed: gasUsed, EffectiveGasPrice: 1_000}))
+	}
+
+	req := httptest.NewRequest(echo.GET, "/recommendedProcessingFees", nil)
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	resp := getRecommendedProcessingFeesResponse{}
+	assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	for _, f := range resp.Fees {
+		if f.DestChainID != mock.MockChainID.Uint64() {
+			// no history recorded for the source chain.
+			assert.Equal(t, 0, f.SampleSize)
+			continue
+		}
+
+		switch f.Type {
+		case ERC20Deployed.String(), ERC20NotDeployed.String():
+			assert.Equal(t, 2, f.SampleSize)
+			assert.Equal(t, "1000000000", f.P50)
+			assert.Equal(t, "2000000000", f.P90)
+			assert.Equal(t, "2000000000", f.Amount)
+		default:
+			assert.Equal(t, 0, f.SampleSize)
+			assert.Empty(t, f.P90)
+		}
+	}
+
+	assert.Len(t, resp.BaseFeeForecasts, 2)
+}
+
+func TestFeeTypeEventType(t *testing.T) {
+	assert.Equal(t, relayer.EventTypeSendETH, Eth.EventType())
+	assert.Equal(t, relayer.EventTypeSendERC20, ERC20NotDeployed.EventType())
+	assert.Equal(t, relayer.EventTypeSendERC721, ERC721Deployed.EventType())
+	assert.Equal(t, relayer.EventTypeSendERC1155, ERC1155NotDeployed.EventType())
+}
+
+func TestForecastBaseFee(t *testing.T) {
+	rising := []*big.Int{big.NewInt(800), big.NewInt(900), big.NewInt(1000)}
+	falling := []*big.Int{big.NewInt(1200), big.NewInt(1100), big.NewInt(1000)}
+
+	// the base fee keeps rising at the rate it rose over the history.
+	assert.Equal(t, big.NewInt(1250), forecastBaseFee(big.NewInt(1000), rising))
+	// rounded up, like the base fee increases.
+	assert.Equal(t, big.NewInt(2), forecastBaseFee(big.NewInt(1), []*big.Int{big.NewInt(3), big.NewInt(4)}))
+	// a falling or flat history never lowers the forecast.
+	assert.Equal(t, big.NewInt(1000), forecastBaseFee(big.NewInt(1000), falling))
+	assert.Equal(t, big.NewInt(1000), forecastBaseFee(big.NewInt(1000), nil))
+	assert.Equal(t, big.NewInt(1000), forecastBaseFee(big.NewInt(1000), rising[:1]))
+	assert.Equal(t, big.NewInt(1000), forecastBaseFee(big.NewInt(1000), []*big.Int{big.NewInt(0), big.NewInt(5)}))
+}
+
+func TestServerForecastBaseFee(t *testing.T) {
+	client := &feeHistoryClient{
+		EthClient: &mock.EthClient{},
+		baseFees:  []*big.Int{big.NewInt(100), big.NewInt(150), big.NewInt(200)},
+	}
+
+	srv := &Server{baseFeeForecastBlocks: 2}
+
+	assert.Equal(t, big.NewInt(20), srv.forecastBaseFee(context.Background(), client, big.NewInt(10)))
+	assert.Equal(t, uint64(2), client.blockCount)
+
+	client.err = errors.New("fee history unavailable")
+	assert.Equal(t, big.NewInt(10), srv.forecastBaseFee(context.Background(), client, big.NewInt(10)))
+
+	srv.baseFeeForecastBlocks = 0
+	assert.Equal(t, big.NewInt(10), srv.forecastBaseFee(context.Background(), client, big.NewInt(10)))
+}
+
+func TestHistoricalCost_PerMessagePercentiles(t *testing.T) {
+	srv := &Server{processingFeeMultiplier: 1}
+
+	// the most gas and the highest gas price are not used by the same message, so no
+	// message cost more than 3e9.
+	costs := []relayer.ProcessingCost{
+		{GasUsed: 1_000_000, EffectiveGasPrice: 3_000},
+		{GasUsed: 3_000_000, EffectiveGasPrice: 1_000},
+		{GasUsed: 500_000, EffectiveGasPrice: 1_000},
+	}
+
+	assert.Equal(t, big.NewInt(3_000_000_000), srv.historicalCost(costs, 90, big.NewInt(1), Layer2))
+	assert.Equal(t, big.NewInt(3_000_000_000), srv.historicalCost(costs, 50, big.NewInt(1), Layer2))
+	assert.Equal(t, big.NewInt(500_000_000), srv.historicalCost(costs, 10, big.NewInt(1), Layer2))
+
+	// the messages are priced at least at the current gas price.
+	assert.Equal(t, big.NewInt(6_000_000_000), srv.historicalCost(costs, 90, big.NewInt(2_000), Layer2))
+}
+
+func TestPercentile(t *testing.T) {
+	values := make([]*big.Int, 0)
+	for _, v := range []int64{5, 1, 4, 2, 3, 10, 9, 8, 7, 6} {
+		values = append(values, big.NewInt(v))
+	}
+
+	assert.Equal(t, big.NewInt(0), percentile(nil, 50))
+	assert.Equal(t, big.NewInt(5), percentile(values, 50))
+	assert.Equal(t, big.NewInt(9), percentile(values, 90))
+	assert.Equal(t, big.NewInt(10), percentile(values, 100))
+	assert.Equal(t, big.NewInt(1), percentile(values, 0))
+	// the input is left unsorted.
+	assert.Equal(t, big.NewInt(5), values[0])
+}
+
+type feeHistoryClient struct {
+	*mock.EthClient
+	baseFees   []*big.Int
+	blockCount uint64
+	err        error
+}
+
+func (c *feeHistoryClient) FeeHistory(
+	ctx context.Context,
+	blockCount uint64,
+	lastBlock *big.Int,
+	rewardPercentiles []float64,
+) (*ethereum.FeeHistory, error) {
+	c.blockCount = blockCount
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return &ethereum.FeeHistory{BaseFee: c.baseFees}, nil
+}
+
 type blockByNumberClient struct {
 	*mock.EthClient
 	block              *types.Block
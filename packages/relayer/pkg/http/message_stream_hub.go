@@ -0,0 +1,187 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	errTooManyMessageStreams = errors.New("too many message streams")
+
+	defaultMessageStreamMaxConnections = 1000
+
+	// messageStreamBufferSize is the number of status changes buffered for a stream, a stream
+	// falling further behind is closed.
+	messageStreamBufferSize = 256
+)
+
+// messageStream is a subscription to the status changes of the messages of an owner, or of a
+// single message.
+type messageStream struct {
+	owner   string
+	msgHash string
+	// afterID is the id of the last status change sent to the stream.
+	afterID int
+	events  chan *relayer.Event
+}
+
+func (s *messageStream) matches(e *relayer.Event) bool {
+	return e.ID > s.afterID &&
+		((s.owner != "" && e.MessageOwner == s.owner) || (s.msgHash != "" && e.MsgHash == s.msgHash))
+}
+
+// messageStreamHub polls the status changes of all the messages with a single poller, while
+// it has streams, and fans them out to the streams they match.
+type messageStreamHub struct {
+	eventRepo      relayer.EventRepository
+	interval       time.Duration
+	maxConnections int
+
+	mu      sync.Mutex
+	streams map[*messageStream]struct{}
+	cancel  context.CancelFunc
+}
+
+func newMessageStreamHub(
+	eventRepo relayer.EventRepository,
+	interval time.Duration,
+	maxConnections int,
+) *messageStreamHub {
+	if interval == 0 {
+		interval = defaultMessageStreamInterval
+	}
+
+	if maxConnections == 0 {
+		maxConnections = defaultMessageStreamMaxConnections
+	}
+
+	return &messageStreamHub{
+		eventRepo:      eventRepo,
+		interval:       interval,
+		maxConnections: maxConnections,
+		streams:        make(map[*messageStream]struct{}),
+	}
+}
+
+// subscribe adds a stream of the status changes saved after afterID, starting the poller
+// for the first stream.
+func (h *messageStreamHub) subscribe(owner string, msgHash string, afterID int) (*messageStream, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.streams) >= h.maxConnections {
+		return nil, errTooManyMessageStreams
+	}
+
+	s := &messageStream{
+		owner:   owner,
+		msgHash: msgHash,
+		afterID: afterID,
+		events:  make(chan *relayer.Event, messageStreamBufferSize),
+	}
+
+	h.streams[s] = struct{}{}
+
+	if h.cancel == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		h.cancel = cancel
+
+		go h.poll(ctx, afterID)
+	}
+
+	return s, nil
+}
+
+// unsubscribe removes a stream, stopping the poller after the last one.
+func (h *messageStreamHub) unsubscribe(s *messageStream) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(s)
+}
+
+// remove closes and removes a stream, the caller holds the lock.
+func (h *messageStreamHub) remove(s *messageStream) {
+	if _, ok := h.streams[s]; !ok {
+		return
+	}
+
+	delete(h.streams, s)
+	close(s.events)
+
+	if len(h.streams) == 0 && h.cancel != nil {
+		h.cancel()
+		h.cancel = nil
+	}
+}
+
+func (h *messageStreamHub) poll(ctx context.Context, afterID int) {
+	t := time.NewTicker(h.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			// reads every batch indexed since the last check, not only the first one.
+			for {
+				events, err := h.eventRepo.FindStatusChanges(ctx, relayer.FindStatusChangesOpts{
+					AfterID: afterID,
+					Limit:   messageStreamBatchSize,
+				})
+				if err != nil {
+					if ctx.Err() == nil {
+						slog.Error("error finding message status changes", "error", err)
+					}
+
+					break
+				}
+
+				if len(events) == 0 {
+					break
+				}
+
+				h.fanOut(events)
+
+				afterID = events[len(events)-1].ID
+
+				if len(events) < messageStreamBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// fanOut sends the status changes to the streams they match, closing the streams too slow
+// to keep up.
+func (h *messageStreamHub) fanOut(events []*relayer.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.streams {
+		for _, e := range events {
+			if !s.matches(e) {
+				continue
+			}
+
+			select {
+			case s.events <- e:
+				s.afterID = e.ID
+			default:
+				slog.Warn("closing slow message stream", "owner", s.owner, "msgHash", s.msgHash)
+				h.remove(s)
+			}
+
+			if _, ok := h.streams[s]; !ok {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_messageStreamHub_fanOut(t *testing.T) {
+	repo := mock.NewEventRepository()
+	h := newMessageStreamHub(repo, time.Hour, 0)
+
+	ownerStream, err := h.subscribe("0xowner", "", 0)
+	require.NoError(t, err)
+
+	msgStream, err := h.subscribe("", "0x2", 0)
+	require.NoError(t, err)
+
+	defer h.unsubscribe(ownerStream)
+	defer h.unsubscribe(msgStream)
+
+	events := []*relayer.Event{
+		{ID: 1, MessageOwner: "0xowner", MsgHash: "0x1"},
+		{ID: 2, MessageOwner: "0xother", MsgHash: "0x2"},
+		{ID: 3, MessageOwner: "0xowner", MsgHash: "0x2"},
+	}
+
+	h.fanOut(events)
+	// a second poller delivering the same changes does not duplicate them.
+	h.fanOut(events)
+
+	assert.Equal(t, []int{1, 3}, drain(ownerStream))
+	assert.Equal(t, []int{2, 3}, drain(msgStream))
+}
+
+func Test_messageStreamHub_closesSlowStream(t *testing.T) {
+	h := newMessageStreamHub(mock.NewEventRepository(), time.Hour, 0)
+
+	s, err := h.subscribe("0xowner", "", 0)
+	require.NoError(t, err)
+
+	events := make([]*relayer.Event, 0, messageStreamBufferSize+1)
+	for i := 1; i <= messageStreamBufferSize+1; i++ {
+		events = append(events, &relayer.Event{ID: i, MessageOwner: "0xowner"})
+	}
+
+	h.fanOut(events)
+
+	assert.Len(t, drain(s), messageStreamBufferSize)
+
+	_, ok := <-s.events
+	assert.False(t, ok)
+	assert.Empty(t, h.streams)
+	assert.Nil(t, h.cancel)
+}
+
+func Test_messageStreamHub_singlePoller(t *testing.T) {
+	repo := mock.NewEventRepository()
+	h := newMessageStreamHub(repo, 10*time.Millisecond, 0)
+
+	streams := make([]*messageStream, 0, 10)
+
+	for i := 0; i < 10; i++ {
+		s, err := h.subscribe("0x4eC242468812B6fFC8Be8FF423Af7bd23108d991", "", 0)
+		require.NoError(t, err)
+
+		streams = append(streams, s)
+	}
+
+	_, err := repo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:         relayer.EventNameMessageStatusChanged,
+		Event:        relayer.EventNameMessageStatusChanged,
+		Data:         "{}",
+		ChainID:      big.NewInt(167001),
+		DestChainID:  big.NewInt(167002),
+		MsgHash:      "0x1",
+		MessageOwner: "0x4eC242468812B6fFC8Be8FF423Af7bd23108d991",
+		Status:       relayer.EventStatusDone,
+	})
+	require.NoError(t, err)
+
+	for _, s := range streams {
+		select {
+		case e := <-s.events:
+			assert.Equal(t, "0x1", e.MsgHash)
+		case <-time.After(5 * time.Second):
+			t.Fatal("status change not streamed")
+		}
+	}
+
+	for _, s := range streams {
+		h.unsubscribe(s)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	assert.Nil(t, h.cancel)
+}
+
+func Test_messageStreamHub_maxConnections(t *testing.T) {
+	h := newMessageStreamHub(mock.NewEventRepository(), time.Hour, 1)
+
+	s, err := h.subscribe("0xowner", "", 0)
+	require.NoError(t, err)
+
+	_, err = h.subscribe("0xowner", "", 0)
+	assert.ErrorIs(t, err, errTooManyMessageStreams)
+
+	h.unsubscribe(s)
+
+	s, err = h.subscribe("0xowner", "", 0)
+	require.NoError(t, err)
+
+	h.unsubscribe(s)
+}
+
+// drain returns the ids of the status changes buffered in a stream.
+func drain(s *messageStream) []int {
+	ids := make([]int, 0)
+
+	for {
+		select {
+		case e, ok := <-s.events:
+			if !ok {
+				return ids
+			}
+
+			ids = append(ids, e.ID)
+		default:
+			return ids
+		}
+	}
+}
@@ -1,10 +1,33 @@
 package http
 
+import (
+	"crypto/subtle"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
 func (srv *Server) configureRoutes() {
 	srv.echo.GET("/healthz", srv.Health)
 	srv.echo.GET("/", srv.Health)
 
 	srv.echo.GET("/events", srv.GetEventsByAddress)
+	srv.echo.GET("/messages/stream", srv.StreamMessageStatuses)
+	srv.echo.GET("/messages/:msgHash", srv.GetMessageTimeline)
 	srv.echo.GET("/blockInfo", srv.GetBlockInfo)
 	srv.echo.GET("/recommendedProcessingFees", srv.GetRecommendedProcessingFees)
+
+	// the admin endpoints are only served when an admin token is configured.
+	if srv.adminToken == "" {
+		return
+	}
+
+	admin := srv.echo.Group("/admin", middleware.KeyAuth(func(key string, c echo.Context) (bool, error) {
+		return subtle.ConstantTimeCompare([]byte(key), []byte(srv.adminToken)) == 1, nil
+	}))
+
+	admin.GET("/messages", srv.GetMessages)
+	admin.GET("/webhooks", srv.GetWebhookSubscriptions)
+	admin.POST("/webhooks", srv.CreateWebhookSubscription)
+	admin.DELETE("/webhooks/:id", srv.DeleteWebhookSubscription)
 }
@@ -6,7 +6,9 @@ import (
 	"math/big"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/labstack/echo/v4/middleware"
@@ -20,6 +22,12 @@ type ethClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error)
+	FeeHistory(
+		ctx context.Context,
+		blockCount uint64,
+		lastBlock *big.Int,
+		rewardPercentiles []float64,
+	) (*ethereum.FeeHistory, error)
 	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
 	TransactionSender(ctx context.Context,
 		tx *types.Transaction,
@@ -44,20 +52,35 @@ type ethClient interface {
 type Server struct {
 	echo                    *echo.Echo
 	eventRepo               relayer.EventRepository
+	messageHistoryRepo      relayer.MessageHistoryRepository
 	srcEthClient            ethClient
 	srcChainID              *big.Int
 	destEthClient           ethClient
 	destChainID             *big.Int
 	processingFeeMultiplier float64
+	feeHistorySize          int
+	baseFeeForecastBlocks   uint64
+	webhookRepo             relayer.WebhookRepository
+	adminToken              string
+	messageStreams          *messageStreamHub
 }
 
 type NewServerOpts struct {
 	Echo                    *echo.Echo
 	EventRepo               relayer.EventRepository
+	MessageHistoryRepo      relayer.MessageHistoryRepository
 	CorsOrigins             []string
 	SrcEthClient            ethClient
 	DestEthClient           ethClient
 	ProcessingFeeMultiplier float64
+	FeeHistorySize          int
+	BaseFeeForecastBlocks   uint64
+	WebhookRepo             relayer.WebhookRepository
+	// AdminToken enables the admin endpoints, authenticated by it as a bearer token.
+	AdminToken            string
+	MessageStreamInterval time.Duration
+	// MessageStreamMaxConnections caps the open message streams, the default applies if 0.
+	MessageStreamMaxConnections int
 }
 
 func (opts NewServerOpts) Validate() error {
@@ -69,6 +92,14 @@ func (opts NewServerOpts) Validate() error {
 		return relayer.ErrNoEventRepository
 	}
 
+	if opts.MessageHistoryRepo == nil {
+		return relayer.ErrNoMessageHistoryRepository
+	}
+
+	if opts.AdminToken != "" && opts.WebhookRepo == nil {
+		return relayer.ErrNoWebhookRepository
+	}
+
 	if opts.CorsOrigins == nil {
 		return relayer.ErrNoCORSOrigins
 	}
@@ -102,11 +133,21 @@ func NewServer(opts NewServerOpts) (*Server, error) {
 	srv := &Server{
 		echo:                    opts.Echo,
 		eventRepo:               opts.EventRepo,
+		messageHistoryRepo:      opts.MessageHistoryRepo,
 		srcEthClient:            opts.SrcEthClient,
 		destEthClient:           opts.DestEthClient,
 		processingFeeMultiplier: opts.ProcessingFeeMultiplier,
+		feeHistorySize:          opts.FeeHistorySize,
+		baseFeeForecastBlocks:   opts.BaseFeeForecastBlocks,
+		webhookRepo:             opts.WebhookRepo,
+		adminToken:              opts.AdminToken,
 		srcChainID:              srcChainID,
 		destChainID:             destChainID,
+		messageStreams: newMessageStreamHub(
+			opts.EventRepo,
+			opts.MessageStreamInterval,
+			opts.MessageStreamMaxConnections,
+		),
 	}
 
 	corsOrigins := opts.CorsOrigins
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/joho/godotenv"
 	echo "github.com/labstack/echo/v4"
@@ -14,14 +15,21 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/repo"
 )
 
+const testAdminToken = "admin"
+
 func newTestServer() *Server {
 	_ = godotenv.Load("../.test.env")
 
 	srv := &Server{
-		echo:      echo.New(),
-		eventRepo: mock.NewEventRepository(),
+		echo:               echo.New(),
+		eventRepo:          mock.NewEventRepository(),
+		messageHistoryRepo: mock.NewMessageHistoryRepository(),
+		webhookRepo:        mock.NewWebhookRepository(),
+		adminToken:         testAdminToken,
 	}
 
+	srv.messageStreams = newMessageStreamHub(srv.eventRepo, 10*time.Millisecond, 0)
+
 	srv.configureMiddleware([]string{"*"})
 	srv.configureRoutes()
 
@@ -37,33 +45,60 @@ func Test_NewServer(t *testing.T) {
 		{
 			"success",
 			NewServerOpts{
-				Echo:          echo.New(),
-				EventRepo:     &repo.EventRepository{},
-				CorsOrigins:   make([]string, 0),
-				SrcEthClient:  &mock.EthClient{},
-				DestEthClient: &mock.EthClient{},
+				Echo:               echo.New(),
+				EventRepo:          &repo.EventRepository{},
+				MessageHistoryRepo: &repo.MessageHistoryRepository{},
+				CorsOrigins:        make([]string, 0),
+				SrcEthClient:       &mock.EthClient{},
+				DestEthClient:      &mock.EthClient{},
 			},
 			nil,
 		},
 		{
 			"noSrcEthClient",
+			NewServerOpts{
+				Echo:               echo.New(),
+				EventRepo:          &repo.EventRepository{},
+				MessageHistoryRepo: &repo.MessageHistoryRepository{},
+				CorsOrigins:        make([]string, 0),
+				DestEthClient:      &mock.EthClient{},
+			},
+			relayer.ErrNoEthClient,
+		},
+		{
+			"noDestEthClient",
+			NewServerOpts{
+				Echo:               echo.New(),
+				EventRepo:          &repo.EventRepository{},
+				MessageHistoryRepo: &repo.MessageHistoryRepository{},
+				CorsOrigins:        make([]string, 0),
+				SrcEthClient:       &mock.EthClient{},
+			},
+			relayer.ErrNoEthClient,
+		},
+		{
+			"noMessageHistoryRepo",
 			NewServerOpts{
 				Echo:          echo.New(),
 				EventRepo:     &repo.EventRepository{},
 				CorsOrigins:   make([]string, 0),
+				SrcEthClient:  &mock.EthClient{},
 				DestEthClient: &mock.EthClient{},
 			},
-			relayer.ErrNoEthClient,
+			relayer.ErrNoMessageHistoryRepository,
 		},
 		{
-			"noDestEthClient",
+			"noWebhookRepo",
 			NewServerOpts{
-				Echo:         echo.New(),
-				EventRepo:    &repo.EventRepository{},
-				CorsOrigins:  make([]string, 0),
-				SrcEthClient: &mock.EthClient{},
+				Echo:               echo.New(),
+				EventRepo:          &repo.EventRepository{},
+				MessageHistoryRepo: &repo.MessageHistoryRepository{},
+				CorsOrigins:        make([]string, 0),
+				SrcEthClient:       &mock.EthClient{},
+				DestEthClient:      &mock.EthClient{},
+				AdminToken:         "admin",
 			},
-			relayer.ErrNoEthClient,
+			relayer.ErrNoWebhookRepository,
 		},
 		{
 			"noEventRepo",
@@ -78,10 +113,11 @@ func Test_NewServer(t *testing.T) {
 		{
 			"noHttpFramework",
 			NewServerOpts{
-				EventRepo:     &repo.EventRepository{},
-				CorsOrigins:   make([]string, 0),
-				SrcEthClient:  &mock.EthClient{},
-				DestEthClient: &mock.EthClient{},
+				EventRepo:          &repo.EventRepository{},
+				MessageHistoryRepo: &repo.MessageHistoryRepository{},
+				CorsOrigins:        make([]string, 0),
+				SrcEthClient:       &mock.EthClient{},
+				DestEthClient:      &mock.EthClient{},
 			},
 			ErrNoHTTPFramework,
 		},
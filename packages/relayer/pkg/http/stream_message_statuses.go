@@ -0,0 +1,133 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+var (
+	// the stream only serves public data, so any origin may connect.
+	upgrader = websocket.Upgrader{CheckOrigin: func(r *http.Request) bool { return true }}
+
+	defaultMessageStreamInterval = 2 * time.Second
+
+	// messageStreamBatchSize is the maximum number of status changes read at once.
+	messageStreamBatchSize = 100
+
+	messageStreamWriteTimeout = 10 * time.Second
+)
+
+// StreamMessageStatuses
+//
+//	 streams the status changes of the messages of an address, or of a single message,
+//	 over a WebSocket, starting from the changes indexed after the connection is opened
+//
+//			@Summary		Stream message status changes
+//			@ID			   	stream-message-statuses
+//		    @Param			address	query		string		false	"owner address of the messages"
+//		    @Param			msgHash	query		string		false	"msgHash of a single message"
+//			@Success		101	{object} relayer.MessageStatusNotification
+//			@Router			/messages/stream [get]
+func (srv *Server) StreamMessageStatuses(c echo.Context) error {
+	opts := relayer.FindStatusChangesOpts{}
+
+	if address := c.QueryParam("address"); address != "" {
+		if !common.IsHexAddress(address) {
+			return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid address param"))
+		}
+
+		opts.Owner = common.HexToAddress(address).Hex()
+	}
+
+	if msgHash := c.QueryParam("msgHash"); msgHash != "" {
+		b, err := hexutil.Decode(msgHash)
+		if err != nil || len(b) != common.HashLength {
+			return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid msgHash param"))
+		}
+
+		opts.MsgHash = common.BytesToHash(b).Hex()
+	}
+
+	if opts.Owner == "" && opts.MsgHash == "" {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("address or msgHash param required"))
+	}
+
+	afterID, err := srv.eventRepo.LatestEventID(c.Request().Context())
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	stream, err := srv.messageStreams.subscribe(opts.Owner, opts.MsgHash, afterID)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusServiceUnavailable, err)
+	}
+
+	defer srv.messageStreams.unsubscribe(stream)
+
+	conn, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// the upgrader already replied to the client.
+		slog.Warn("error upgrading message stream", "error", err)
+		return nil
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	// the client does not send messages, reading only detects when it closes the connection.
+	go func() {
+		defer cancel()
+
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-stream.events:
+			if !ok {
+				// the hub closed the stream, falling too far behind.
+				return nil
+			}
+
+			if err := conn.SetWriteDeadline(time.Now().Add(messageStreamWriteTimeout)); err != nil {
+				return nil
+			}
+
+			if err := conn.WriteJSON(newMessageStatusNotification(e)); err != nil {
+				slog.Warn("error writing message status change", "error", err)
+				return nil
+			}
+		}
+	}
+}
+
+func newMessageStatusNotification(e *relayer.Event) *relayer.MessageStatusNotification {
+	return &relayer.MessageStatusNotification{
+		MsgHash:     e.MsgHash,
+		Owner:       e.MessageOwner,
+		Event:       e.Event,
+		Status:      e.Status,
+		StatusName:  e.Status.String(),
+		ChainID:     e.ChainID,
+		DestChainID: e.DestChainID,
+		BlockID:     e.EmittedBlockID,
+	}
+}
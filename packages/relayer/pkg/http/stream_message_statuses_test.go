@@ -0,0 +1,112 @@
+package http
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_StreamMessageStatuses(t *testing.T) {
+	srv := newTestServer()
+
+	owner := "0x4eC242468812B6fFC8Be8FF423Af7bd23108d991"
+
+	save := func(name string, msgHash string, status relayer.EventStatus) {
+		_, err := srv.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+			Name:         name,
+			Event:        name,
+			Data:         "{}",
+			ChainID:      big.NewInt(167001),
+			DestChainID:  big.NewInt(167002),
+			MsgHash:      msgHash,
+			MessageOwner: owner,
+			Status:       status,
+		})
+		require.NoError(t, err)
+	}
+
+	// saved before the stream is opened, so it is not streamed.
+	save(relayer.EventNameMessageSent, "0x1", relayer.EventStatusNew)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial(
+		"ws"+strings.TrimPrefix(ts.URL, "http")+"/messages/stream?address="+strings.ToLower(owner),
+		nil,
+	)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	save(relayer.EventNameMessageStatusChanged, "0x1", relayer.EventStatusDone)
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+
+	n := &relayer.MessageStatusNotification{}
+	require.NoError(t, conn.ReadJSON(n))
+
+	assert.Equal(t, "0x1", n.MsgHash)
+	assert.Equal(t, owner, n.Owner)
+	assert.Equal(t, relayer.EventNameMessageStatusChanged, n.Event)
+	assert.Equal(t, relayer.EventStatusDone, n.Status)
+	assert.Equal(t, "done", n.StatusName)
+	assert.Equal(t, int64(167002), n.DestChainID)
+}
+
+func Test_StreamMessageStatuses_invalidParams(t *testing.T) {
+	srv := newTestServer()
+
+	tests := []struct {
+		name                  string
+		query                 string
+		wantBodyRegexpMatches []string
+	}{
+		{"noParams", "", []string{`address or msgHash param required`}},
+		{"invalidAddress", "?address=0x1", []string{`invalid address param`}},
+		{"invalidMsgHash", "?msgHash=0x1", []string{`invalid msgHash param`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewUnauthenticatedRequest(echo.GET, "/messages/stream"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, http.StatusBadRequest, tt.wantBodyRegexpMatches)
+		})
+	}
+}
+
+func Test_StreamMessageStatuses_maxConnections(t *testing.T) {
+	srv := newTestServer()
+	srv.messageStreams = newMessageStreamHub(srv.eventRepo, 10*time.Millisecond, 1)
+
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	url := "ws" + strings.TrimPrefix(ts.URL, "http") + "/messages/stream?address=0x4eC242468812B6fFC8Be8FF423Af7bd23108d991"
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
@@ -0,0 +1,143 @@
+package http
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/cyberhorsey/webutils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/labstack/echo/v4"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+type createWebhookSubscriptionRequest struct {
+	URL     string `json:"url"`
+	Owner   string `json:"owner"`
+	MsgHash string `json:"msgHash"`
+}
+
+type createWebhookSubscriptionResponse struct {
+	*relayer.WebhookSubscription
+	// Secret is only returned when the subscription is created.
+	Secret string `json:"secret"`
+}
+
+// GetWebhookSubscriptions
+//
+//	 returns the webhook subscriptions, without their secrets
+//
+//			@Summary		Get webhook subscriptions
+//			@ID			   	get-webhook-subscriptions
+//			@Accept			json
+//			@Produce		json
+//			@Success		200	{object} []relayer.WebhookSubscription
+//			@Router			/admin/webhooks [get]
+func (srv *Server) GetWebhookSubscriptions(c echo.Context) error {
+	subscriptions, err := srv.webhookRepo.FindSubscriptions(c.Request().Context())
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusOK, subscriptions)
+}
+
+// CreateWebhookSubscription
+//
+//	 subscribes a URL to the status changes of the messages of an owner, or of a single
+//	 message. The secret signing the notifications is only returned in this response.
+//
+//			@Summary		Create webhook subscription
+//			@ID			   	create-webhook-subscription
+//			@Accept			json
+//			@Produce		json
+//			@Success		201	{object} createWebhookSubscriptionResponse
+//			@Router			/admin/webhooks [post]
+func (srv *Server) CreateWebhookSubscription(c echo.Context) error {
+	req := &createWebhookSubscriptionRequest{}
+	if err := c.Bind(req); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, err)
+	}
+
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid url"))
+	}
+
+	if req.Owner == "" && req.MsgHash == "" {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("owner or msgHash required"))
+	}
+
+	opts := &relayer.SaveWebhookSubscriptionOpts{
+		URL: req.URL,
+	}
+
+	if req.Owner != "" {
+		if !common.IsHexAddress(req.Owner) {
+			return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid owner"))
+		}
+
+		opts.Owner = common.HexToAddress(req.Owner).Hex()
+	}
+
+	if req.MsgHash != "" {
+		b, err := hexutil.Decode(req.MsgHash)
+		if err != nil || len(b) != common.HashLength {
+			return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid msgHash"))
+		}
+
+		opts.MsgHash = common.BytesToHash(b).Hex()
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusInternalServerError, err)
+	}
+
+	opts.Secret = hex.EncodeToString(secret)
+
+	subscription, err := srv.webhookRepo.SaveSubscription(c.Request().Context(), opts)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.JSON(http.StatusCreated, createWebhookSubscriptionResponse{
+		WebhookSubscription: subscription,
+		Secret:              subscription.Secret,
+	})
+}
+
+// DeleteWebhookSubscription
+//
+//	 deletes a webhook subscription, its pending deliveries are not sent
+//
+//			@Summary		Delete webhook subscription
+//			@ID			   	delete-webhook-subscription
+//		    @Param			id	path		int		true	"id of the subscription"
+//			@Success		204
+//			@Router			/admin/webhooks/{id} [delete]
+func (srv *Server) DeleteWebhookSubscription(c echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusBadRequest, errors.New("invalid id param"))
+	}
+
+	subscription, err := srv.webhookRepo.FindSubscription(c.Request().Context(), id)
+	if err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	if subscription == nil {
+		return webutils.LogAndRenderErrors(c, http.StatusNotFound, errors.New("subscription not found"))
+	}
+
+	if err := srv.webhookRepo.DeleteSubscription(c.Request().Context(), id); err != nil {
+		return webutils.LogAndRenderErrors(c, http.StatusUnprocessableEntity, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
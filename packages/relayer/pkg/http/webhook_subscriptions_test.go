@@ -0,0 +1,171 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cyberhorsey/webutils/testutils"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+func Test_CreateWebhookSubscription(t *testing.T) {
+	srv := newTestServer()
+
+	tests := []struct {
+		name                  string
+		token                 string
+		body                  interface{}
+		wantStatus            int
+		wantBodyRegexpMatches []string
+	}{
+		{
+			"successOwner",
+			testAdminToken,
+			map[string]string{
+				"url":   "https://example.com/hook",
+				"owner": "0x4ec242468812b6ffc8be8ff423af7bd23108d991",
+			},
+			http.StatusCreated,
+			[]string{
+				`"url":"https://example.com/hook"`,
+				`"owner":"0x4eC242468812B6fFC8Be8FF423Af7bd23108d991"`,
+				`"secret":"[0-9a-f]{64}"`,
+			},
+		},
+		{
+			"successMsgHash",
+			testAdminToken,
+			map[string]string{
+				"url":     "http://example.com/hook",
+				"msgHash": "0x47CE4D255907937ABA12DFA09D87A0A707FEA7EEAC687924AC0A80FA291C3289",
+			},
+			http.StatusCreated,
+			[]string{`"msgHash":"0x47ce4d255907937aba12dfa09d87a0a707fea7eeac687924ac0a80fa291c3289"`},
+		},
+		{
+			"invalidURL",
+			testAdminToken,
+			map[string]string{
+				"url":   "ftp://example.com",
+				"owner": "0x4ec242468812b6ffc8be8ff423af7bd23108d991",
+			},
+			http.StatusBadRequest,
+			[]string{`invalid url`},
+		},
+		{
+			"noOwnerOrMsgHash",
+			testAdminToken,
+			map[string]string{"url": "https://example.com/hook"},
+			http.StatusBadRequest,
+			[]string{`owner or msgHash required`},
+		},
+		{
+			"invalidOwner",
+			testAdminToken,
+			map[string]string{"url": "https://example.com/hook", "owner": "0x1"},
+			http.StatusBadRequest,
+			[]string{`invalid owner`},
+		},
+		{
+			"unauthorized",
+			"wrong",
+			map[string]string{
+				"url":   "https://example.com/hook",
+				"owner": "0x4ec242468812b6ffc8be8ff423af7bd23108d991",
+			},
+			http.StatusUnauthorized,
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewAuthenticatedRequestWithJWT(
+				tt.token,
+				echo.POST,
+				"/admin/webhooks",
+				tt.body,
+			)
+
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			testutils.AssertStatusAndBody(t, rec, tt.wantStatus, tt.wantBodyRegexpMatches)
+		})
+	}
+}
+
+func Test_GetWebhookSubscriptions(t *testing.T) {
+	srv := newTestServer()
+
+	_, err := srv.webhookRepo.SaveSubscription(context.Background(), &relayer.SaveWebhookSubscriptionOpts{
+		URL:    "https://example.com/hook",
+		Secret: "secret",
+		Owner:  "0x4eC242468812B6fFC8Be8FF423Af7bd23108d991",
+	})
+	assert.Nil(t, err)
+
+	req := testutils.NewAuthenticatedRequestWithJWT(testAdminToken, echo.GET, "/admin/webhooks", nil)
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+
+	testutils.AssertStatusAndBody(t, rec, http.StatusOK, []string{`"url":"https://example.com/hook"`})
+	assert.NotContains(t, rec.Body.String(), "secret")
+}
+
+func Test_DeleteWebhookSubscription(t *testing.T) {
+	srv := newTestServer()
+
+	s, err := srv.webhookRepo.SaveSubscription(context.Background(), &relayer.SaveWebhookSubscriptionOpts{
+		URL:     "https://example.com/hook",
+		Secret:  "secret",
+		MsgHash: "0x47ce4d255907937aba12dfa09d87a0a707fea7eeac687924ac0a80fa291c3289",
+	})
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantStatus int
+	}{
+		{"success", "1", http.StatusNoContent},
+		{"notFound", "1", http.StatusNotFound},
+		{"invalidID", "one", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := testutils.NewAuthenticatedRequestWithJWT(testAdminToken, echo.DELETE, "/admin/webhooks/"+tt.id, nil)
+			rec := httptest.NewRecorder()
+
+			srv.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+
+	deleted, err := srv.webhookRepo.FindSubscription(context.Background(), s.ID)
+	assert.Nil(t, err)
+	assert.Nil(t, deleted)
+}
+
+func Test_AdminRoutes_disabledWithoutToken(t *testing.T) {
+	srv := newTestServer()
+	srv.echo = echo.New()
+	srv.adminToken = ""
+	srv.configureRoutes()
+
+	req := testutils.NewAuthenticatedRequestWithJWT(testAdminToken, echo.GET, "/admin/webhooks", nil)
+	rec := httptest.NewRecorder()
+
+	srv.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -14,6 +14,8 @@ var (
 	SuccessMsgHash = [32]byte{0x1}
 	SuccessId      = big.NewInt(1)
 	FailSignal     = [32]byte{0x2}
+	// RetriableMsgHash is a message hash whose status is Retriable.
+	RetriableMsgHash = [32]byte{0x3}
 )
 
 var dummyAddress = "0x63FaC9201494f0bd17B9892B9fae4d52fe3BD377"
@@ -83,6 +85,10 @@ func (b *Bridge) MessageStatus(opts *bind.CallOpts, msgHash [32]byte) (uint8, er
 		return uint8(relayer.EventStatusFailed), nil
 	}
 
+	if msgHash == RetriableMsgHash {
+		return uint8(relayer.EventStatusRetriable), nil
+	}
+
 	return uint8(relayer.EventStatusDone), nil
 }
 
@@ -94,6 +100,18 @@ func (b *Bridge) ProcessMessage(
 	return ProcessMessageTx, nil
 }
 
+func (b *Bridge) RetryMessage(
+	opts *bind.TransactOpts,
+	_message bridge.IBridgeMessage,
+	_isLastAttempt bool,
+) (*types.Transaction, error) {
+	return ProcessMessageTx, nil
+}
+
+func (b *Bridge) SignalForFailedMessage(opts *bind.CallOpts, _msgHash [32]byte) ([32]byte, error) {
+	return FailSignal, nil
+}
+
 func (b *Bridge) ParseMessageSent(log types.Log) (*bridge.BridgeMessageSent, error) {
 	return &bridge.BridgeMessageSent{}, nil
 }
@@ -79,6 +79,24 @@ func (c *EthClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.
 	return blk, nil
 }
 
+// FeeHistory returns a flat history at the base fee of the mocked blocks.
+func (c *EthClient) FeeHistory(
+	ctx context.Context,
+	blockCount uint64,
+	lastBlock *big.Int,
+	rewardPercentiles []float64,
+) (*ethereum.FeeHistory, error) {
+	baseFees := make([]*big.Int, 0, blockCount+1)
+	for i := uint64(0); i <= blockCount; i++ {
+		baseFees = append(baseFees, big.NewInt(1))
+	}
+
+	return &ethereum.FeeHistory{
+		OldestBlock: new(big.Int).Sub(LatestBlockNumber, new(big.Int).SetUint64(blockCount-1)),
+		BaseFee:     baseFees,
+	}, nil
+}
+
 func (c *EthClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
 	return PendingNonce, nil
 }
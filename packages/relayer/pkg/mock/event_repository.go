@@ -1,12 +1,14 @@
 package mock
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"math/rand"
 	"net/http"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/morkid/paginate"
@@ -16,6 +18,7 @@ import (
 
 type EventRepository struct {
 	events                                          []*relayer.Event
+	lastID                                          int
 	CheckpointSyncedEventByBlockNumberOrGreaterFunc func(
 		ctx context.Context,
 		chainId uint64,
@@ -45,16 +48,23 @@ func (r *EventRepository) SavedCount() int {
 }
 
 func (r *EventRepository) Save(ctx context.Context, opts *relayer.SaveEventOpts) (*relayer.Event, error) {
+	r.lastID++
+
 	event := &relayer.Event{
-		ID:           rand.Int(), // nolint: gosec
-		Data:         datatypes.JSON(opts.Data),
-		Status:       opts.Status,
-		ChainID:      opts.ChainID.Int64(),
-		DestChainID:  opts.DestChainID.Int64(),
-		Name:         opts.Name,
-		MessageOwner: opts.MessageOwner,
-		MsgHash:      opts.MsgHash,
-		EventType:    opts.EventType,
+		ID:                    r.lastID,
+		Data:                  datatypes.JSON(opts.Data),
+		Status:                opts.Status,
+		ChainID:               opts.ChainID.Int64(),
+		DestChainID:           opts.DestChainID.Int64(),
+		Name:                  opts.Name,
+		MessageOwner:          opts.MessageOwner,
+		MsgHash:               opts.MsgHash,
+		EventType:             opts.EventType,
+		Event:                 opts.Event,
+		CanonicalTokenAddress: opts.CanonicalTokenAddress,
+		EmittedBlockID:        opts.EmittedBlockID,
+		EmittedBlockHash:      opts.EmittedBlockHash,
+		CreatedAt:             time.Now().UTC(),
 	}
 
 	r.events = append(r.events, event)
@@ -116,6 +126,9 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 	event.GasLimit = &opts.GasLimit
 	event.IsProfitable = &opts.IsProfitable
 	event.EstimatedOnchainFee = &opts.EstimatedOnchainFee
+	event.DeclaredOnchainFee = &opts.DeclaredOnchainFee
+	event.SimulatedGasUsed = &opts.SimulatedGasUsed
+	event.DataCost = &opts.DataCost
 	currentTime := time.Now().UTC()
 	event.IsProfitableEvaluatedAt = &currentTime
 
@@ -125,6 +138,81 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 	return nil
 }
 
+func (r *EventRepository) UpdateProcessingCost(
+	ctx context.Context,
+	id int, opts *relayer.UpdateProcessingCostOpts,
+) error {
+	for _, e := range r.events {
+		if e.ID != id {
+			continue
+		}
+
+		gasUsed := opts.GasUsed
+		gasPrice := opts.EffectiveGasPrice
+		processedAt := opts.ProcessedAt
+
+		e.ProcessedGasUsed = &gasUsed
+		e.ProcessedGasPrice = &gasPrice
+		e.ProcessedRelayerFee = opts.RelayerFee
+		e.ProcessedAt = &processedAt
+
+		return nil
+	}
+
+	return nil
+}
+
+func (r *EventRepository) FindProcessingCosts(
+	ctx context.Context,
+	opts relayer.FindProcessingCostsOpts,
+) ([]relayer.ProcessingCost, error) {
+	costs := make([]relayer.ProcessingCost, 0)
+
+	// newest first, events are appended as they are saved.
+	for i := len(r.events) - 1; i >= 0; i-- {
+		e := r.events[i]
+		if e.ProcessedAt == nil || e.EventType != opts.EventType || e.DestChainID != int64(opts.DestChainID) {
+			continue
+		}
+
+		if opts.Limit > 0 && len(costs) == opts.Limit {
+			break
+		}
+
+		costs = append(costs, relayer.ProcessingCost{
+			GasUsed:           *e.ProcessedGasUsed,
+			EffectiveGasPrice: *e.ProcessedGasPrice,
+		})
+	}
+
+	return costs, nil
+}
+
+func (r *EventRepository) FindStatusChanges(
+	ctx context.Context,
+	opts relayer.FindStatusChangesOpts,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	for _, e := range r.events {
+		if e.ID <= opts.AfterID ||
+			(e.Name != relayer.EventNameMessageSent && e.Name != relayer.EventNameMessageStatusChanged) {
+			continue
+		}
+
+		if (opts.Owner == "" && opts.MsgHash == "") ||
+			(opts.Owner != "" && e.MessageOwner == opts.Owner) || (opts.MsgHash != "" && e.MsgHash == opts.MsgHash) {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
+
+func (r *EventRepository) LatestEventID(ctx context.Context) (int, error) {
+	return r.lastID, nil
+}
+
 func (r *EventRepository) FindAllByAddress(
 	ctx context.Context,
 	req *http.Request,
@@ -162,6 +250,46 @@ func (r *EventRepository) FindAllByAddress(
 	}, nil
 }
 
+func (r *EventRepository) FindAllMessages(
+	ctx context.Context,
+	opts relayer.FindAllMessagesOpts,
+) ([]*relayer.Event, error) {
+	events := make([]*relayer.Event, 0)
+
+	// newest first, events are appended as they are saved.
+	for i := len(r.events) - 1; i >= 0; i-- {
+		e := r.events[i]
+		if e.Name != relayer.EventNameMessageSent || !matchesMessages(e, opts) {
+			continue
+		}
+
+		if opts.Limit > 0 && len(events) == opts.Limit {
+			break
+		}
+
+		events = append(events, e)
+	}
+
+	return events, nil
+}
+
+func matchesMessages(e *relayer.Event, opts relayer.FindAllMessagesOpts) bool {
+	switch {
+	case len(opts.Statuses) > 0 && !slices.Contains(opts.Statuses, e.Status),
+		opts.IsProfitable != nil && (e.IsProfitable == nil || *e.IsProfitable != *opts.IsProfitable),
+		opts.DestChainID != nil && uint64(e.DestChainID) != *opts.DestChainID,
+		opts.CanonicalTokenAddress != "" && !strings.EqualFold(e.CanonicalTokenAddress, opts.CanonicalTokenAddress),
+		opts.MinFee != nil && (e.Fee == nil || *e.Fee < *opts.MinFee),
+		opts.MaxFee != nil && (e.Fee == nil || *e.Fee > *opts.MaxFee),
+		opts.CreatedAfter != nil && e.CreatedAt.Before(*opts.CreatedAfter),
+		opts.CreatedBefore != nil && e.CreatedAt.After(*opts.CreatedBefore),
+		opts.BeforeID > 0 && e.ID >= opts.BeforeID:
+		return false
+	}
+
+	return true
+}
+
 func (r *EventRepository) FirstByMsgHash(
 	ctx context.Context,
 	msgHash string,
@@ -230,11 +358,6 @@ func (r *EventRepository) LatestCheckpointSyncedEvent(
 	return 5, nil
 }
 
-// DeleteAllAfterBlockID is used when a reorg is detected
-func (r *EventRepository) DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error {
-	return nil
-}
-
 // FindLatestBlockID get latest block id
 func (r *EventRepository) FindLatestBlockID(
 	ctx context.Context,
@@ -248,3 +371,56 @@ func (r *EventRepository) FindLatestBlockID(
 
 	return 0, errors.New("invalid")
 }
+
+func (r *EventRepository) FindEmittedBlocks(
+	ctx context.Context,
+	opts relayer.FindEmittedBlocksOpts,
+) ([]relayer.EmittedBlock, error) {
+	blocks := make([]relayer.EmittedBlock, 0)
+
+	for _, e := range r.events {
+		if !r.matchesEmittedBlocks(e, opts) || e.EmittedBlockID < opts.FromBlockID || e.EmittedBlockHash == "" {
+			continue
+		}
+
+		block := relayer.EmittedBlock{EmittedBlockID: e.EmittedBlockID, EmittedBlockHash: e.EmittedBlockHash}
+		if !slices.Contains(blocks, block) {
+			blocks = append(blocks, block)
+		}
+	}
+
+	slices.SortFunc(blocks, func(a, b relayer.EmittedBlock) int {
+		return cmp.Compare(a.EmittedBlockID, b.EmittedBlockID)
+	})
+
+	return blocks, nil
+}
+
+func (r *EventRepository) DeleteAllByEmittedBlock(
+	ctx context.Context,
+	opts relayer.FindEmittedBlocksOpts,
+	block relayer.EmittedBlock,
+) (int64, error) {
+	events := make([]*relayer.Event, 0, len(r.events))
+
+	for _, e := range r.events {
+		if r.matchesEmittedBlocks(e, opts) &&
+			e.EmittedBlockID == block.EmittedBlockID &&
+			e.EmittedBlockHash == block.EmittedBlockHash {
+			continue
+		}
+
+		events = append(events, e)
+	}
+
+	deleted := int64(len(r.events) - len(events))
+	r.events = events
+
+	return deleted, nil
+}
+
+func (r *EventRepository) matchesEmittedBlocks(e *relayer.Event, opts relayer.FindEmittedBlocksOpts) bool {
+	return uint64(e.ChainID) == opts.ChainID &&
+		uint64(e.DestChainID) == opts.DestChainID &&
+		slices.Contains(opts.Names, e.Name)
+}
@@ -0,0 +1,75 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"gorm.io/datatypes"
+)
+
+type MessageHistoryRepository struct {
+	history []*relayer.MessageHistory
+}
+
+func NewMessageHistoryRepository() *MessageHistoryRepository {
+	return &MessageHistoryRepository{
+		history: make([]*relayer.MessageHistory, 0),
+	}
+}
+
+func (r *MessageHistoryRepository) Save(
+	ctx context.Context,
+	opts *relayer.SaveMessageHistoryOpts,
+) (*relayer.MessageHistory, error) {
+	h := &relayer.MessageHistory{
+		ID:          len(r.history) + 1,
+		MsgHash:     opts.MsgHash,
+		Kind:        opts.Kind,
+		ChainID:     opts.ChainID.Int64(),
+		DestChainID: opts.DestChainID.Int64(),
+		Status:      opts.Status,
+		TxHash:      opts.TxHash,
+		BlockID:     opts.BlockID,
+		Error:       opts.Error,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	if opts.Data != "" {
+		h.Data = datatypes.JSON(opts.Data)
+	}
+
+	r.history = append(r.history, h)
+
+	return h, nil
+}
+
+func (r *MessageHistoryRepository) SaveOnce(
+	ctx context.Context,
+	opts *relayer.SaveMessageHistoryOpts,
+) (*relayer.MessageHistory, error) {
+	for _, h := range r.history {
+		if h.MsgHash == opts.MsgHash && h.Kind == opts.Kind &&
+			((h.Status == nil && opts.Status == nil) ||
+				(h.Status != nil && opts.Status != nil && *h.Status == *opts.Status)) {
+			return nil, nil
+		}
+	}
+
+	return r.Save(ctx, opts)
+}
+
+func (r *MessageHistoryRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.MessageHistory, error) {
+	history := make([]*relayer.MessageHistory, 0)
+
+	for _, h := range r.history {
+		if h.MsgHash == msgHash {
+			history = append(history, h)
+		}
+	}
+
+	return history, nil
+}
@@ -0,0 +1,192 @@
+package mock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"gorm.io/datatypes"
+)
+
+type WebhookRepository struct {
+	mu            sync.Mutex
+	subscriptions []*relayer.WebhookSubscription
+	deliveries    []*relayer.WebhookDelivery
+
+	// SaveDeliveryErrs are the errors returned when saving a delivery, by subscription ID.
+	SaveDeliveryErrs map[int]error
+}
+
+func NewWebhookRepository() *WebhookRepository {
+	return &WebhookRepository{
+		subscriptions: make([]*relayer.WebhookSubscription, 0),
+		deliveries:    make([]*relayer.WebhookDelivery, 0),
+	}
+}
+
+func (r *WebhookRepository) SaveSubscription(
+	ctx context.Context,
+	opts *relayer.SaveWebhookSubscriptionOpts,
+) (*relayer.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := 1
+	if len(r.subscriptions) > 0 {
+		id = r.subscriptions[len(r.subscriptions)-1].ID + 1
+	}
+
+	s := &relayer.WebhookSubscription{
+		ID:        id,
+		URL:       opts.URL,
+		Secret:    opts.Secret,
+		Owner:     opts.Owner,
+		MsgHash:   opts.MsgHash,
+		CreatedAt: time.Now().UTC(),
+	}
+
+	r.subscriptions = append(r.subscriptions, s)
+
+	return s, nil
+}
+
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, s := range r.subscriptions {
+		if s.ID == id {
+			r.subscriptions = append(r.subscriptions[:i], r.subscriptions[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (r *WebhookRepository) FindSubscriptions(ctx context.Context) ([]*relayer.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*relayer.WebhookSubscription{}, r.subscriptions...), nil
+}
+
+func (r *WebhookRepository) FindSubscription(ctx context.Context, id int) (*relayer.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range r.subscriptions {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *WebhookRepository) FindSubscriptionsByMessage(
+	ctx context.Context,
+	owner string,
+	msgHash string,
+) ([]*relayer.WebhookSubscription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	subscriptions := make([]*relayer.WebhookSubscription, 0)
+
+	for _, s := range r.subscriptions {
+		if (s.Owner != "" && s.Owner == owner) || (s.MsgHash != "" && s.MsgHash == msgHash) {
+			subscriptions = append(subscriptions, s)
+		}
+	}
+
+	return subscriptions, nil
+}
+
+func (r *WebhookRepository) SaveDelivery(
+	ctx context.Context,
+	opts *relayer.SaveWebhookDeliveryOpts,
+) (*relayer.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err, ok := r.SaveDeliveryErrs[opts.SubscriptionID]; ok {
+		return nil, err
+	}
+
+	d := &relayer.WebhookDelivery{
+		ID:             len(r.deliveries) + 1,
+		SubscriptionID: opts.SubscriptionID,
+		MsgHash:        opts.MsgHash,
+		Payload:        datatypes.JSON(opts.Payload),
+		Status:         relayer.WebhookDeliveryStatusPending,
+		NextAttemptAt:  time.Now().UTC(),
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	r.deliveries = append(r.deliveries, d)
+
+	return d, nil
+}
+
+func (r *WebhookRepository) ClaimDueDeliveries(
+	ctx context.Context,
+	lease time.Duration,
+	limit int,
+) ([]*relayer.WebhookDelivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	deliveries := make([]*relayer.WebhookDelivery, 0)
+
+	for _, d := range r.deliveries {
+		if len(deliveries) == limit {
+			break
+		}
+
+		if d.Status == relayer.WebhookDeliveryStatusPending && !d.NextAttemptAt.After(now) {
+			claimed := *d
+			d.NextAttemptAt = now.Add(lease)
+
+			deliveries = append(deliveries, &claimed)
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) UpdateDelivery(
+	ctx context.Context,
+	id int,
+	opts *relayer.UpdateWebhookDeliveryOpts,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, d := range r.deliveries {
+		if d.ID == id {
+			d.Status = opts.Status
+			d.Attempts = opts.Attempts
+			d.LastError = opts.LastError
+			d.NextAttemptAt = opts.NextAttemptAt
+			d.DeliveredAt = opts.DeliveredAt
+		}
+	}
+
+	return nil
+}
+
+// Deliveries returns a copy of the saved deliveries.
+func (r *WebhookRepository) Deliveries() []relayer.WebhookDelivery {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deliveries := make([]relayer.WebhookDelivery, 0, len(r.deliveries))
+	for _, d := range r.deliveries {
+		deliveries = append(deliveries, *d)
+	}
+
+	return deliveries
+}
@@ -0,0 +1,455 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// unprofitableSuffix is the suffix of the queue the processor publishes the unprofitable
+// messages to, see the RabbitMQ implementation.
+const unprofitableSuffix = "-unprofitable"
+
+// deadJobsPruneInterval is how often the dead jobs older than the retention are deleted.
+const deadJobsPruneInterval = time.Hour
+
+// Job is a queue message stored in the queue_messages table.
+type Job struct {
+	ID         int64
+	QueueName  string
+	Body       []byte
+	Headers    datatypes.JSON
+	Dead       bool
+	DeliveryID string
+	Deliveries uint64
+	VisibleAt  time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName implements the gorm Tabler interface.
+func (Job) TableName() string {
+	return "queue_messages"
+}
+
+// delivery is the internal value of a queue.Message delivered by a MySQL queue, the
+// delivery ID changes on every delivery of a job, so a stale delivery can not acknowledge
+// a job delivered again after its visibility timeout.
+type delivery struct {
+	jobID      int64
+	deliveryID string
+}
+
+// NewQueueOpts are the options of a MySQL queue.
+type NewQueueOpts struct {
+	PrefetchCount uint64
+	// VisibilityTimeout is how long a delivered job stays invisible to the other consumers,
+	// the timeout is extended while the job is being processed, so it only expires if the
+	// consumer dies before acknowledging the job.
+	VisibilityTimeout time.Duration
+	PollInterval      time.Duration
+	// DeadRetention is how long a dead job is kept for inspection before being deleted,
+	// zero keeps the dead jobs forever.
+	DeadRetention time.Duration
+}
+
+// MySQL is a queue.Queue backed by a durable jobs table in the relayer database, it mirrors
+// the semantics of the RabbitMQ queue without needing a broker:
+//   - a negatively acknowledged job without requeue is marked as dead, like the messages
+//     routed to the dead letter exchange.
+//   - a job published to the unprofitable queue with an expiration is delivered again on
+//     the processing queue once expired, like the dead lettering of the expired messages.
+type MySQL struct {
+	db        db.DB
+	opts      NewQueueOpts
+	queueName string
+
+	// inflight limits the number of delivered but not yet acknowledged jobs.
+	inflight chan struct{}
+
+	leasesMu sync.Mutex
+	leases   map[int64]string
+}
+
+// NewQueue creates a new MySQL queue instance.
+func NewQueue(database db.DB, opts NewQueueOpts) (*MySQL, error) {
+	if database == nil {
+		return nil, db.ErrNoDB
+	}
+
+	if opts.VisibilityTimeout <= 0 {
+		return nil, errors.New("invalid queue visibility timeout")
+	}
+
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second
+	}
+
+	q := &MySQL{
+		db:     database,
+		opts:   opts,
+		leases: make(map[int64]string),
+	}
+
+	if opts.PrefetchCount > 0 {
+		q.inflight = make(chan struct{}, opts.PrefetchCount)
+	}
+
+	relayer.QueueConnectionInstantiated.Inc()
+
+	return q, nil
+}
+
+// Start implements the queue.Queue interface, the jobs table is created by the migrations,
+// so there is nothing to declare.
+func (q *MySQL) Start(ctx context.Context, queueName string) error {
+	q.queueName = queueName
+
+	return nil
+}
+
+// Close implements the queue.Queue interface.
+func (q *MySQL) Close(ctx context.Context) {
+	sqlDB, err := q.db.DB()
+	if err != nil {
+		slog.Info("error closing mysql queue connection", "err", err.Error())
+		return
+	}
+
+	if err := sqlDB.Close(); err != nil {
+		slog.Info("error closing mysql queue connection", "err", err.Error())
+	}
+
+	slog.Info("closed mysql queue connection")
+}
+
+// Publish implements the queue.Queue interface.
+func (q *MySQL) Publish(
+	ctx context.Context,
+	queueName string,
+	msg []byte,
+	headers map[string]interface{},
+	expiration *string,
+) error {
+	target, visibleAt, err := publishTarget(queueName, expiration, time.Now().UTC())
+	if err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+		return err
+	}
+
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+		return err
+	}
+
+	slog.Info("publishing mysql msg to queue", "queue", target)
+
+	if err := q.db.GormDB().WithContext(ctx).Create(&Job{
+		QueueName: target,
+		Body:      msg,
+		Headers:   datatypes.JSON(encodedHeaders),
+		VisibleAt: visibleAt,
+	}).Error; err != nil {
+		relayer.QueueMessagePublishedErrors.Inc()
+		return err
+	}
+
+	relayer.QueueMessagePublished.Inc()
+
+	return nil
+}
+
+// publishTarget returns the queue a message should be stored in and the time it becomes
+// visible, the expiration is only applied to the unprofitable queues, whose messages
+// are delivered again on the processing queue once expired. As no consumer reads the
+// unprofitable queues, a message published to one without an expiration is refused.
+func publishTarget(queueName string, expiration *string, now time.Time) (string, time.Time, error) {
+	if !strings.HasSuffix(queueName, unprofitableSuffix) {
+		return queueName, now, nil
+	}
+
+	if expiration == nil {
+		return "", time.Time{}, fmt.Errorf("message published to %s without an expiration", queueName)
+	}
+
+	// same format as the RabbitMQ per-message TTL, in milliseconds.
+	ms, err := strconv.ParseUint(*expiration, 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid message expiration %q: %w", *expiration, err)
+	}
+
+	return strings.TrimSuffix(queueName, unprofitableSuffix), now.Add(time.Duration(ms) * time.Millisecond), nil
+}
+
+// Ack implements the queue.Queue interface.
+func (q *MySQL) Ack(ctx context.Context, msg queue.Message) error {
+	d := msg.Internal.(*delivery)
+	defer q.release(d)
+
+	res := q.db.GormDB().WithContext(ctx).
+		Where("id = ? AND delivery_id = ?", d.jobID, d.deliveryID).
+		Delete(&Job{})
+	if res.Error != nil {
+		slog.Error("error acknowledging mysql message", "err", res.Error.Error())
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		slog.Warn("acknowledged mysql message was delivered again", "jobId", d.jobID)
+	}
+
+	slog.Info("acknowledged mysql message", "jobId", d.jobID)
+
+	relayer.QueueMessageAcknowledged.Inc()
+
+	return nil
+}
+
+// Nack implements the queue.Queue interface.
+func (q *MySQL) Nack(ctx context.Context, msg queue.Message, requeue bool) error {
+	d := msg.Internal.(*delivery)
+	defer q.release(d)
+
+	updates := map[string]interface{}{"delivery_id": ""}
+	if requeue {
+		updates["visible_at"] = time.Now().UTC()
+	} else {
+		updates["dead"] = true
+	}
+
+	if err := q.db.GormDB().WithContext(ctx).
+		Model(&Job{}).
+		Where("id = ? AND delivery_id = ?", d.jobID, d.deliveryID).
+		Updates(updates).Error; err != nil {
+		slog.Error("error negatively acknowledging mysql message", "err", err.Error())
+		return err
+	}
+
+	slog.Info("negatively acknowledged mysql message", "jobId", d.jobID, "requeue", requeue)
+
+	relayer.QueueMessageNegativelyAcknowledged.Inc()
+
+	return nil
+}
+
+// Notify implements the queue.Queue interface, there is no connection to be notified of.
+func (q *MySQL) Notify(ctx context.Context, wg *sync.WaitGroup) error {
+	wg.Add(1)
+	defer wg.Done()
+
+	<-ctx.Done()
+
+	return nil
+}
+
+// Subscribe implements the queue.Queue interface, it polls the visible jobs of the queue
+// and extends the visibility timeout of the delivered jobs until they are acknowledged.
+func (q *MySQL) Subscribe(ctx context.Context, msgChan chan<- queue.Message, wg *sync.WaitGroup) error {
+	wg.Add(1)
+	defer wg.Done()
+
+	slog.Info("subscribing to mysql messages", "queue", q.queueName)
+
+	go q.renewLeases(ctx)
+
+	if q.opts.DeadRetention > 0 {
+		go q.pruneDeadJobs(ctx)
+	}
+
+	ticker := time.NewTicker(q.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if !q.acquire(ctx) {
+			return nil
+		}
+
+		job, err := q.claim(ctx)
+		if err != nil {
+			q.releaseSlot()
+
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			slog.Error("error claiming mysql message", "err", err.Error())
+
+			return err
+		}
+
+		if job == nil {
+			q.releaseSlot()
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				continue
+			}
+		}
+
+		slog.Info("mysql message found", "jobId", job.ID, "deliveries", job.Deliveries)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case msgChan <- queue.Message{
+			Body:     job.Body,
+			Internal: &delivery{jobID: job.ID, deliveryID: job.DeliveryID},
+		}:
+		}
+	}
+}
+
+// claim takes the oldest visible job of the queue, and hides it for the visibility timeout.
+func (q *MySQL) claim(ctx context.Context) (*Job, error) {
+	var job *Job
+
+	err := q.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var jobs []*Job
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("queue_name = ? AND dead = ? AND visible_at <= ?", q.queueName, false, time.Now().UTC()).
+			Order("id").
+			Limit(1).
+			Find(&jobs).Error; err != nil {
+			return err
+		}
+
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		job = jobs[0]
+		job.DeliveryID = uuid.New().String()
+		job.Deliveries++
+
+		return tx.Model(&Job{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+			"delivery_id": job.DeliveryID,
+			"deliveries":  job.Deliveries,
+			"visible_at":  time.Now().UTC().Add(q.opts.VisibilityTimeout),
+		}).Error
+	})
+	if err != nil || job == nil {
+		return nil, err
+	}
+
+	q.leasesMu.Lock()
+	q.leases[job.ID] = job.DeliveryID
+	q.leasesMu.Unlock()
+
+	return job, nil
+}
+
+// renewLeases extends the visibility timeout of the delivered jobs which are not
+// acknowledged yet.
+func (q *MySQL) renewLeases(ctx context.Context) {
+	ticker := time.NewTicker(q.opts.VisibilityTimeout / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.leasesMu.Lock()
+			leases := make(map[int64]string, len(q.leases))
+			for id, deliveryID := range q.leases {
+				leases[id] = deliveryID
+			}
+			q.leasesMu.Unlock()
+
+			for id, deliveryID := range leases {
+				if err := q.db.GormDB().WithContext(ctx).
+					Model(&Job{}).
+					Where("id = ? AND delivery_id = ?", id, deliveryID).
+					Update("visible_at", time.Now().UTC().Add(q.opts.VisibilityTimeout)).Error; err != nil {
+					slog.Warn("error extending mysql message visibility", "jobId", id, "err", err.Error())
+				}
+			}
+		}
+	}
+}
+
+// pruneDeadJobs periodically deletes the dead jobs of the queue older than the retention.
+func (q *MySQL) pruneDeadJobs(ctx context.Context) {
+	ticker := time.NewTicker(deadJobsPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := q.deleteDeadJobs(ctx, time.Now().UTC().Add(-q.opts.DeadRetention))
+			if err != nil {
+				slog.Warn("error deleting dead mysql messages", "err", err.Error())
+				continue
+			}
+
+			slog.Info("deleted dead mysql messages", "queue", q.queueName, "count", deleted)
+		}
+	}
+}
+
+// deleteDeadJobs deletes the jobs of the queue which died before the given time.
+func (q *MySQL) deleteDeadJobs(ctx context.Context, before time.Time) (int64, error) {
+	res := q.db.GormDB().WithContext(ctx).
+		Where("queue_name = ? AND dead = ? AND updated_at < ?", q.queueName, true, before).
+		Delete(&Job{})
+
+	return res.RowsAffected, res.Error
+}
+
+// acquire takes an in-flight slot, it returns false if the context is done first.
+func (q *MySQL) acquire(ctx context.Context) bool {
+	if q.inflight == nil {
+		return ctx.Err() == nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return false
+	case q.inflight <- struct{}{}:
+		return true
+	}
+}
+
+// release drops the lease of the given delivery and frees its in-flight slot.
+func (q *MySQL) release(d *delivery) {
+	q.leasesMu.Lock()
+	if q.leases[d.jobID] == d.deliveryID {
+		delete(q.leases, d.jobID)
+	}
+	q.leasesMu.Unlock()
+
+	q.releaseSlot()
+}
+
+// releaseSlot frees an in-flight slot.
+func (q *MySQL) releaseSlot() {
+	if q.inflight == nil {
+		return
+	}
+
+	select {
+	case <-q.inflight:
+	default:
+	}
+}
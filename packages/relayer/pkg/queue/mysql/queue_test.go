@@ -0,0 +1,283 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var testQueueName = "1-2-MessageSent-queue"
+
+// newTestQueue returns a queue on the given database, with the given messages published.
+func newTestQueue(t *testing.T, database db.DB, visibilityTimeout time.Duration, msgs ...string) *MySQL {
+	q, err := NewQueue(database, NewQueueOpts{VisibilityTimeout: visibilityTimeout})
+	assert.Nil(t, err)
+	assert.Nil(t, q.Start(context.Background(), testQueueName))
+
+	for _, msg := range msgs {
+		assert.Nil(t, q.Publish(context.Background(), testQueueName, []byte(msg), nil, nil))
+	}
+
+	return q
+}
+
+// message returns the queue message the subscription delivers for a claimed job.
+func message(job *Job) queue.Message {
+	return queue.Message{Body: job.Body, Internal: &delivery{jobID: job.ID, deliveryID: job.DeliveryID}}
+}
+
+func countJobs(t *testing.T, database db.DB, query string, args ...interface{}) int64 {
+	var count int64
+
+	assert.Nil(t, database.GormDB().Model(&Job{}).Where(query, args...).Count(&count).Error)
+
+	return count
+}
+
+func TestPublishTarget(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0).UTC()
+	expiration := "1500"
+	invalidExpiration := "soon"
+
+	tests := []struct {
+		name          string
+		queueName     string
+		expiration    *string
+		wantQueue     string
+		wantVisibleAt time.Time
+		wantErr       bool
+	}{
+		{
+			"processingQueue",
+			"1-2-MessageSent-queue",
+			nil,
+			"1-2-MessageSent-queue",
+			now,
+			false,
+		},
+		{
+			"processingQueueIgnoresExpiration",
+			"1-2-MessageSent-queue",
+			&expiration,
+			"1-2-MessageSent-queue",
+			now,
+			false,
+		},
+		{
+			"unprofitableQueueWithoutExpiration",
+			"1-2-MessageSent-queue-unprofitable",
+			nil,
+			"",
+			time.Time{},
+			true,
+		},
+		{
+			"unprofitableQueueExpiresToProcessingQueue",
+			"1-2-MessageSent-queue-unprofitable",
+			&expiration,
+			"1-2-MessageSent-queue",
+			now.Add(1500 * time.Millisecond),
+			false,
+		},
+		{
+			"invalidExpiration",
+			"1-2-MessageSent-queue-unprofitable",
+			&invalidExpiration,
+			"",
+			time.Time{},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queueName, visibleAt, err := publishTarget(tt.queueName, tt.expiration, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantQueue, queueName)
+			assert.Equal(t, tt.wantVisibleAt, visibleAt)
+		})
+	}
+}
+
+func TestIntegration_Claim_SkipsLockedJobs(t *testing.T) {
+	database, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q := newTestQueue(t, database, time.Minute, "first", "second")
+
+	// another consumer's claim transaction holds the row lock on the oldest job.
+	tx := database.GormDB().Begin()
+	defer tx.Rollback()
+
+	var locked []*Job
+
+	assert.Nil(t, tx.Clauses(clause.Locking{Strength: "UPDATE"}).Order("id").Limit(1).Find(&locked).Error)
+	assert.Len(t, locked, 1)
+
+	job, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, job)
+	assert.Equal(t, "second", string(job.Body))
+	assert.Equal(t, uint64(1), job.Deliveries)
+	assert.NotEmpty(t, job.DeliveryID)
+
+	// the claimed job is hidden, and the locked one is still skipped.
+	job, err = q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, job)
+}
+
+func TestIntegration_Ack(t *testing.T) {
+	database, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q := newTestQueue(t, database, time.Minute, "msg")
+
+	job, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, job)
+
+	assert.Nil(t, q.Ack(context.Background(), message(job)))
+	assert.Equal(t, int64(0), countJobs(t, database, "id = ?", job.ID))
+
+	q.leasesMu.Lock()
+	assert.Empty(t, q.leases)
+	q.leasesMu.Unlock()
+}
+
+func TestIntegration_Nack(t *testing.T) {
+	database, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q := newTestQueue(t, database, time.Minute, "msg")
+
+	job, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, job)
+
+	// a requeued job is visible again right away.
+	assert.Nil(t, q.Nack(context.Background(), message(job), true))
+
+	requeued, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, requeued)
+	assert.Equal(t, job.ID, requeued.ID)
+	assert.Equal(t, uint64(2), requeued.Deliveries)
+	assert.NotEqual(t, job.DeliveryID, requeued.DeliveryID)
+
+	// a job negatively acknowledged without requeue is dead, and never delivered again.
+	assert.Nil(t, q.Nack(context.Background(), message(requeued), false))
+	assert.Equal(t, int64(1), countJobs(t, database, "id = ? AND dead = ?", job.ID, true))
+
+	dead, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, dead)
+}
+
+func TestIntegration_LeaseRenewal(t *testing.T) {
+	database, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q := newTestQueue(t, database, 3*time.Second, "msg")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go q.renewLeases(ctx)
+
+	job, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, job)
+
+	// the lease outlives the visibility timeout while the job is being processed.
+	time.Sleep(5 * time.Second)
+
+	again, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, again)
+
+	assert.Nil(t, q.Ack(context.Background(), message(job)))
+	assert.Equal(t, int64(0), countJobs(t, database, "id = ?", job.ID))
+}
+
+func TestIntegration_LeaseExpiry(t *testing.T) {
+	database, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q := newTestQueue(t, database, time.Second, "msg")
+
+	// without the leases being renewed, as when the consumer died, the job is delivered again
+	// once the visibility timeout expires.
+	job, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, job)
+
+	time.Sleep(2 * time.Second)
+
+	redelivered, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, redelivered)
+	assert.Equal(t, job.ID, redelivered.ID)
+	assert.Equal(t, uint64(2), redelivered.Deliveries)
+
+	// the stale delivery can't acknowledge the job delivered again.
+	assert.Nil(t, q.Ack(context.Background(), message(job)))
+	assert.Equal(t, int64(1), countJobs(t, database, "id = ?", job.ID))
+
+	assert.Nil(t, q.Ack(context.Background(), message(redelivered)))
+	assert.Equal(t, int64(0), countJobs(t, database, "id = ?", job.ID))
+}
+
+func TestIntegration_DeleteDeadJobs(t *testing.T) {
+	database, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	q := newTestQueue(t, database, time.Minute, "dead", "alive")
+
+	job, err := q.claim(context.Background())
+	assert.Nil(t, err)
+	assert.NotNil(t, job)
+	assert.Nil(t, q.Nack(context.Background(), message(job), false))
+
+	// dead jobs are kept until the retention passes.
+	deleted, err := q.deleteDeadJobs(context.Background(), time.Now().UTC().Add(-time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), deleted)
+
+	deleted, err = q.deleteDeadJobs(context.Background(), time.Now().UTC().Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	assert.Equal(t, int64(0), countJobs(t, database, "dead = ?", true))
+	assert.Equal(t, int64(1), countJobs(t, database, "queue_name = ?", testQueueName))
+
+	// the dead jobs of the other queues are left alone.
+	assert.Nil(t, database.GormDB().Create(&Job{QueueName: "other", Body: []byte("x"), Dead: true, VisibleAt: time.Now()}).Error)
+
+	deleted, err = q.deleteDeadJobs(context.Background(), time.Now().UTC().Add(time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), deleted)
+}
@@ -12,6 +12,12 @@ var (
 	ErrClosed = errors.New("queue connection closed")
 )
 
+// Supported queue drivers.
+const (
+	DriverRabbitMQ = "rabbitmq"
+	DriverMySQL    = "mysql"
+)
+
 type Queue interface {
 	Start(ctx context.Context, queueName string) error
 	Close(ctx context.Context)
@@ -32,6 +38,9 @@ type QueueMessageSentBody struct {
 	Event        *bridge.BridgeMessageSent
 	ID           int
 	TimesRetried uint64
+	// RetryMessageAttempts is the number of times the processor tried to retry the message
+	// once it became Retriable.
+	RetryMessageAttempts uint64
 }
 
 type Message struct {
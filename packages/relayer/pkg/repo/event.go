@@ -60,6 +60,7 @@ func (r *EventRepository) Save(ctx context.Context, opts *relayer.SaveEventOpts)
 		SyncedInBlockID:        opts.SyncedInBlockID,
 		BlockID:                opts.BlockID,
 		EmittedBlockID:         opts.EmittedBlockID,
+		EmittedBlockHash:       opts.EmittedBlockHash,
 	}
 
 	if err := r.db.GormDB().WithContext(ctx).Create(e).Error; err != nil {
@@ -96,6 +97,9 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 		"is_profitable":              opts.IsProfitable,
 		"estimated_onchain_fee":      opts.EstimatedOnchainFee,
 		"is_profitable_evaluated_at": opts.IsProfitableEvaluatedAt,
+		"declared_onchain_fee":       opts.DeclaredOnchainFee,
+		"simulated_gas_used":         opts.SimulatedGasUsed,
+		"data_cost":                  opts.DataCost,
 	}).Error
 
 	if err != nil {
@@ -105,6 +109,102 @@ func (r *EventRepository) UpdateFeesAndProfitability(
 	return nil
 }
 
+// UpdateProcessingCost records the cost of the processMessage transaction sent for an event.
+func (r *EventRepository) UpdateProcessingCost(
+	ctx context.Context,
+	id int,
+	opts *relayer.UpdateProcessingCostOpts,
+) error {
+	tx := r.db.GormDB().WithContext(ctx)
+	tx = tx.Model(&relayer.Event{})
+	tx = tx.Where("id = ?", id)
+
+	// check if existed.
+	var count int64
+	if err := tx.Count(&count).Error; err != nil {
+		return errors.Wrap(err, "r.db.Count")
+	}
+
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	err := tx.Updates(map[string]interface{}{
+		"processed_gas_used":    opts.GasUsed,
+		"processed_gas_price":   opts.EffectiveGasPrice,
+		"processed_relayer_fee": opts.RelayerFee,
+		"processed_at":          opts.ProcessedAt,
+	}).Error
+
+	if err != nil {
+		return errors.Wrap(err, "tx.Updates")
+	}
+
+	return nil
+}
+
+// FindProcessingCosts returns the most recent recorded processing costs of an event type
+// on a destination chain, newest first.
+func (r *EventRepository) FindProcessingCosts(
+	ctx context.Context,
+	opts relayer.FindProcessingCostsOpts,
+) ([]relayer.ProcessingCost, error) {
+	var costs []relayer.ProcessingCost
+
+	if err := r.db.GormDB().WithContext(ctx).Table("events").
+		Select("processed_gas_used AS gas_used, processed_gas_price AS effective_gas_price").
+		Where("event_type = ?", opts.EventType).
+		Where("dest_chain_id = ?", opts.DestChainID).
+		Where("processed_at IS NOT NULL").
+		Order("processed_at DESC").
+		Limit(opts.Limit).
+		Scan(&costs).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return costs, nil
+}
+
+// FindStatusChanges returns the status changes of the messages matching the owner or the
+// msgHash, or of all the messages without either, oldest first.
+func (r *EventRepository) FindStatusChanges(
+	ctx context.Context,
+	opts relayer.FindStatusChangesOpts,
+) ([]*relayer.Event, error) {
+	var events []*relayer.Event
+
+	q := r.db.GormDB().WithContext(ctx).
+		Where("id > ?", opts.AfterID).
+		Where("name IN ?", []string{relayer.EventNameMessageSent, relayer.EventNameMessageStatusChanged})
+
+	switch {
+	case opts.Owner != "" && opts.MsgHash != "":
+		q = q.Where("message_owner = ? OR msg_hash = ?", opts.Owner, opts.MsgHash)
+	case opts.Owner != "":
+		q = q.Where("message_owner = ?", opts.Owner)
+	case opts.MsgHash != "":
+		q = q.Where("msg_hash = ?", opts.MsgHash)
+	}
+
+	if err := q.Order("id ASC").Limit(opts.Limit).Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
+// LatestEventID returns the id of the most recently saved event, 0 if there are none.
+func (r *EventRepository) LatestEventID(ctx context.Context) (int, error) {
+	var id int
+
+	if err := r.db.GormDB().WithContext(ctx).Table("events").
+		Select("COALESCE(MAX(id), 0)").Scan(&id).Error; err != nil {
+		return 0, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return id, nil
+}
+
 func (r *EventRepository) UpdateStatus(ctx context.Context, id int, status relayer.EventStatus) error {
 	tx := r.db.GormDB().WithContext(ctx)
 	tx = tx.Model(&relayer.Event{})
@@ -208,6 +308,59 @@ func (r *EventRepository) FindAllByAddress(
 	return &page, nil
 }
 
+// FindAllMessages returns a page of the messages matching the filters, newest first.
+func (r *EventRepository) FindAllMessages(
+	ctx context.Context,
+	opts relayer.FindAllMessagesOpts,
+) ([]*relayer.Event, error) {
+	var events []*relayer.Event
+
+	q := r.db.GormDB().WithContext(ctx).
+		Where("name = ?", relayer.EventNameMessageSent)
+
+	if len(opts.Statuses) > 0 {
+		q = q.Where("status IN ?", opts.Statuses)
+	}
+
+	if opts.IsProfitable != nil {
+		q = q.Where("is_profitable = ?", *opts.IsProfitable)
+	}
+
+	if opts.DestChainID != nil {
+		q = q.Where("dest_chain_id = ?", *opts.DestChainID)
+	}
+
+	if opts.CanonicalTokenAddress != "" {
+		q = q.Where("canonical_token_address = ?", opts.CanonicalTokenAddress)
+	}
+
+	if opts.MinFee != nil {
+		q = q.Where("fee >= ?", *opts.MinFee)
+	}
+
+	if opts.MaxFee != nil {
+		q = q.Where("fee <= ?", *opts.MaxFee)
+	}
+
+	if opts.CreatedAfter != nil {
+		q = q.Where("created_at >= ?", *opts.CreatedAfter)
+	}
+
+	if opts.CreatedBefore != nil {
+		q = q.Where("created_at <= ?", *opts.CreatedBefore)
+	}
+
+	if opts.BeforeID > 0 {
+		q = q.Where("id < ?", opts.BeforeID)
+	}
+
+	if err := q.Order("id DESC").Limit(opts.Limit).Find(&events).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return events, nil
+}
+
 func (r *EventRepository) Delete(
 	ctx context.Context,
 	id int,
@@ -263,15 +416,6 @@ func (r *EventRepository) LatestCheckpointSyncedEvent(
 	return uint64(blockID), nil
 }
 
-// DeleteAllAfterBlockID is used when a reorg is detected
-func (r *EventRepository) DeleteAllAfterBlockID(blockID uint64, srcChainID uint64, destChainID uint64) error {
-	query := `
-DELETE FROM events
-WHERE block_id >= ? AND chain_id = ? AND dest_chain_id = ?`
-
-	return r.db.GormDB().Table("events").Exec(query, blockID, srcChainID, destChainID).Error
-}
-
 // FindLatestBlockID get latest block id
 func (r *EventRepository) FindLatestBlockID(
 	ctx context.Context,
@@ -291,3 +435,44 @@ func (r *EventRepository) FindLatestBlockID(
 
 	return b, nil
 }
+
+// FindEmittedBlocks returns the distinct blocks the events were emitted in, from a block on,
+// lowest first. Events saved without their block hash are left out.
+func (r *EventRepository) FindEmittedBlocks(
+	ctx context.Context,
+	opts relayer.FindEmittedBlocksOpts,
+) ([]relayer.EmittedBlock, error) {
+	var blocks []relayer.EmittedBlock
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.Event{}).
+		Distinct("emitted_block_id", "emitted_block_hash").
+		Where("chain_id = ? AND dest_chain_id = ?", opts.ChainID, opts.DestChainID).
+		Where("name IN ?", opts.Names).
+		Where("emitted_block_id >= ? AND emitted_block_hash != ''", opts.FromBlockID).
+		Order("emitted_block_id ASC").
+		Scan(&blocks).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Scan")
+	}
+
+	return blocks, nil
+}
+
+// DeleteAllByEmittedBlock is used when a reorg is detected, it deletes the events emitted in
+// the reorged out block, and returns the number of events deleted.
+func (r *EventRepository) DeleteAllByEmittedBlock(
+	ctx context.Context,
+	opts relayer.FindEmittedBlocksOpts,
+	block relayer.EmittedBlock,
+) (int64, error) {
+	tx := r.db.GormDB().WithContext(ctx).
+		Where("chain_id = ? AND dest_chain_id = ?", opts.ChainID, opts.DestChainID).
+		Where("name IN ?", opts.Names).
+		Where("emitted_block_id = ? AND emitted_block_hash = ?", block.EmittedBlockID, block.EmittedBlockHash).
+		Delete(&relayer.Event{})
+	if tx.Error != nil {
+		return 0, errors.Wrap(tx.Error, "r.db.Delete")
+	}
+
+	return tx.RowsAffected, nil
+}
@@ -0,0 +1,120 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type MessageHistoryRepository struct {
+	db db.DB
+}
+
+func NewMessageHistoryRepository(dbHandler db.DB) (*MessageHistoryRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &MessageHistoryRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *MessageHistoryRepository) Save(
+	ctx context.Context,
+	opts *relayer.SaveMessageHistoryOpts,
+) (*relayer.MessageHistory, error) {
+	h := newMessageHistory(opts)
+
+	if err := r.db.GormDB().WithContext(ctx).Create(h).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Create")
+	}
+
+	return h, nil
+}
+
+// SaveOnce saves the entry unless the timeline of the message already has an entry of the
+// same kind and status, as an event can be seen by the indexer and the processor, or seen
+// again when crawling past blocks. It returns nil when the entry already exists.
+func (r *MessageHistoryRepository) SaveOnce(
+	ctx context.Context,
+	opts *relayer.SaveMessageHistoryOpts,
+) (*relayer.MessageHistory, error) {
+	h := newMessageHistory(opts)
+
+	err := r.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("msg_hash = ?", opts.MsgHash).
+			Where("kind = ?", opts.Kind)
+
+		if opts.Status != nil {
+			q = q.Where("status = ?", *opts.Status)
+		} else {
+			q = q.Where("status IS NULL")
+		}
+
+		var existing []*relayer.MessageHistory
+
+		if err := q.Limit(1).Find(&existing).Error; err != nil {
+			return errors.Wrap(err, "tx.Find")
+		}
+
+		if len(existing) > 0 {
+			h = nil
+			return nil
+		}
+
+		if err := tx.Create(h).Error; err != nil {
+			return errors.Wrap(err, "tx.Create")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func newMessageHistory(opts *relayer.SaveMessageHistoryOpts) *relayer.MessageHistory {
+	h := &relayer.MessageHistory{
+		MsgHash:     opts.MsgHash,
+		Kind:        opts.Kind,
+		ChainID:     opts.ChainID.Int64(),
+		DestChainID: opts.DestChainID.Int64(),
+		Status:      opts.Status,
+		TxHash:      opts.TxHash,
+		BlockID:     opts.BlockID,
+		Error:       opts.Error,
+	}
+
+	if opts.Data != "" {
+		h.Data = datatypes.JSON(opts.Data)
+	}
+
+	return h
+}
+
+// FindAllByMsgHash returns the timeline of a message, oldest entry first.
+func (r *MessageHistoryRepository) FindAllByMsgHash(
+	ctx context.Context,
+	msgHash string,
+) ([]*relayer.MessageHistory, error) {
+	var history []*relayer.MessageHistory
+
+	if err := r.db.GormDB().WithContext(ctx).
+		Where("msg_hash = ?", msgHash).
+		Order("id ASC").
+		Find(&history).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return history, nil
+}
@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewMessageHistoryRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMessageHistoryRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_MessageHistory_FindAllByMsgHash(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	historyRepo, err := NewMessageHistoryRepository(db)
+	assert.Equal(t, nil, err)
+
+	status := relayer.EventStatusDone
+
+	for _, opts := range []*relayer.SaveMessageHistoryOpts{
+		{MsgHash: "0x1", Kind: relayer.MessageHistoryKindMessageSent, TxHash: "0xa", BlockID: 1},
+		{MsgHash: "0x2", Kind: relayer.MessageHistoryKindMessageSent, TxHash: "0xb", BlockID: 2},
+		{MsgHash: "0x1", Kind: relayer.MessageHistoryKindProcessingAttempt, Error: "reverted"},
+		{MsgHash: "0x1", Kind: relayer.MessageHistoryKindMessageProcessed, Status: &status, Data: `{"gasUsed":1}`},
+	} {
+		opts.ChainID = big.NewInt(1)
+		opts.DestChainID = big.NewInt(2)
+
+		_, err := historyRepo.Save(context.Background(), opts)
+		assert.Equal(t, nil, err)
+	}
+
+	history, err := historyRepo.FindAllByMsgHash(context.Background(), "0x1")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(history))
+	assert.Equal(t, relayer.MessageHistoryKindMessageSent, history[0].Kind)
+	assert.Equal(t, uint64(1), history[0].BlockID)
+	assert.Equal(t, "reverted", history[1].Error)
+	assert.Equal(t, relayer.EventStatusDone, *history[2].Status)
+
+	history, err = historyRepo.FindAllByMsgHash(context.Background(), "0x3")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(history))
+}
+
+func TestIntegration_MessageHistory_SaveOnce(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	historyRepo, err := NewMessageHistoryRepository(db)
+	assert.Equal(t, nil, err)
+
+	done := relayer.EventStatusDone
+	retriable := relayer.EventStatusRetriable
+
+	for _, tt := range []struct {
+		opts  *relayer.SaveMessageHistoryOpts
+		saved bool
+	}{
+		{&relayer.SaveMessageHistoryOpts{Kind: relayer.MessageHistoryKindMessageProcessed, Status: &retriable}, true},
+		// the processor and the indexer both save the entry of a processing.
+		{&relayer.SaveMessageHistoryOpts{Kind: relayer.MessageHistoryKindMessageProcessed, Status: &retriable}, false},
+		// a retried message is processed again, into another status.
+		{&relayer.SaveMessageHistoryOpts{Kind: relayer.MessageHistoryKindMessageProcessed, Status: &done}, true},
+		{&relayer.SaveMessageHistoryOpts{Kind: relayer.MessageHistoryKindStatusChanged, Status: &done}, true},
+		{&relayer.SaveMessageHistoryOpts{Kind: relayer.MessageHistoryKindMessageProcessed}, true},
+		{&relayer.SaveMessageHistoryOpts{Kind: relayer.MessageHistoryKindMessageProcessed}, false},
+	} {
+		tt.opts.MsgHash = "0x1"
+		tt.opts.ChainID = big.NewInt(1)
+		tt.opts.DestChainID = big.NewInt(2)
+
+		h, err := historyRepo.SaveOnce(context.Background(), tt.opts)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, tt.saved, h != nil)
+	}
+
+	history, err := historyRepo.FindAllByMsgHash(context.Background(), "0x1")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(history))
+}
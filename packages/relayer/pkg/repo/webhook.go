@@ -0,0 +1,184 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+type WebhookRepository struct {
+	db db.DB
+}
+
+func NewWebhookRepository(dbHandler db.DB) (*WebhookRepository, error) {
+	if dbHandler == nil {
+		return nil, db.ErrNoDB
+	}
+
+	return &WebhookRepository{
+		db: dbHandler,
+	}, nil
+}
+
+func (r *WebhookRepository) SaveSubscription(
+	ctx context.Context,
+	opts *relayer.SaveWebhookSubscriptionOpts,
+) (*relayer.WebhookSubscription, error) {
+	s := &relayer.WebhookSubscription{
+		URL:     opts.URL,
+		Secret:  opts.Secret,
+		Owner:   opts.Owner,
+		MsgHash: opts.MsgHash,
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Create(s).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Create")
+	}
+
+	return s, nil
+}
+
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id int) error {
+	return r.db.GormDB().WithContext(ctx).Delete(&relayer.WebhookSubscription{}, id).Error
+}
+
+func (r *WebhookRepository) FindSubscriptions(ctx context.Context) ([]*relayer.WebhookSubscription, error) {
+	var subscriptions []*relayer.WebhookSubscription
+
+	if err := r.db.GormDB().WithContext(ctx).Order("id ASC").Find(&subscriptions).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return subscriptions, nil
+}
+
+// FindSubscription returns the subscription with the given id, or nil if it does not exist.
+func (r *WebhookRepository) FindSubscription(ctx context.Context, id int) (*relayer.WebhookSubscription, error) {
+	s := &relayer.WebhookSubscription{}
+
+	if err := r.db.GormDB().WithContext(ctx).Where("id = ?", id).First(s).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+
+		return nil, errors.Wrap(err, "r.db.First")
+	}
+
+	return s, nil
+}
+
+// FindSubscriptionsByMessage returns the subscriptions to the owner of a message, or to the
+// message itself.
+func (r *WebhookRepository) FindSubscriptionsByMessage(
+	ctx context.Context,
+	owner string,
+	msgHash string,
+) ([]*relayer.WebhookSubscription, error) {
+	var subscriptions []*relayer.WebhookSubscription
+
+	q := r.db.GormDB().WithContext(ctx)
+
+	switch {
+	case owner != "" && msgHash != "":
+		q = q.Where("(owner != '' AND owner = ?) OR (msg_hash != '' AND msg_hash = ?)", owner, msgHash)
+	case owner != "":
+		q = q.Where("owner != '' AND owner = ?", owner)
+	case msgHash != "":
+		q = q.Where("msg_hash != '' AND msg_hash = ?", msgHash)
+	default:
+		return subscriptions, nil
+	}
+
+	if err := q.Order("id ASC").Find(&subscriptions).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Find")
+	}
+
+	return subscriptions, nil
+}
+
+func (r *WebhookRepository) SaveDelivery(
+	ctx context.Context,
+	opts *relayer.SaveWebhookDeliveryOpts,
+) (*relayer.WebhookDelivery, error) {
+	d := &relayer.WebhookDelivery{
+		SubscriptionID: opts.SubscriptionID,
+		MsgHash:        opts.MsgHash,
+		Payload:        datatypes.JSON(opts.Payload),
+		Status:         relayer.WebhookDeliveryStatusPending,
+		NextAttemptAt:  time.Now().UTC(),
+	}
+
+	if err := r.db.GormDB().WithContext(ctx).Create(d).Error; err != nil {
+		return nil, errors.Wrap(err, "r.db.Create")
+	}
+
+	return d, nil
+}
+
+// ClaimDueDeliveries returns the oldest pending deliveries due to be sent, and postpones their
+// next attempt by the lease.
+func (r *WebhookRepository) ClaimDueDeliveries(
+	ctx context.Context,
+	lease time.Duration,
+	limit int,
+) ([]*relayer.WebhookDelivery, error) {
+	var deliveries []*relayer.WebhookDelivery
+
+	err := r.db.GormDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", relayer.WebhookDeliveryStatusPending, now).
+			Order("next_attempt_at ASC").
+			Limit(limit).
+			Find(&deliveries).Error; err != nil {
+			return err
+		}
+
+		if len(deliveries) == 0 {
+			return nil
+		}
+
+		ids := make([]int, 0, len(deliveries))
+		for _, d := range deliveries {
+			ids = append(ids, d.ID)
+		}
+
+		return tx.Model(&relayer.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "r.db.Transaction")
+	}
+
+	return deliveries, nil
+}
+
+func (r *WebhookRepository) UpdateDelivery(
+	ctx context.Context,
+	id int,
+	opts *relayer.UpdateWebhookDeliveryOpts,
+) error {
+	if err := r.db.GormDB().WithContext(ctx).
+		Model(&relayer.WebhookDelivery{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          opts.Status,
+			"attempts":        opts.Attempts,
+			"last_error":      opts.LastError,
+			"next_attempt_at": opts.NextAttemptAt,
+			"delivered_at":    opts.DeliveredAt,
+		}).Error; err != nil {
+		return errors.Wrap(err, "r.db.Updates")
+	}
+
+	return nil
+}
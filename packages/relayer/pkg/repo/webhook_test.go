@@ -0,0 +1,121 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gopkg.in/go-playground/assert.v1"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+)
+
+func Test_NewWebhookRepo(t *testing.T) {
+	tests := []struct {
+		name    string
+		db      db.DB
+		wantErr error
+	}{
+		{
+			"success",
+			&db.Database{},
+			nil,
+		},
+		{
+			"noDb",
+			nil,
+			db.ErrNoDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWebhookRepository(tt.db)
+			assert.Equal(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestIntegration_Webhook_FindSubscriptionsByMessage(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	webhookRepo, err := NewWebhookRepository(db)
+	assert.Equal(t, nil, err)
+
+	var saved []*relayer.WebhookSubscription
+
+	for _, opts := range []*relayer.SaveWebhookSubscriptionOpts{
+		{URL: "https://owner.example.com", Secret: "a", Owner: "0xowner"},
+		{URL: "https://message.example.com", Secret: "b", MsgHash: "0x1"},
+		{URL: "https://other.example.com", Secret: "c", Owner: "0xother"},
+	} {
+		s, err := webhookRepo.SaveSubscription(context.Background(), opts)
+		assert.Equal(t, nil, err)
+
+		saved = append(saved, s)
+	}
+
+	subscriptions, err := webhookRepo.FindSubscriptionsByMessage(context.Background(), "0xowner", "0x1")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(subscriptions))
+	assert.Equal(t, "https://owner.example.com", subscriptions[0].URL)
+	assert.Equal(t, "https://message.example.com", subscriptions[1].URL)
+
+	subscriptions, err = webhookRepo.FindSubscriptionsByMessage(context.Background(), "0xnobody", "0x2")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(subscriptions))
+
+	assert.Equal(t, nil, webhookRepo.DeleteSubscription(context.Background(), saved[2].ID))
+
+	subscriptions, err = webhookRepo.FindSubscriptions(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(subscriptions))
+
+	s, err := webhookRepo.FindSubscription(context.Background(), saved[2].ID)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, nil, s)
+}
+
+func TestIntegration_Webhook_ClaimDueDeliveries(t *testing.T) {
+	db, close, err := testMysql(t)
+	assert.Equal(t, nil, err)
+
+	defer close()
+
+	webhookRepo, err := NewWebhookRepository(db)
+	assert.Equal(t, nil, err)
+
+	delivery, err := webhookRepo.SaveDelivery(context.Background(), &relayer.SaveWebhookDeliveryOpts{
+		SubscriptionID: 1,
+		MsgHash:        "0x1",
+		Payload:        `{"msgHash":"0x1"}`,
+	})
+	assert.Equal(t, nil, err)
+
+	deliveries, err := webhookRepo.ClaimDueDeliveries(context.Background(), time.Minute, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(deliveries))
+	assert.Equal(t, delivery.ID, deliveries[0].ID)
+
+	// a claimed delivery is not due again until its lease expires.
+	deliveries, err = webhookRepo.ClaimDueDeliveries(context.Background(), time.Minute, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(deliveries))
+
+	deliveredAt := time.Now().UTC()
+
+	assert.Equal(t, nil, webhookRepo.UpdateDelivery(context.Background(), delivery.ID, &relayer.UpdateWebhookDeliveryOpts{
+		Status:        relayer.WebhookDeliveryStatusDelivered,
+		Attempts:      1,
+		NextAttemptAt: deliveredAt,
+		DeliveredAt:   &deliveredAt,
+	}))
+
+	deliveries, err = webhookRepo.ClaimDueDeliveries(context.Background(), time.Minute, 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(deliveries))
+}
@@ -0,0 +1,251 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// Headers of the webhook requests. The signature is the hex encoded HMAC-SHA256 of the
+// timestamp and the body joined by a ".", keyed by the secret of the subscription.
+const (
+	SignatureHeader = "X-Relayer-Signature"
+	TimestampHeader = "X-Relayer-Timestamp"
+	DeliveryHeader  = "X-Relayer-Delivery"
+)
+
+var (
+	// deliveryBatchSize is the maximum number of deliveries sent at every interval.
+	deliveryBatchSize = 50
+
+	// maxRetryInterval caps the backoff between the attempts of a delivery.
+	maxRetryInterval = time.Hour
+)
+
+type NewDispatcherOpts struct {
+	WebhookRepo   relayer.WebhookRepository
+	Interval      time.Duration
+	RetryInterval time.Duration
+	MaxAttempts   uint64
+	Timeout       time.Duration
+}
+
+// Dispatcher saves a delivery of every message status notification for the matching
+// subscriptions, and sends the deliveries until they succeed or run out of attempts.
+type Dispatcher struct {
+	webhookRepo   relayer.WebhookRepository
+	client        *http.Client
+	interval      time.Duration
+	retryInterval time.Duration
+	maxAttempts   uint64
+	timeout       time.Duration
+}
+
+func NewDispatcher(opts NewDispatcherOpts) (*Dispatcher, error) {
+	if opts.WebhookRepo == nil {
+		return nil, relayer.ErrNoWebhookRepository
+	}
+
+	return &Dispatcher{
+		webhookRepo:   opts.WebhookRepo,
+		client:        &http.Client{Timeout: opts.Timeout},
+		interval:      opts.Interval,
+		retryInterval: opts.RetryInterval,
+		maxAttempts:   opts.MaxAttempts,
+		timeout:       opts.Timeout,
+	}, nil
+}
+
+// Sign returns the signature of a webhook request.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Notify saves a delivery of the notification for every subscription to the owner of the
+// message, or to the message itself. The deliveries are sent by Start. A delivery failing to
+// save does not prevent the deliveries of the other subscriptions, the failures are joined.
+func (d *Dispatcher) Notify(ctx context.Context, n *relayer.MessageStatusNotification) error {
+	subscriptions, err := d.webhookRepo.FindSubscriptionsByMessage(ctx, n.Owner, n.MsgHash)
+	if err != nil {
+		return errors.Wrap(err, "d.webhookRepo.FindSubscriptionsByMessage")
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(n)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	var errs []error
+
+	for _, s := range subscriptions {
+		if _, err := d.webhookRepo.SaveDelivery(ctx, &relayer.SaveWebhookDeliveryOpts{
+			SubscriptionID: s.ID,
+			MsgHash:        n.MsgHash,
+			Payload:        string(payload),
+		}); err != nil {
+			errs = append(errs, errors.Wrapf(err, "d.webhookRepo.SaveDelivery(%v)", s.ID))
+		}
+	}
+
+	return stderrors.Join(errs...)
+}
+
+// Start sends the due deliveries at every interval, until the context is done.
+func (d *Dispatcher) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	defer wg.Done()
+
+	t := time.NewTicker(d.interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if err := d.sendDueDeliveries(ctx); err != nil {
+				slog.Error("error sending webhook deliveries", "error", err)
+			}
+		}
+	}
+}
+
+// sendDueDeliveries claims the due deliveries, and sends them concurrently. The claim lasts
+// longer than a request can, so the deliveries are not sent twice.
+func (d *Dispatcher) sendDueDeliveries(ctx context.Context) error {
+	deliveries, err := d.webhookRepo.ClaimDueDeliveries(ctx, 2*d.timeout, deliveryBatchSize)
+	if err != nil {
+		return errors.Wrap(err, "d.webhookRepo.ClaimDueDeliveries")
+	}
+
+	var wg sync.WaitGroup
+
+	for _, delivery := range deliveries {
+		wg.Add(1)
+
+		go func(delivery *relayer.WebhookDelivery) {
+			defer wg.Done()
+
+			d.send(ctx, delivery)
+		}(delivery)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// send attempts the delivery, and records its outcome. A failed attempt is retried with an
+// exponential backoff, until the maximum attempts are reached.
+func (d *Dispatcher) send(ctx context.Context, delivery *relayer.WebhookDelivery) {
+	now := time.Now().UTC()
+
+	opts := &relayer.UpdateWebhookDeliveryOpts{
+		Status:        relayer.WebhookDeliveryStatusDelivered,
+		Attempts:      delivery.Attempts + 1,
+		NextAttemptAt: now,
+		DeliveredAt:   &now,
+	}
+
+	if err := d.post(ctx, delivery); err != nil {
+		relayer.WebhookDeliveryAttemptsFailed.Inc()
+
+		slog.Warn("webhook delivery attempt failed",
+			"deliveryID", delivery.ID,
+			"subscriptionID", delivery.SubscriptionID,
+			"attempts", opts.Attempts,
+			"error", err,
+		)
+
+		opts.Status = relayer.WebhookDeliveryStatusPending
+		opts.LastError = err.Error()
+		opts.NextAttemptAt = now.Add(d.backoff(opts.Attempts))
+		opts.DeliveredAt = nil
+
+		if opts.Attempts >= d.maxAttempts {
+			opts.Status = relayer.WebhookDeliveryStatusFailed
+		}
+	} else {
+		relayer.WebhookDeliveriesSent.Inc()
+	}
+
+	if err := d.webhookRepo.UpdateDelivery(ctx, delivery.ID, opts); err != nil {
+		slog.Error("error updating webhook delivery", "deliveryID", delivery.ID, "error", err)
+	}
+}
+
+// post sends the signed payload of the delivery to the URL of its subscription. Any response
+// status other than 2xx is an error.
+func (d *Dispatcher) post(ctx context.Context, delivery *relayer.WebhookDelivery) error {
+	subscription, err := d.webhookRepo.FindSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return errors.Wrap(err, "d.webhookRepo.FindSubscription")
+	}
+
+	if subscription == nil {
+		return errors.New("subscription deleted")
+	}
+
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return errors.Wrap(err, "http.NewRequestWithContext")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "d.client.Do")
+	}
+
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %v", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// backoff returns the time to wait before the next attempt, the retry interval doubled after
+// every attempt.
+func (d *Dispatcher) backoff(attempts uint64) time.Duration {
+	wait := d.retryInterval
+
+	for i := uint64(1); i < attempts && wait < maxRetryInterval; i++ {
+		wait *= 2
+	}
+
+	return min(wait, maxRetryInterval)
+}
@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func newTestDispatcher(t *testing.T, maxAttempts uint64) (*Dispatcher, *mock.WebhookRepository) {
+	repo := mock.NewWebhookRepository()
+
+	d, err := NewDispatcher(NewDispatcherOpts{
+		WebhookRepo:   repo,
+		Interval:      time.Second,
+		RetryInterval: time.Minute,
+		MaxAttempts:   maxAttempts,
+		Timeout:       time.Second,
+	})
+	require.NoError(t, err)
+
+	return d, repo
+}
+
+func Test_NewDispatcher(t *testing.T) {
+	_, err := NewDispatcher(NewDispatcherOpts{})
+	assert.Equal(t, relayer.ErrNoWebhookRepository, err)
+}
+
+func Test_Dispatcher_delivers(t *testing.T) {
+	var body []byte
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+
+		body, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		require.NoError(t, err)
+
+		assert.Equal(t, Sign("secret", timestamp, body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, "1", r.Header.Get(DeliveryHeader))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	d, repo := newTestDispatcher(t, 3)
+
+	_, err := repo.SaveSubscription(context.Background(), &relayer.SaveWebhookSubscriptionOpts{
+		URL:    srv.URL,
+		Secret: "secret",
+		Owner:  "0xowner",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, d.Notify(context.Background(), &relayer.MessageStatusNotification{
+		MsgHash: "0x1",
+		Owner:   "0xowner",
+		Status:  relayer.EventStatusDone,
+	}))
+
+	// notifications of other owners are not delivered to the subscription.
+	require.NoError(t, d.Notify(context.Background(), &relayer.MessageStatusNotification{
+		MsgHash: "0x2",
+		Owner:   "0xother",
+	}))
+
+	require.NoError(t, d.sendDueDeliveries(context.Background()))
+
+	deliveries := repo.Deliveries()
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, relayer.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+	assert.Equal(t, uint64(1), deliveries[0].Attempts)
+	assert.NotNil(t, deliveries[0].DeliveredAt)
+	assert.JSONEq(t, string(deliveries[0].Payload), string(body))
+}
+
+func Test_Dispatcher_NotifySavesOtherSubscriptionsOnError(t *testing.T) {
+	d, repo := newTestDispatcher(t, 3)
+
+	for i := 0; i < 3; i++ {
+		_, err := repo.SaveSubscription(context.Background(), &relayer.SaveWebhookSubscriptionOpts{
+			URL:    "http://localhost",
+			Secret: "secret",
+			Owner:  "0xowner",
+		})
+		require.NoError(t, err)
+	}
+
+	errSaveDelivery := errors.New("save delivery")
+	repo.SaveDeliveryErrs = map[int]error{2: errSaveDelivery}
+
+	err := d.Notify(context.Background(), &relayer.MessageStatusNotification{
+		MsgHash: "0x1",
+		Owner:   "0xowner",
+	})
+	assert.ErrorIs(t, err, errSaveDelivery)
+
+	// the subscriptions after the failing one still get their delivery.
+	deliveries := repo.Deliveries()
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, 1, deliveries[0].SubscriptionID)
+	assert.Equal(t, 3, deliveries[1].SubscriptionID)
+}
+
+func Test_Dispatcher_retries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	d, repo := newTestDispatcher(t, 2)
+
+	_, err := repo.SaveSubscription(context.Background(), &relayer.SaveWebhookSubscriptionOpts{
+		URL:     srv.URL,
+		Secret:  "secret",
+		MsgHash: "0x1",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, d.Notify(context.Background(), &relayer.MessageStatusNotification{MsgHash: "0x1"}))
+	require.NoError(t, d.sendDueDeliveries(context.Background()))
+
+	delivery := repo.Deliveries()[0]
+	assert.Equal(t, relayer.WebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, uint64(1), delivery.Attempts)
+	assert.Equal(t, "unexpected response status 500", delivery.LastError)
+	assert.True(t, delivery.NextAttemptAt.After(time.Now().Add(50*time.Second)))
+
+	// the delivery is not due before its backoff elapses.
+	require.NoError(t, d.sendDueDeliveries(context.Background()))
+	assert.Equal(t, uint64(1), repo.Deliveries()[0].Attempts)
+
+	require.NoError(t, repo.UpdateDelivery(context.Background(), delivery.ID, &relayer.UpdateWebhookDeliveryOpts{
+		Status:        delivery.Status,
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: time.Now().UTC(),
+	}))
+	require.NoError(t, d.sendDueDeliveries(context.Background()))
+
+	delivery = repo.Deliveries()[0]
+	assert.Equal(t, relayer.WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, uint64(2), delivery.Attempts)
+}
+
+func Test_Dispatcher_backoff(t *testing.T) {
+	d, _ := newTestDispatcher(t, 3)
+
+	assert.Equal(t, time.Minute, d.backoff(1))
+	assert.Equal(t, 2*time.Minute, d.backoff(2))
+	assert.Equal(t, 8*time.Minute, d.backoff(4))
+	assert.Equal(t, time.Hour, d.backoff(100))
+}
@@ -0,0 +1,310 @@
+package processor
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/pkg/errors"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+)
+
+// batchProcessorABIJSON is the processMessages function of the MessageBatchProcessor ABI, which
+// processes every message on its own and forwards the processing fees of the batch to the caller.
+const batchProcessorABIJSON = `[{"inputs":[` +
+	`{"internalType":"bytes[]","name":"_calls","type":"bytes[]"}],` +
+	`"name":"processMessages","outputs":[` +
+	`{"internalType":"bool[]","name":"succeeded_","type":"bool[]"}],` +
+	`"stateMutability":"nonpayable","type":"function"}]`
+
+var batchProcessorABI abi.ABI
+
+func init() {
+	var err error
+
+	if batchProcessorABI, err = abi.JSON(strings.NewReader(batchProcessorABIJSON)); err != nil {
+		log.Crit("Get MessageBatchProcessor ABI error", "error", err)
+	}
+}
+
+// batchedCall is a processMessage call waiting to be sent in a batch.
+type batchedCall struct {
+	event    *bridge.BridgeMessageSent
+	data     []byte
+	gasLimit uint64
+	result   chan batchedCallResult
+}
+
+// batchedCallResult is the outcome of a batched call, the receipt is the receipt of the
+// batch transaction with its gas used set to the share of the call.
+type batchedCallResult struct {
+	receipt *types.Receipt
+	err     error
+}
+
+// sendBatchedProcessMessageCall hands the processMessage call over to the batch loop, and
+// waits for the batch it ends up in to be mined.
+func (p *Processor) sendBatchedProcessMessageCall(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+	data []byte,
+	gasLimit uint64,
+) (*types.Receipt, error) {
+	call := &batchedCall{
+		event:    event,
+		data:     data,
+		gasLimit: gasLimit,
+		result:   make(chan batchedCallResult, 1),
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case p.batchCh <- call:
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-call.result:
+		return result.receipt, result.err
+	}
+}
+
+// batchLoop collects the processMessage calls of the ready messages, and sends them together
+// once the batch window has elapsed since the first one, or the batch is full.
+func (p *Processor) batchLoop(ctx context.Context) {
+	p.wg.Add(1)
+	defer p.wg.Done()
+
+	for {
+		var calls []*batchedCall
+
+		select {
+		case <-ctx.Done():
+			return
+		case call := <-p.batchCh:
+			calls = append(calls, call)
+		}
+
+		timer := time.NewTimer(p.batchWindow)
+
+	collect:
+		for len(calls) < p.batchMaxSize {
+			select {
+			case <-ctx.Done():
+				break collect
+			case <-timer.C:
+				break collect
+			case call := <-p.batchCh:
+				calls = append(calls, call)
+			}
+		}
+
+		timer.Stop()
+
+		p.sendBatch(ctx, calls)
+	}
+}
+
+// sendBatch sends the calls in a single processMessages transaction to the batch processor, and
+// reports the outcome of every call to the message waiting for it.
+func (p *Processor) sendBatch(ctx context.Context, calls []*batchedCall) {
+	if p.profitableOnly {
+		calls = p.profitableBatchedCalls(ctx, calls)
+		if len(calls) == 0 {
+			return
+		}
+	}
+
+	data, err := packBatch(calls)
+	if err != nil {
+		failBatchedCalls(calls, err)
+		return
+	}
+
+	// the padded gas limit of every message already covers the intrinsic gas of a transaction
+	// of its own, which leaves room for the overhead of the batch processor.
+	var gasLimit uint64
+	for _, call := range calls {
+		gasLimit += call.gasLimit
+	}
+
+	slog.Info("Sending batch", "calls", len(calls), "gasLimit", gasLimit)
+
+	receipt, err := p.txmgr.Send(ctx, txmgr.TxCandidate{
+		TxData:   data,
+		Blobs:    nil,
+		To:       &p.batchProcessorAddress,
+		GasLimit: gasLimit,
+	})
+	if err != nil {
+		failBatchedCalls(calls, err)
+		return
+	}
+
+	relayer.ProcessMessageBatchesSent.Inc()
+
+	for _, call := range calls {
+		call.result <- p.batchedCallResult(receipt, call, gasLimit)
+	}
+}
+
+// batchedCallResult returns the outcome of the call in the mined batch. A reverted batch is
+// reported as is, while a call that failed within a successful batch is reported as failed.
+func (p *Processor) batchedCallResult(
+	receipt *types.Receipt,
+	call *batchedCall,
+	batchGasLimit uint64,
+) batchedCallResult {
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return batchedCallResult{receipt: receipt}
+	}
+
+	if !p.isMessageProcessedInReceipt(receipt, call.event) {
+		relayer.ProcessMessageBatchedCallsFailed.Inc()
+
+		slog.Warn("Batched processMessage call failed",
+			"msgHash", common.Hash(call.event.MsgHash).Hex(),
+			"txHash", receipt.TxHash.Hex(),
+		)
+
+		return batchedCallResult{
+			err: errors.Wrapf(errBatchedCallFailed, "txHash %v", receipt.TxHash.Hex()),
+		}
+	}
+
+	// the cost of the batch is shared between the calls in proportion to their gas limits,
+	// which the profitability after transacting and the processing cost are computed from.
+	share := *receipt
+	share.GasUsed = batchGasShare(receipt.GasUsed, call.gasLimit, batchGasLimit)
+
+	return batchedCallResult{receipt: &share}
+}
+
+// profitableBatchedCalls estimates the gas used by the batch, and shares its cost between the
+// calls in proportion to their gas limits. The calls whose fee does not cover their share are
+// left out of the batch as unprofitable.
+func (p *Processor) profitableBatchedCalls(ctx context.Context, calls []*batchedCall) []*batchedCall {
+	data, err := packBatch(calls)
+	if err != nil {
+		failBatchedCalls(calls, err)
+		return nil
+	}
+
+	baseFee, err := p.getBaseFee(ctx)
+	if err != nil {
+		failBatchedCalls(calls, err)
+		return nil
+	}
+
+	gasTipCap, err := p.destEthClient.SuggestGasTipCap(ctx)
+	if err != nil {
+		failBatchedCalls(calls, err)
+		return nil
+	}
+
+	gasTipCap = relayer.EffectiveGasTipCap(gasTipCap, p.minTipCap)
+
+	gasUsed, err := p.destEthClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: p.relayerAddr,
+		To:   &p.batchProcessorAddress,
+		Data: data,
+	})
+	if err != nil {
+		failBatchedCalls(calls, errors.Wrap(err, "p.destEthClient.EstimateGas"))
+		return nil
+	}
+
+	var batchGasLimit uint64
+	for _, call := range calls {
+		batchGasLimit += call.gasLimit
+	}
+
+	gasPrice := (baseFee.Uint64() * 2) + gasTipCap.Uint64()
+
+	profitable := make([]*batchedCall, 0, len(calls))
+
+	for _, call := range calls {
+		estimatedOnchainFee := batchGasShare(gasUsed, call.gasLimit, batchGasLimit) * gasPrice
+
+		if call.event.Message.Fee < estimatedOnchainFee {
+			slog.Info("unprofitable in batch",
+				"msgHash", common.Hash(call.event.MsgHash).Hex(),
+				"processingFee", call.event.Message.Fee,
+				"estimatedOnchainFee", estimatedOnchainFee,
+			)
+
+			relayer.UnprofitableMessagesDetected.Inc()
+
+			call.result <- batchedCallResult{err: relayer.ErrUnprofitable}
+
+			continue
+		}
+
+		profitable = append(profitable, call)
+	}
+
+	slog.Info("batch profitability",
+		"calls", len(calls),
+		"profitableCalls", len(profitable),
+		"estimatedGasUsed", gasUsed,
+		"destChainBaseFee", baseFee,
+		"gasTipCap", gasTipCap,
+	)
+
+	return profitable
+}
+
+// isMessageProcessedInReceipt returns whether the receipt has the MessageProcessed event of
+// the message, emitted by the destination bridge.
+func (p *Processor) isMessageProcessedInReceipt(receipt *types.Receipt, event *bridge.BridgeMessageSent) bool {
+	messageProcessed := encoding.BridgeABI.Events["MessageProcessed"]
+
+	for _, receiptLog := range receipt.Logs {
+		if receiptLog != nil && receiptLog.Address == p.cfg.DestBridgeAddress && len(receiptLog.Topics) > 1 &&
+			receiptLog.Topics[0] == messageProcessed.ID && receiptLog.Topics[1] == common.Hash(event.MsgHash) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// packBatch packs the processMessages calldata of the processMessage calls to the bridge.
+func packBatch(calls []*batchedCall) ([]byte, error) {
+	data := make([][]byte, 0, len(calls))
+
+	for _, call := range calls {
+		data = append(data, call.data)
+	}
+
+	return batchProcessorABI.Pack("processMessages", data)
+}
+
+// batchGasShare returns the share of the gas of a batch of a call, in proportion to its gas
+// limit.
+func batchGasShare(gas uint64, gasLimit uint64, batchGasLimit uint64) uint64 {
+	if batchGasLimit == 0 {
+		return 0
+	}
+
+	return gas/batchGasLimit*gasLimit + gas%batchGasLimit*gasLimit/batchGasLimit
+}
+
+// failBatchedCalls reports the error to every call of the batch.
+func failBatchedCalls(calls []*batchedCall, err error) {
+	for _, call := range calls {
+		call.result <- batchedCallResult{err: err}
+	}
+}
@@ -0,0 +1,153 @@
+package processor
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+// batchTxManager is a mock.TxManager recording the candidates it sends, and returning a
+// fixed receipt.
+type batchTxManager struct {
+	mock.TxManager
+	mu         sync.Mutex
+	candidates []txmgr.TxCandidate
+	receipt    *types.Receipt
+}
+
+func (t *batchTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.candidates = append(t.candidates, candidate)
+
+	return t.receipt, nil
+}
+
+func newBatchedCall(event *bridge.BridgeMessageSent, gasLimit uint64) *batchedCall {
+	return &batchedCall{
+		event:    event,
+		data:     []byte{0x1},
+		gasLimit: gasLimit,
+		result:   make(chan batchedCallResult, 1),
+	}
+}
+
+func Test_sendBatch_partialFailure(t *testing.T) {
+	processed := newProcessMessageEvent(100)
+	failed := newProcessMessageEvent(100)
+	failed.MsgHash = [32]byte{0x9}
+
+	receipt := &types.Receipt{
+		Status:  types.ReceiptStatusSuccessful,
+		GasUsed: 90_000,
+		TxHash:  common.HexToHash("0x1234"),
+		Logs: []*types.Log{messageProcessedLog(t, processed.Message, bridge.BridgeProcessingStats{
+			GasUsedInFeeCalc:   1,
+			ProcessedByRelayer: true,
+		})},
+	}
+
+	p := newTestProcessor(false)
+	p.batchProcessorAddress = common.HexToAddress("0x4A2C0BcFEd4Aa4D23d8f5c2E1A3f1d4e43b1C3aE")
+	txManager := &batchTxManager{receipt: receipt}
+	p.txmgr = txManager
+
+	processedCall := newBatchedCall(processed, 200_000)
+	failedCall := newBatchedCall(failed, 100_000)
+
+	p.sendBatch(context.Background(), []*batchedCall{processedCall, failedCall})
+
+	require.Len(t, txManager.candidates, 1)
+	assert.Equal(t, &p.batchProcessorAddress, txManager.candidates[0].To)
+	assert.Equal(t, batchProcessorABI.Methods["processMessages"].ID, txManager.candidates[0].TxData[:4])
+	assert.Equal(t, uint64(300_000), txManager.candidates[0].GasLimit)
+
+	result := <-processedCall.result
+	require.NoError(t, result.err)
+	assert.Equal(t, uint64(60_000), result.receipt.GasUsed)
+	assert.Equal(t, receipt.TxHash, result.receipt.TxHash)
+	assert.Equal(t, uint64(90_000), receipt.GasUsed)
+
+	result = <-failedCall.result
+	assert.ErrorIs(t, result.err, errBatchedCallFailed)
+	assert.True(t, isTransientProcessMessageError(result.err))
+}
+
+func Test_sendBatch_revertedBatch(t *testing.T) {
+	receipt := &types.Receipt{Status: types.ReceiptStatusFailed}
+
+	p := newTestProcessor(false)
+	p.txmgr = &batchTxManager{receipt: receipt}
+
+	call := newBatchedCall(newProcessMessageEvent(100), 100_000)
+
+	p.sendBatch(context.Background(), []*batchedCall{call})
+
+	result := <-call.result
+	assert.NoError(t, result.err)
+	assert.Equal(t, receipt, result.receipt)
+}
+
+func Test_sendBatch_unprofitableCall(t *testing.T) {
+	p := newTestProcessor(true)
+	txManager := &batchTxManager{receipt: &types.Receipt{Status: types.ReceiptStatusSuccessful}}
+	p.txmgr = txManager
+
+	call := newBatchedCall(newProcessMessageEvent(0), 100_000)
+
+	p.sendBatch(context.Background(), []*batchedCall{call})
+
+	result := <-call.result
+	assert.ErrorIs(t, result.err, relayer.ErrUnprofitable)
+	assert.Empty(t, txManager.candidates)
+}
+
+func Test_batchLoop_sendsFullBatch(t *testing.T) {
+	p := newTestProcessor(false)
+	p.batchWindow = time.Minute
+	p.batchMaxSize = 2
+	p.batchCh = make(chan *batchedCall)
+
+	txManager := &batchTxManager{receipt: &types.Receipt{Status: types.ReceiptStatusFailed}}
+	p.txmgr = txManager
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go p.batchLoop(ctx)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			receipt, err := p.sendBatchedProcessMessageCall(ctx, newProcessMessageEvent(100), []byte{0x1}, 100_000)
+			assert.NoError(t, err)
+			assert.NotNil(t, receipt)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Len(t, txManager.candidates, 1)
+}
+
+func Test_batchGasShare(t *testing.T) {
+	assert.Equal(t, uint64(60_000), batchGasShare(90_000, 200_000, 300_000))
+	assert.Equal(t, uint64(30_000), batchGasShare(90_000, 100_000, 300_000))
+	assert.Equal(t, uint64(0), batchGasShare(90_000, 100_000, 0))
+}
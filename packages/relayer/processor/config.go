@@ -3,6 +3,7 @@ package processor
 import (
 	"crypto/ecdsa"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,7 +17,6 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
-	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue/rabbitmq"
 )
 
 // Config is a struct used to initialize a processor.
@@ -59,6 +59,7 @@ type Config struct {
 	QueuePassword string
 	QueueHost     string
 	QueuePort     uint64
+	QueueDriver   string
 	QueuePrefetch uint64
 	// rpc configs
 	SrcRPCUrl        string
@@ -73,6 +74,30 @@ type Config struct {
 
 	MaxMessageRetries uint64
 	MinFeeToProcess   uint64
+
+	// retry and recall configs
+	RetryMessages            bool
+	RetryMessagesMaxAttempts uint64
+	RetryMessagesInterval    uint64
+	RetryMessagesMaxGasLimit uint64
+	RetryMessagesMaxGasPrice uint64
+	RecallCandidates         bool
+	DestSignalServiceAddress common.Address
+
+	// profitability configs, only used with ProfitableOnly. All tokens are processed if
+	// the allowlist is empty.
+	ProfitMarginBps uint64
+	TokenAllowlist  []common.Address
+
+	// batching configs, batching is disabled if the batch processor address is not set.
+	BatchProcessorAddress common.Address
+	BatchWindow           uint64
+	BatchMaxSize          uint64
+
+	// QueueName overrides the default queue name of the route if set.
+	QueueName string
+	// Routes are the additional (source, destination) routes served by the processor.
+	Routes []*Config
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -103,7 +128,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		destQuotaManagerAddress = common.HexToAddress(c.String(flags.DestQuotaManagerAddress.Name))
 	}
 
-	return &Config{
+	cfg := &Config{
 		ProcessorPrivateKey:                processorPrivateKey,
 		SrcSignalServiceAddress:            common.HexToAddress(c.String(flags.SrcSignalServiceAddress.Name)),
 		DestTaikoAddress:                   common.HexToAddress(c.String(flags.DestTaikoAddress.Name)),
@@ -123,6 +148,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		QueuePassword:                      c.String(flags.QueuePassword.Name),
 		QueuePort:                          c.Uint64(flags.QueuePort.Name),
 		QueueHost:                          c.String(flags.QueueHost.Name),
+		QueueDriver:                        c.String(flags.QueueDriver.Name),
 		QueuePrefetch:                      c.Uint64(flags.QueuePrefetchCount.Name),
 		SrcRPCUrl:                          c.String(flags.SrcRPCUrl.Name),
 		DestRPCUrl:                         c.String(flags.DestRPCUrl.Name),
@@ -141,8 +167,19 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 			processorPrivateKey,
 			c,
 		),
-		MaxMessageRetries: c.Uint64(flags.MaxMessageRetries.Name),
-		MinFeeToProcess:   c.Uint64(flags.MinFeeToProcess.Name),
+		MaxMessageRetries:        c.Uint64(flags.MaxMessageRetries.Name),
+		MinFeeToProcess:          c.Uint64(flags.MinFeeToProcess.Name),
+		RetryMessages:            c.Bool(flags.RetryMessages.Name),
+		RetryMessagesMaxAttempts: c.Uint64(flags.RetryMessagesMaxAttempts.Name),
+		RetryMessagesInterval:    c.Uint64(flags.RetryMessagesInterval.Name),
+		RetryMessagesMaxGasLimit: c.Uint64(flags.RetryMessagesMaxGasLimit.Name),
+		RetryMessagesMaxGasPrice: c.Uint64(flags.RetryMessagesMaxGasPrice.Name),
+		RecallCandidates:         c.Bool(flags.RecallCandidates.Name),
+		DestSignalServiceAddress: common.HexToAddress(c.String(flags.DestSignalServiceAddress.Name)),
+		ProfitMarginBps:          c.Uint64(flags.ProfitMarginBps.Name),
+		BatchProcessorAddress:    common.HexToAddress(c.String(flags.BatchProcessorAddress.Name)),
+		BatchWindow:              c.Uint64(flags.BatchWindow.Name),
+		BatchMaxSize:             c.Uint64(flags.BatchMaxSize.Name),
 		OpenDBFunc: func() (db.DB, error) {
 			return db.OpenDBConnection(db.DBConnectionOpts{
 				Name:            c.String(flags.DatabaseUsername.Name),
@@ -164,21 +201,36 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 				},
 			})
 		},
-		OpenQueueFunc: func() (queue.Queue, error) {
-			opts := queue.NewQueueOpts{
-				Username:      c.String(flags.QueueUsername.Name),
-				Password:      c.String(flags.QueuePassword.Name),
-				Host:          c.String(flags.QueueHost.Name),
-				Port:          c.String(flags.QueuePort.Name),
-				PrefetchCount: c.Uint64(flags.QueuePrefetchCount.Name),
-			}
+	}
 
-			q, err := rabbitmq.NewQueue(opts)
-			if err != nil {
-				return nil, err
+	if c.IsSet(flags.TokenAllowlist.Name) {
+		for _, token := range strings.Split(c.String(flags.TokenAllowlist.Name), ",") {
+			token = strings.TrimSpace(token)
+			if !common.IsHexAddress(token) {
+				return nil, fmt.Errorf("invalid %s address: %s", flags.TokenAllowlist.Name, token)
 			}
 
-			return q, nil
-		},
-	}, nil
+			cfg.TokenAllowlist = append(cfg.TokenAllowlist, common.HexToAddress(token))
+		}
+	}
+
+	if cfg.BatchMaxSize == 0 {
+		return nil, fmt.Errorf("%s must be greater than 0", flags.BatchMaxSize.Name)
+	}
+
+	if cfg.OpenQueueFunc, err = pkgFlags.InitOpenQueueFuncFromCli(c, cfg.OpenDBFunc); err != nil {
+		return nil, err
+	}
+
+	if c.IsSet(flags.RoutesFile.Name) {
+		if targetTxHash != nil {
+			return nil, fmt.Errorf("%s can not be used with %s", flags.RoutesFile.Name, flags.TargetTxHash.Name)
+		}
+
+		if cfg.Routes, err = newRouteConfigs(c, cfg, c.String(flags.RoutesFile.Name)); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
 }
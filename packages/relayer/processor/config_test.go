@@ -1,12 +1,15 @@
 package processor
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
 
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/cmd/flags"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
@@ -73,6 +76,14 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		assert.Equal(t, true, c.ProfitableOnly)
 		assert.Equal(t, uint64(100), c.QueuePrefetch)
 		assert.Equal(t, true, c.EnableTaikoL2)
+		assert.Equal(t, uint64(500), c.ProfitMarginBps)
+		assert.Equal(t, []common.Address{
+			relayer.ZeroAddress,
+			common.HexToAddress(destBridgeAddr),
+		}, c.TokenAllowlist)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), c.BatchProcessorAddress)
+		assert.Equal(t, uint64(2), c.BatchWindow)
+		assert.Equal(t, uint64(10), c.BatchMaxSize)
 
 		c.OpenDBFunc = func() (db.DB, error) {
 			return &mock.DB{}, nil
@@ -120,6 +131,9 @@ func TestNewConfigFromCliContext(t *testing.T) {
 		"--" + flags.ProfitableOnly.Name,
 		"--" + flags.EnableTaikoL2.Name,
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
+		"--" + flags.ProfitMarginBps.Name, "500",
+		"--" + flags.TokenAllowlist.Name, relayer.ZeroAddress.Hex() + ", " + destBridgeAddr,
+		"--" + flags.BatchProcessorAddress.Name, destBridgeAddr,
 	}))
 }
 
@@ -147,3 +161,126 @@ func TestNewConfigFromCliContext_PrivKeyError(t *testing.T) {
 		"--" + flags.DestQuotaManagerAddress.Name, destQuotaManagerAddr,
 	}), "invalid processorPrivateKey")
 }
+
+func TestNewConfigFromCliContext_Routes(t *testing.T) {
+	routesFile := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(routesFile, []byte(`[{
+		"srcRpcUrl": "routeSrcRpcUrl",
+		"destRpcUrl": "routeDestRpcUrl",
+		"srcSignalServiceAddress": "`+srcSignalServiceAddr+`",
+		"destBridgeAddress": "`+destBridgeAddr+`",
+		"destERC20VaultAddress": "`+destBridgeAddr+`",
+		"enableTaikoL2": true,
+		"queueName": "route-queue"
+	}]`), 0o600))
+
+	app := setupApp()
+
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, c.Routes, 1)
+
+		route := c.Routes[0]
+		assert.Equal(t, "routeSrcRpcUrl", route.SrcRPCUrl)
+		assert.Equal(t, "routeDestRpcUrl", route.DestRPCUrl)
+		assert.Equal(t, "routeDestRpcUrl", route.TxmgrConfigs.L1RPCURL)
+		assert.Equal(t, "destRpcUrl", c.TxmgrConfigs.L1RPCURL)
+		assert.Equal(t, common.HexToAddress(destBridgeAddr), route.DestBridgeAddress)
+		assert.Equal(t, common.Address{}, route.DestERC721VaultAddress)
+		assert.Equal(t, c.ProcessorPrivateKey, route.ProcessorPrivateKey)
+		assert.Equal(t, "route-queue", route.QueueName)
+		assert.Equal(t, "dbname", route.DatabaseName)
+		assert.True(t, route.EnableTaikoL2)
+		assert.Empty(t, route.Routes)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run([]string{
+		"TestNewConfigFromCliContext_Routes",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueUsername.Name, "queuename",
+		"--" + flags.QueuePassword.Name, "queuepassword",
+		"--" + flags.QueueHost.Name, "queuehost",
+		"--" + flags.QueuePort.Name, "5555",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC721VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC20VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC1155VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestTaikoAddress.Name, destBridgeAddr,
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.RoutesFile.Name, routesFile,
+	}))
+}
+
+func TestNewConfigFromCliContext_InvalidRoute(t *testing.T) {
+	routesFile := filepath.Join(t.TempDir(), "routes.json")
+	assert.Nil(t, os.WriteFile(routesFile, []byte(`[{"srcRpcUrl": "routeSrcRpcUrl"}]`), 0o600))
+
+	app := setupApp()
+	assert.ErrorContains(t, app.Run([]string{
+		"TestNewConfigFromCliContext_InvalidRoute",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.QueueUsername.Name, "queuename",
+		"--" + flags.QueuePassword.Name, "queuepassword",
+		"--" + flags.QueueHost.Name, "queuehost",
+		"--" + flags.QueuePort.Name, "5555",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC721VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC20VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC1155VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestTaikoAddress.Name, destBridgeAddr,
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+		"--" + flags.RoutesFile.Name, routesFile,
+	}), "invalid route 0: destRpcUrl not provided")
+}
+
+func TestNewConfigFromCliContext_QueueDriver(t *testing.T) {
+	args := []string{
+		"TestNewConfigFromCliContext_QueueDriver",
+		"--" + flags.DatabaseUsername.Name, "dbuser",
+		"--" + flags.DatabasePassword.Name, "dbpass",
+		"--" + flags.DatabaseHost.Name, "dbhost",
+		"--" + flags.DatabaseName.Name, "dbname",
+		"--" + flags.SrcRPCUrl.Name, "srcRpcUrl",
+		"--" + flags.DestRPCUrl.Name, "destRpcUrl",
+		"--" + flags.DestBridgeAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC721VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC20VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestERC1155VaultAddress.Name, destBridgeAddr,
+		"--" + flags.DestTaikoAddress.Name, destBridgeAddr,
+		"--" + flags.ProcessorPrivateKey.Name, dummyEcdsaKey,
+	}
+
+	// the rabbitmq driver still needs the broker connection flags.
+	assert.ErrorContains(t, setupApp().Run(args), "queue.username is required by the rabbitmq queue driver")
+
+	assert.ErrorContains(
+		t,
+		setupApp().Run(append(args, "--"+flags.QueueDriver.Name, "kafka")),
+		"unsupported queue driver: kafka",
+	)
+
+	app := setupApp()
+	app.Action = func(ctx *cli.Context) error {
+		c, err := NewConfigFromCliContext(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, queue.DriverMySQL, c.QueueDriver)
+		assert.NotNil(t, c.OpenQueueFunc)
+
+		return err
+	}
+
+	assert.Nil(t, app.Run(append(args, "--"+flags.QueueDriver.Name, queue.DriverMySQL)))
+}
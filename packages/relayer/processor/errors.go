@@ -15,6 +15,8 @@ var (
 
 	errTxReverted = errors.New("tx reverted")
 
+	errBatchedCallFailed = errors.New("batched processMessage call failed")
+
 	// FallbackGasTipCap is the default fallback gasTipCap used when we are
 	// unable to query an L1 backend for a suggested gasTipCap.
 	FallbackGasTipCap = big.NewInt(1500000000)
@@ -5,8 +5,12 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/pkg/errors"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
 )
 
 var (
@@ -15,57 +19,70 @@ var (
 
 // isProfitable determines whether a message is profitable or not. It should
 // check the processing fee, if one does not exist at all, it is definitely not
-// profitable. Otherwise, we compare it to the estimated cost.
+// profitable. Otherwise, we compare it, less the profit margin, to the estimated cost:
+// the simulated gas used, or the padded gas limit if the message was not simulated,
+// plus the cost of posting the calldata to L1.
 func (p *Processor) isProfitable(
 	ctx context.Context,
 	id int,
-	fee uint64,
-	gasLimit uint64,
-	destChainBaseFee uint64,
-	gasTipCap uint64,
+	evaluation *profitabilityEvaluation,
 ) (bool, error) {
-	var shouldProcess = false
-
-	if fee == 0 || gasLimit == 0 {
+	if evaluation.Fee == 0 || evaluation.GasLimit == 0 {
 		slog.Info("unprofitable: no gasLimit or processingFee",
-			"processingFee", fee,
-			"gasLimit", gasLimit,
+			"processingFee", evaluation.Fee,
+			"gasLimit", evaluation.GasLimit,
 		)
 
-		return shouldProcess, errImpossible
+		return false, errImpossible
 	}
 
-	// If processing fee covers baseFee * 2 + gasTipCap for the padded gas limit,
-	// we should process.
-	estimatedOnchainFee := ((destChainBaseFee * 2) + gasTipCap) * uint64(gasLimit)
-	if fee >= estimatedOnchainFee {
-		shouldProcess = true
+	// If processing fee covers baseFee * 2 + gasTipCap for the gas used,
+	// with the profit margin on top, we should process.
+	gasPrice := (evaluation.DestChainBaseFee * 2) + evaluation.GasTipCap
+
+	gasUsed := evaluation.EstimatedGasUsed
+	if gasUsed == 0 {
+		gasUsed = evaluation.GasLimit
 	}
 
+	evaluation.DeclaredOnchainFee = gasPrice * evaluation.GasLimit
+	evaluation.EstimatedOnchainFee = gasPrice*gasUsed + evaluation.DataCost
+
+	// a message using more gas than its padded gas limit fails when processed.
+	evaluation.IsProfitable = gasUsed <= evaluation.GasLimit &&
+		evaluation.Fee >= withProfitMargin(evaluation.EstimatedOnchainFee, p.profitMarginBps)
+
 	slog.Info("isProfitable",
-		"processingFee", fee,
-		"destChainBaseFee", destChainBaseFee,
-		"gasTipCap", gasTipCap,
-		"gasLimit", gasLimit,
-		"shouldProcess", shouldProcess,
-		"estimatedOnchainFee", estimatedOnchainFee,
+		"processingFee", evaluation.Fee,
+		"destChainBaseFee", evaluation.DestChainBaseFee,
+		"gasTipCap", evaluation.GasTipCap,
+		"gasLimit", evaluation.GasLimit,
+		"estimatedGasUsed", evaluation.EstimatedGasUsed,
+		"dataCost", evaluation.DataCost,
+		"profitMarginBps", p.profitMarginBps,
+		"shouldProcess", evaluation.IsProfitable,
+		"declaredOnchainFee", evaluation.DeclaredOnchainFee,
+		"estimatedOnchainFee", evaluation.EstimatedOnchainFee,
 	)
 
 	opts := relayer.UpdateFeesAndProfitabilityOpts{
-		Fee:                     fee,
-		DestChainBaseFee:        destChainBaseFee,
-		GasTipCap:               gasTipCap,
-		GasLimit:                gasLimit,
-		IsProfitable:            shouldProcess,
-		EstimatedOnchainFee:     estimatedOnchainFee,
+		Fee:                     evaluation.Fee,
+		DestChainBaseFee:        evaluation.DestChainBaseFee,
+		GasTipCap:               evaluation.GasTipCap,
+		GasLimit:                evaluation.GasLimit,
+		IsProfitable:            evaluation.IsProfitable,
+		EstimatedOnchainFee:     evaluation.EstimatedOnchainFee,
 		IsProfitableEvaluatedAt: time.Now().UTC(),
+		DeclaredOnchainFee:      evaluation.DeclaredOnchainFee,
+		SimulatedGasUsed:        evaluation.EstimatedGasUsed,
+		DataCost:                evaluation.DataCost,
 	}
 
 	if err := p.eventRepo.UpdateFeesAndProfitability(ctx, id, &opts); err != nil {
 		slog.Error("failed to update event", "error", err)
 	}
 
-	if !shouldProcess {
+	if !evaluation.IsProfitable {
 		relayer.UnprofitableMessagesDetected.Inc()
 
 		return false, nil
@@ -73,3 +90,104 @@ func (p *Processor) isProfitable(
 
 	return true, nil
 }
+
+// simulateProcessMessage estimates the gas used by the processMessage call, its calldata
+// including the signal proof, and the cost of posting the calldata to L1. Messages without a
+// processing fee can never be profitable, so they are not simulated.
+func (p *Processor) simulateProcessMessage(
+	ctx context.Context,
+	data []byte,
+	evaluation *profitabilityEvaluation,
+) error {
+	if evaluation.Fee == 0 {
+		return nil
+	}
+
+	gasUsed, err := p.destEthClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: p.relayerAddr,
+		To:   &p.cfg.DestBridgeAddress,
+		Data: data,
+	})
+	if err != nil {
+		return errors.Wrap(err, "p.destEthClient.EstimateGas")
+	}
+
+	l1BaseFee, err := p.l1BaseFee(ctx)
+	if err != nil {
+		return errors.Wrap(err, "p.l1BaseFee")
+	}
+
+	evaluation.EstimatedGasUsed = gasUsed
+	evaluation.DataCost = calldataGas(data) * l1BaseFee
+
+	return nil
+}
+
+// l1BaseFee returns the base fee the calldata posted to L1 is priced at. The calldata of
+// the transactions is only posted to L1 on the routes to Taiko, where the source chain is L1.
+func (p *Processor) l1BaseFee(ctx context.Context) (uint64, error) {
+	if p.taikoL2 == nil {
+		return 0, nil
+	}
+
+	header, err := p.srcEthClient.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if header.BaseFee == nil {
+		return 0, nil
+	}
+
+	return header.BaseFee.Uint64(), nil
+}
+
+// isTokenAllowed returns whether the token bridged by the message is in the token allowlist,
+// ETH being the zero address. All tokens are allowed when the allowlist is empty.
+func (p *Processor) isTokenAllowed(event *bridge.BridgeMessageSent) (bool, error) {
+	if p.tokenAllowlist == nil {
+		return true, nil
+	}
+
+	eventType, canonicalToken, _, err := relayer.DecodeMessageData(event.Message.Data, event.Message.Value)
+	if err != nil {
+		return false, errors.Wrap(err, "relayer.DecodeMessageData")
+	}
+
+	token := relayer.ZeroAddress
+	if eventType != relayer.EventTypeSendETH {
+		token = canonicalToken.Address()
+	}
+
+	if _, ok := p.tokenAllowlist[token]; !ok {
+		slog.Info("unprofitable: token not allowed",
+			"msgHash", common.Hash(event.MsgHash).Hex(),
+			"token", token.Hex(),
+		)
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// calldataGas returns the gas charged for the calldata of a transaction.
+func calldataGas(data []byte) uint64 {
+	var gas uint64
+
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+
+	return gas
+}
+
+// withProfitMargin returns the cost increased by the margin in basis points, split so the
+// multiplication does not overflow.
+func withProfitMargin(cost uint64, marginBps uint64) uint64 {
+	return cost + cost/10000*marginBps + cost%10000*marginBps/10000
+}
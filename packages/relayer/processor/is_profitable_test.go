@@ -4,27 +4,36 @@ import (
 	"context"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
 )
 
 func Test_isProfitable(t *testing.T) {
 	p := newTestProcessor(true)
 
 	tests := []struct {
-		id             int
-		name           string
-		fee            uint64
-		gasLimit       uint64
-		baseFee        uint64
-		gasTipCap      uint64
-		wantProfitable bool
-		wantErr        error
+		id               int
+		name             string
+		fee              uint64
+		gasLimit         uint64
+		estimatedGasUsed uint64
+		dataCost         uint64
+		profitMarginBps  uint64
+		baseFee          uint64
+		gasTipCap        uint64
+		wantProfitable   bool
+		wantErr          error
 	}{
 		{
 			0,
 			"zeroProcessingFee",
 			0,
 			1,
+			0,
+			0,
+			0,
 			1,
 			1,
 			false,
@@ -35,6 +44,9 @@ func Test_isProfitable(t *testing.T) {
 			"profitable",
 			7000000000600001,
 			600000,
+			0,
+			0,
+			0,
 			1000000000,
 			1,
 			true,
@@ -45,6 +57,9 @@ func Test_isProfitable(t *testing.T) {
 			"profitableAtEstimatedCost",
 			1200000000600000,
 			600000,
+			0,
+			0,
+			0,
 			1000000000,
 			1,
 			true,
@@ -55,6 +70,74 @@ func Test_isProfitable(t *testing.T) {
 			"unprofitable",
 			590000000600000,
 			600000,
+			0,
+			0,
+			0,
+			1000000000,
+			1,
+			false,
+			nil,
+		},
+		{
+			4,
+			"profitableAtSimulatedGasUsed",
+			590000000600000,
+			600000,
+			200000,
+			0,
+			0,
+			1000000000,
+			1,
+			true,
+			nil,
+		},
+		{
+			5,
+			"unprofitableWithDataCost",
+			590000000600000,
+			600000,
+			200000,
+			200000000000000,
+			0,
+			1000000000,
+			1,
+			false,
+			nil,
+		},
+		{
+			6,
+			"unprofitableWithProfitMargin",
+			440000000000000,
+			600000,
+			200000,
+			0,
+			1500,
+			1000000000,
+			0,
+			false,
+			nil,
+		},
+		{
+			7,
+			"profitableWithProfitMargin",
+			460000000000000,
+			600000,
+			200000,
+			0,
+			1500,
+			1000000000,
+			0,
+			true,
+			nil,
+		},
+		{
+			8,
+			"unprofitableAboveGasLimit",
+			7000000000600001,
+			600000,
+			600001,
+			0,
+			0,
 			1000000000,
 			1,
 			false,
@@ -64,13 +147,19 @@ func Test_isProfitable(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			p.profitMarginBps = tt.profitMarginBps
+
 			profitable, err := p.isProfitable(
 				context.Background(),
 				tt.id,
-				tt.fee,
-				tt.gasLimit,
-				tt.baseFee,
-				tt.gasTipCap,
+				&profitabilityEvaluation{
+					Fee:              tt.fee,
+					GasLimit:         tt.gasLimit,
+					DestChainBaseFee: tt.baseFee,
+					GasTipCap:        tt.gasTipCap,
+					EstimatedGasUsed: tt.estimatedGasUsed,
+					DataCost:         tt.dataCost,
+				},
 			)
 
 			assert.Equal(t, tt.wantProfitable, profitable)
@@ -78,3 +167,54 @@ func Test_isProfitable(t *testing.T) {
 		})
 	}
 }
+
+func Test_isTokenAllowed(t *testing.T) {
+	p := newTestProcessor(true)
+	event := newProcessMessageEvent(1)
+
+	allowed, err := p.isTokenAllowed(event)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+
+	p.tokenAllowlist = map[common.Address]struct{}{
+		common.HexToAddress("0x1"): {},
+	}
+
+	allowed, err = p.isTokenAllowed(event)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+
+	// messages without data bridge ETH, which is the zero address.
+	p.tokenAllowlist[relayer.ZeroAddress] = struct{}{}
+
+	allowed, err = p.isTokenAllowed(event)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+}
+
+func Test_simulateProcessMessage(t *testing.T) {
+	p := newTestProcessor(true)
+
+	evaluation := &profitabilityEvaluation{Fee: 1}
+	assert.Nil(t, p.simulateProcessMessage(context.Background(), []byte{0, 1}, evaluation))
+	assert.Equal(t, uint64(1), evaluation.EstimatedGasUsed)
+	// the calldata is only posted to L1 on the routes to Taiko.
+	assert.Equal(t, uint64(0), evaluation.DataCost)
+
+	evaluation = &profitabilityEvaluation{}
+	assert.Nil(t, p.simulateProcessMessage(context.Background(), []byte{0, 1}, evaluation))
+	assert.Equal(t, uint64(0), evaluation.EstimatedGasUsed)
+}
+
+func Test_calldataGas(t *testing.T) {
+	assert.Equal(t, uint64(0), calldataGas(nil))
+	assert.Equal(t, uint64(4+16+16), calldataGas([]byte{0, 1, 0xff}))
+}
+
+func Test_withProfitMargin(t *testing.T) {
+	assert.Equal(t, uint64(100), withProfitMargin(100, 0))
+	assert.Equal(t, uint64(115), withProfitMargin(100, 1500))
+	assert.Equal(t, uint64(3000000000), withProfitMargin(1000000000, 20000))
+	// the margin of a large cost does not overflow.
+	assert.Equal(t, uint64(2000000000000000002), withProfitMargin(1000000000000000001, 10000))
+}
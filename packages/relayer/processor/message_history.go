@@ -0,0 +1,126 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+)
+
+// profitabilityEvaluation is the data of a ProfitabilityEvaluated timeline entry.
+type profitabilityEvaluation struct {
+	Fee                 uint64 `json:"fee"`
+	GasLimit            uint64 `json:"gasLimit"`
+	DestChainBaseFee    uint64 `json:"destChainBaseFee"`
+	GasTipCap           uint64 `json:"gasTipCap"`
+	EstimatedGasUsed    uint64 `json:"estimatedGasUsed,omitempty"`
+	DataCost            uint64 `json:"dataCost,omitempty"`
+	DeclaredOnchainFee  uint64 `json:"declaredOnchainFee"`
+	EstimatedOnchainFee uint64 `json:"estimatedOnchainFee"`
+	IsProfitable        bool   `json:"isProfitable"`
+}
+
+// saveMessageHistory appends an entry to the lifecycle timeline of a message. The timeline
+// is informational, failing to save it does not fail the processing of the message.
+func (p *Processor) saveMessageHistory(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+	opts relayer.SaveMessageHistoryOpts,
+) {
+	opts.MsgHash = common.Hash(event.MsgHash).Hex()
+	opts.ChainID = new(big.Int).SetUint64(event.Message.SrcChainId)
+	opts.DestChainID = new(big.Int).SetUint64(event.Message.DestChainId)
+
+	if _, err := p.messageHistoryRepo.Save(ctx, &opts); err != nil {
+		slog.Warn("failed to save message history",
+			"msgHash", opts.MsgHash,
+			"kind", opts.Kind,
+			"error", err,
+		)
+	}
+}
+
+// saveProfitabilityEvaluation appends a ProfitabilityEvaluated entry to the timeline of a message.
+func (p *Processor) saveProfitabilityEvaluation(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+	evaluation profitabilityEvaluation,
+) {
+	data, err := json.Marshal(evaluation)
+	if err != nil {
+		slog.Warn("failed to marshal profitability evaluation", "error", err)
+		return
+	}
+
+	p.saveMessageHistory(ctx, event, relayer.SaveMessageHistoryOpts{
+		Kind: relayer.MessageHistoryKindProfitabilityEvaluated,
+		Data: string(data),
+	})
+}
+
+// saveReceiptHistory appends an entry for a mined transaction to the timeline of a message,
+// txErr is set when the transaction did not have the intended effect.
+func (p *Processor) saveReceiptHistory(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+	kind string,
+	receipt *types.Receipt,
+	txErr error,
+) {
+	opts := relayer.SaveMessageHistoryOpts{
+		Kind:   kind,
+		TxHash: receipt.TxHash.Hex(),
+	}
+
+	if receipt.BlockNumber != nil {
+		opts.BlockID = receipt.BlockNumber.Uint64()
+	}
+
+	if txErr != nil {
+		opts.Error = txErr.Error()
+	}
+
+	p.saveMessageHistory(ctx, event, opts)
+}
+
+// saveMessageProcessedHistory appends the MessageProcessed entry of a processing transaction to
+// the timeline of a message, with the status the message was left in. The indexer saves the
+// same entry when it indexes the MessageProcessed event, so it is only saved once.
+func (p *Processor) saveMessageProcessedHistory(
+	ctx context.Context,
+	event *bridge.BridgeMessageSent,
+	receipt *types.Receipt,
+) {
+	opts := relayer.SaveMessageHistoryOpts{
+		MsgHash:     common.Hash(event.MsgHash).Hex(),
+		Kind:        relayer.MessageHistoryKindMessageProcessed,
+		ChainID:     new(big.Int).SetUint64(event.Message.SrcChainId),
+		DestChainID: new(big.Int).SetUint64(event.Message.DestChainId),
+		TxHash:      receipt.TxHash.Hex(),
+	}
+
+	if receipt.BlockNumber != nil {
+		opts.BlockID = receipt.BlockNumber.Uint64()
+	}
+
+	status, err := messageStatusFromReceipt(receipt, event.MsgHash)
+	if err != nil {
+		slog.Warn("failed to read the message status of the receipt", "msgHash", opts.MsgHash, "error", err)
+	}
+
+	opts.Status = status
+
+	if _, err := p.messageHistoryRepo.SaveOnce(ctx, &opts); err != nil {
+		slog.Warn("failed to save message history",
+			"msgHash", opts.MsgHash,
+			"kind", opts.Kind,
+			"error", err,
+		)
+	}
+}
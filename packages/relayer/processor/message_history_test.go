@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_saveMessageHistory(t *testing.T) {
+	p := newTestProcessor(true)
+	event := newProcessMessageEvent(1)
+	msgHash := common.Hash(event.MsgHash).Hex()
+
+	p.saveProfitabilityEvaluation(context.Background(), event, profitabilityEvaluation{
+		Fee:          1,
+		GasLimit:     2,
+		IsProfitable: true,
+	})
+	p.saveReceiptHistory(
+		context.Background(),
+		event,
+		relayer.MessageHistoryKindProcessingAttempt,
+		&types.Receipt{TxHash: common.HexToHash("0x1")},
+		errTxReverted,
+	)
+
+	receipt := &types.Receipt{
+		TxHash:      common.HexToHash("0x2"),
+		BlockNumber: big.NewInt(5),
+		Logs:        []*types.Log{messageStatusChangedLog(t, relayer.EventStatusDone)},
+	}
+
+	// the entry is saved once, as the indexer may have saved it already.
+	p.saveMessageProcessedHistory(context.Background(), event, receipt)
+	p.saveMessageProcessedHistory(context.Background(), event, receipt)
+
+	history, err := p.messageHistoryRepo.FindAllByMsgHash(context.Background(), msgHash)
+	assert.Nil(t, err)
+	assert.Len(t, history, 3)
+
+	assert.Equal(t, relayer.MessageHistoryKindProfitabilityEvaluated, history[0].Kind)
+	assert.JSONEq(t,
+		`{"fee":1,"gasLimit":2,"destChainBaseFee":0,"gasTipCap":0,`+
+			`"declaredOnchainFee":0,"estimatedOnchainFee":0,"isProfitable":true}`,
+		string(history[0].Data),
+	)
+	assert.Equal(t, int64(mock.MockChainID.Uint64()), history[0].ChainID)
+
+	assert.Equal(t, relayer.MessageHistoryKindProcessingAttempt, history[1].Kind)
+	assert.Equal(t, errTxReverted.Error(), history[1].Error)
+	assert.Equal(t, uint64(0), history[1].BlockID)
+
+	assert.Equal(t, relayer.MessageHistoryKindMessageProcessed, history[2].Kind)
+	assert.Equal(t, common.HexToHash("0x2").Hex(), history[2].TxHash)
+	assert.Equal(t, uint64(5), history[2].BlockID)
+	assert.Empty(t, history[2].Error)
+
+	if assert.NotNil(t, history[2].Status) {
+		assert.Equal(t, relayer.EventStatusDone, *history[2].Status)
+	}
+}
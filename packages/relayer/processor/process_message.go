@@ -12,7 +12,6 @@ import (
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -130,6 +129,17 @@ func (p *Processor) processMessage(
 		return false, msgBody.TimesRetried, errors.Wrap(err, "p.eventStatusFromMsgHash")
 	}
 
+	// messages processed before, either by us or someone else, can still be
+	// retried or recalled if enabled.
+	switch {
+	case eventStatus == relayer.EventStatusRetriable && p.retryMessages:
+		shouldRequeue, err := p.retryMessage(ctx, msg, msgBody)
+
+		return shouldRequeue, msgBody.TimesRetried, err
+	case eventStatus == relayer.EventStatusFailed && p.recallCandidates:
+		return false, msgBody.TimesRetried, p.saveRecallCandidate(ctx, msgBody)
+	}
+
 	if !canProcessMessage(
 		ctx,
 		eventStatus,
@@ -144,6 +154,25 @@ func (p *Processor) processMessage(
 		return false, msgBody.TimesRetried, err
 	}
 
+	// a reorg can orphan the message after it was queued, the indexer queues the canonical
+	// one when it reindexes the blocks, so we acknowledge this one without processing it.
+	orphaned, err := p.isOrphaned(ctx, msgBody.Event.Raw)
+	if err != nil {
+		return false, msgBody.TimesRetried, errors.Wrap(err, "p.isOrphaned")
+	}
+
+	if orphaned {
+		slog.Warn("message orphaned by a reorg",
+			"msgHash", common.Hash(msgBody.Event.MsgHash).Hex(),
+			"srcTxHash", msgBody.Event.Raw.TxHash.Hex(),
+			"blockHash", msgBody.Event.Raw.BlockHash.Hex(),
+		)
+
+		relayer.OrphanedMessagesSkipped.Inc()
+
+		return false, msgBody.TimesRetried, nil
+	}
+
 	// check paused status
 	paused, err := p.destBridge.Paused(&bind.CallOpts{
 		Context: ctx,
@@ -232,6 +261,12 @@ func (p *Processor) processMessage(
 
 	if messageStatus == uint8(relayer.EventStatusRetriable) {
 		relayer.RetriableEvents.Inc()
+
+		if p.retryMessages {
+			shouldRequeue, err := p.retryMessage(ctx, msg, msgBody)
+
+			return shouldRequeue, msgBody.TimesRetried, err
+		}
 	} else if messageStatus == uint8(relayer.EventStatusDone) {
 		relayer.DoneEvents.Inc()
 	}
@@ -301,6 +336,12 @@ func (p *Processor) generateEncodedSignalProof(ctx context.Context,
 		return nil, err
 	}
 
+	// the message is provable against the header synced at latestBlockID.
+	p.saveMessageHistory(ctx, event, relayer.SaveMessageHistoryOpts{
+		Kind:    relayer.MessageHistoryKindHeaderSynced,
+		BlockID: latestBlockID,
+	})
+
 	return encodedSignalProof, nil
 }
 
@@ -364,51 +405,51 @@ func (p *Processor) sendProcessMessageCall(
 	var estimatedMaxCost uint64
 
 	if bool(p.profitableOnly) {
-		profitable, err := p.isProfitable(
-			ctx,
-			id,
-			event.Message.Fee,
-			gasLimit,
-			baseFee.Uint64(),
-			gasTipCap.Uint64(),
-		)
-		if err != nil || !profitable {
-			if err == errImpossible {
-				return nil, errImpossible
-			}
-
-			return nil, relayer.ErrUnprofitable
-		}
-
-		// now simulate the transaction and let's confirm it is profitable
-		auth, err := bind.NewKeyedTransactorWithChainID(p.ecdsaKey, p.destChainId)
+		allowed, err := p.isTokenAllowed(event)
 		if err != nil {
 			return nil, err
 		}
 
-		msg := ethereum.CallMsg{
-			From: auth.From,
-			To:   &p.cfg.DestBridgeAddress,
-			Data: data,
+		if !allowed {
+			relayer.UnprofitableMessagesDetected.Inc()
+
+			return nil, relayer.ErrUnprofitable
 		}
 
-		gasUsed, err := p.destEthClient.EstimateGas(ctx, msg)
-		if err != nil {
+		evaluation := profitabilityEvaluation{
+			Fee:              event.Message.Fee,
+			GasLimit:         gasLimit,
+			DestChainBaseFee: baseFee.Uint64(),
+			GasTipCap:        gasTipCap.Uint64(),
+		}
+
+		// simulate the transaction, the profitability is evaluated with the gas it uses
+		// rather than the gas limit declared by the message.
+		if err := p.simulateProcessMessage(ctx, data, &evaluation); err != nil {
 			return nil, err
 		}
 
 		slog.Info("estimatedGasUsed",
-			"gasUsed", gasUsed,
+			"gasUsed", evaluation.EstimatedGasUsed,
+			"dataCost", evaluation.DataCost,
 			"messageGasLimit", event.Message.GasLimit,
 			"paddedGasLimit", gasLimit,
 			"srcTxHash", event.Raw.TxHash.Hex(),
 		)
 
-		if gasUsed > gasLimit {
+		profitable, err := p.isProfitable(ctx, id, &evaluation)
+
+		p.saveProfitabilityEvaluation(ctx, event, evaluation)
+
+		if err != nil || !profitable {
+			if err == errImpossible {
+				return nil, errImpossible
+			}
+
 			return nil, relayer.ErrUnprofitable
 		}
 
-		estimatedMaxCost = gasUsed * ((baseFee.Uint64() * 2) + gasTipCap.Uint64())
+		estimatedMaxCost = evaluation.EstimatedOnchainFee
 	}
 
 	// we should check event status one more time, after we have waited for
@@ -438,9 +479,24 @@ func (p *Processor) sendProcessMessageCall(
 		GasLimit: gasLimit,
 	}
 
-	receipt, err := p.txmgr.Send(ctx, candidate)
+	var receipt *types.Receipt
+
+	// the batched messages share the transaction, and its cost, with the messages
+	// that were ready within the same batch window.
+	if p.batchCh != nil {
+		receipt, err = p.sendBatchedProcessMessageCall(ctx, event, data, gasLimit)
+	} else {
+		receipt, err = p.txmgr.Send(ctx, candidate)
+	}
+
 	if err != nil {
 		slog.Warn("Failed to send ProcessMessage transaction", "error", err.Error())
+
+		p.saveMessageHistory(ctx, event, relayer.SaveMessageHistoryOpts{
+			Kind:  relayer.MessageHistoryKindProcessingAttempt,
+			Error: err.Error(),
+		})
+
 		return nil, err
 	}
 
@@ -455,11 +511,15 @@ func (p *Processor) sendProcessMessageCall(
 			"srcTxHash", event.Raw.TxHash.Hex(),
 			"status", receipt.Status)
 
+		p.saveReceiptHistory(ctx, event, relayer.MessageHistoryKindProcessingAttempt, receipt, errTxReverted)
+
 		return nil, errTxReverted
 	}
 
 	relayer.MessageSentEventsProcessed.Inc()
 
+	var relayerFee *big.Int
+
 	if p.profitableOnly {
 		if receipt.EffectiveGasPrice == nil {
 			relayer.AfterTransactingProfitabilityEvaluationErrors.Inc()
@@ -470,7 +530,7 @@ func (p *Processor) sendProcessMessageCall(
 		} else {
 			cost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
 
-			relayerFee, err := p.relayerFeeFromReceipt(ctx, receipt, event)
+			relayerFee, err = p.relayerFeeFromReceipt(ctx, receipt, event)
 			if err != nil {
 				relayer.AfterTransactingProfitabilityEvaluationErrors.Inc()
 				slog.Warn("failed to determine relayer fee; skipping after-transacting profitability",
@@ -499,6 +559,10 @@ func (p *Processor) sendProcessMessageCall(
 		}
 	}
 
+	p.saveProcessingCost(ctx, id, receipt, relayerFee)
+
+	p.saveMessageProcessedHistory(ctx, event, receipt)
+
 	if err := p.saveMessageStatusChangedEvent(ctx, receipt, event); err != nil {
 		return nil, err
 	}
@@ -625,30 +689,12 @@ func (p *Processor) saveMessageStatusChangedEvent(
 	receipt *types.Receipt,
 	event *bridge.BridgeMessageSent,
 ) error {
-	bridgeAbi, err := abi.JSON(strings.NewReader(bridge.BridgeABI))
+	status, err := messageStatusFromReceipt(receipt, event.MsgHash)
 	if err != nil {
 		return err
 	}
 
-	m := make(map[string]interface{})
-
-	for _, log := range receipt.Logs {
-		if log == nil || len(log.Topics) == 0 {
-			continue
-		}
-
-		topic := log.Topics[0]
-		if topic == bridgeAbi.Events["MessageStatusChanged"].ID {
-			err = bridgeAbi.UnpackIntoMap(m, "MessageStatusChanged", log.Data)
-			if err != nil {
-				return err
-			}
-
-			break
-		}
-	}
-
-	if m["status"] != nil {
+	if status != nil {
 		// keep same format as other raw events
 		data := fmt.Sprintf(`{"Raw":{"transactionHash": "%v"}}`, receipt.TxHash.Hex())
 
@@ -658,7 +704,7 @@ func (p *Processor) saveMessageStatusChangedEvent(
 			EmittedBlockID: event.Raw.BlockNumber,
 			ChainID:        new(big.Int).SetUint64(event.Message.SrcChainId),
 			DestChainID:    new(big.Int).SetUint64(event.Message.DestChainId),
-			Status:         relayer.EventStatus(m["status"].(uint8)),
+			Status:         *status,
 			MsgHash:        common.Hash(event.MsgHash).Hex(),
 			MessageOwner:   event.Message.SrcOwner.Hex(),
 			Event:          relayer.EventNameMessageStatusChanged,
@@ -671,6 +717,46 @@ func (p *Processor) saveMessageStatusChangedEvent(
 	return nil
 }
 
+// messageStatusFromReceipt returns the status of the MessageStatusChanged event of the
+// message in a receipt, or nil when the receipt has none.
+func messageStatusFromReceipt(receipt *types.Receipt, msgHash [32]byte) (*relayer.EventStatus, error) {
+	bridgeAbi, err := abi.JSON(strings.NewReader(bridge.BridgeABI))
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]interface{})
+
+	for _, log := range receipt.Logs {
+		if log == nil || len(log.Topics) == 0 {
+			continue
+		}
+
+		// a batch receipt has the events of the other messages of the batch too.
+		if len(log.Topics) > 1 && log.Topics[1] != common.Hash(msgHash) {
+			continue
+		}
+
+		topic := log.Topics[0]
+		if topic == bridgeAbi.Events["MessageStatusChanged"].ID {
+			err = bridgeAbi.UnpackIntoMap(m, "MessageStatusChanged", log.Data)
+			if err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	if m["status"] == nil {
+		return nil, nil
+	}
+
+	status := relayer.EventStatus(m["status"].(uint8))
+
+	return &status, nil
+}
+
 // getBaseFee determines the baseFee on the dest chain
 func (p *Processor) getBaseFee(ctx context.Context) (*big.Int, error) {
 	destBlock, err := p.destEthClient.BlockByNumber(ctx, nil)
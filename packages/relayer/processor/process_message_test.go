@@ -85,6 +85,24 @@ func messageProcessedLog(
 		Data:    data,
 	}
 }
+
+func messageStatusChangedLog(t *testing.T, status relayer.EventStatus) *types.Log {
+	t.Helper()
+
+	bridgeABI, err := bridge.BridgeMetaData.GetAbi()
+	require.NoError(t, err)
+
+	messageStatusChanged := bridgeABI.Events["MessageStatusChanged"]
+	data, err := messageStatusChanged.Inputs.NonIndexed().Pack(uint8(status))
+	require.NoError(t, err)
+
+	return &types.Log{
+		Address: common.HexToAddress("0xC4279588B8dA563D264e286E2ee7CE8c244444d6"),
+		Topics:  []common.Hash{messageStatusChanged.ID, common.Hash(mock.SuccessMsgHash)},
+		Data:    data,
+	}
+}
+
 func newProcessMessageEvent(fee uint64) *bridge.BridgeMessageSent {
 	return &bridge.BridgeMessageSent{
 		MsgHash: mock.SuccessMsgHash,
@@ -415,7 +433,8 @@ func Test_ProcessMessage_unprofitable(t *testing.T) {
 				Topics: []common.Hash{
 					relayer.ZeroHash,
 				},
-				Data: []byte{0xff},
+				Data:      []byte{0xff},
+				BlockHash: (&types.Header{Number: big.NewInt(0)}).Hash(),
 			},
 		},
 		ID: 0,
@@ -464,6 +483,7 @@ func TestGenerateEncodedSignalProofUsesDestChainCheckpoint(t *testing.T) {
 
 	p := &Processor{
 		eventRepo:               repo,
+		messageHistoryRepo:      mock.NewMessageHistoryRepository(),
 		srcChainId:              big.NewInt(int64(srcChainID)),
 		destChainId:             big.NewInt(int64(destChainID)),
 		srcEthClient:            ethClient,
@@ -548,3 +568,24 @@ func processorHeaderWithBaseFee(baseFee *big.Int) *types.Header {
 
 	return &header
 }
+
+func Test_ProcessMessage_orphaned(t *testing.T) {
+	p := newTestProcessor(true)
+
+	event := newProcessMessageEvent(1)
+	event.Message.GasLimit = 600000
+	event.Raw.BlockHash = common.HexToHash("0x1234")
+
+	marshalled, err := json.Marshal(queue.QueueMessageSentBody{Event: event})
+	assert.Nil(t, err)
+
+	before := testutil.ToFloat64(relayer.OrphanedMessagesSkipped)
+
+	shouldRequeue, _, err := p.processMessage(context.Background(), queue.Message{Body: marshalled})
+
+	assert.Nil(t, err)
+	assert.False(t, shouldRequeue)
+
+	after := testutil.ToFloat64(relayer.OrphanedMessagesSkipped)
+	assert.Equal(t, float64(1), after-before)
+}
@@ -0,0 +1,46 @@
+package processor
+
+import (
+	"context"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+)
+
+// saveProcessingCost records the gas used and effective gas price of a processMessage
+// transaction on its MessageSent event, which the API uses to recommend processing fees.
+// Failing to record it does not fail the processing of the message.
+func (p *Processor) saveProcessingCost(
+	ctx context.Context,
+	id int,
+	receipt *types.Receipt,
+	relayerFee *big.Int,
+) {
+	// messages targeted via config flag are not stored as events.
+	if id == 0 || receipt.EffectiveGasPrice == nil || !receipt.EffectiveGasPrice.IsUint64() {
+		return
+	}
+
+	opts := &relayer.UpdateProcessingCostOpts{
+		GasUsed:           receipt.GasUsed,
+		EffectiveGasPrice: receipt.EffectiveGasPrice.Uint64(),
+		ProcessedAt:       time.Now().UTC(),
+	}
+
+	if relayerFee != nil && relayerFee.IsUint64() {
+		fee := relayerFee.Uint64()
+		opts.RelayerFee = &fee
+	}
+
+	if err := p.eventRepo.UpdateProcessingCost(ctx, id, opts); err != nil {
+		slog.Warn("failed to save processing cost",
+			"id", id,
+			"txHash", receipt.TxHash.Hex(),
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,45 @@
+package processor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+)
+
+func Test_saveProcessingCost(t *testing.T) {
+	repo := mock.NewEventRepository()
+	p := &Processor{eventRepo: repo}
+
+	event, err := repo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		ChainID:     big.NewInt(1),
+		DestChainID: mock.MockChainID,
+		EventType:   relayer.EventTypeSendERC20,
+	})
+	assert.Nil(t, err)
+
+	receipt := &types.Receipt{GasUsed: 150_000, EffectiveGasPrice: big.NewInt(20)}
+
+	// targeted messages are not stored, nothing is recorded.
+	p.saveProcessingCost(context.Background(), 0, receipt, nil)
+	assert.Nil(t, event.ProcessedAt)
+
+	p.saveProcessingCost(context.Background(), event.ID, receipt, big.NewInt(3_000_000))
+	assert.Equal(t, uint64(150_000), *event.ProcessedGasUsed)
+	assert.Equal(t, uint64(20), *event.ProcessedGasPrice)
+	assert.Equal(t, uint64(3_000_000), *event.ProcessedRelayerFee)
+
+	costs, err := repo.FindProcessingCosts(context.Background(), relayer.FindProcessingCostsOpts{
+		EventType:   relayer.EventTypeSendERC20,
+		DestChainID: mock.MockChainID.Uint64(),
+		Limit:       10,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []relayer.ProcessingCost{{GasUsed: 150_000, EffectiveGasPrice: 20}}, costs)
+}
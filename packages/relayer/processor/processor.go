@@ -64,7 +64,8 @@ type ethClient interface {
 type Processor struct {
 	cancel context.CancelFunc
 
-	eventRepo relayer.EventRepository
+	eventRepo          relayer.EventRepository
+	messageHistoryRepo relayer.MessageHistoryRepository
 
 	queue queue.Queue
 
@@ -124,6 +125,29 @@ type Processor struct {
 	// sending transactions. The profitability estimate floors the suggested tip
 	// at this value so it reflects what the tx manager actually pays.
 	minTipCap *big.Int
+
+	// retry and recall of the failed messages, the dest signal service and caller
+	// are only set when recall candidates are enabled.
+	retryMessages            bool
+	retryMessagesMaxAttempts uint64
+	retryMessagesInterval    time.Duration
+	recallCandidates         bool
+	destSignalService        relayer.SignalService
+	destCaller               relayer.Caller
+
+	// profitability configs, a nil allowlist allows all tokens.
+	profitMarginBps uint64
+	tokenAllowlist  map[common.Address]struct{}
+
+	// batching of the processMessage calls through a batch processor contract, batchCh is
+	// only set when batching is enabled.
+	batchProcessorAddress common.Address
+	batchWindow           time.Duration
+	batchMaxSize          int
+	batchCh               chan *batchedCall
+
+	// routes are the child processors serving the additional routes of the routes file.
+	routes []*Processor
 }
 
 // InitFromCli creates a new processor from a cli context
@@ -150,6 +174,11 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		return err
 	}
 
+	messageHistoryRepository, err := repo.NewMessageHistoryRepository(db)
+	if err != nil {
+		return err
+	}
+
 	srcRpcClient, err := rpc.Dial(cfg.SrcRPCUrl)
 	if err != nil {
 		return err
@@ -257,6 +286,28 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 		p.taikoL2 = taikoL2
 	}
 
+	if cfg.RecallCandidates {
+		if cfg.DestSignalServiceAddress == relayer.ZeroAddress {
+			return errors.New("destSignalServiceAddress not provided")
+		}
+
+		destSignalService, err := signalservice.NewSignalService(
+			cfg.DestSignalServiceAddress,
+			destEthClient,
+		)
+		if err != nil {
+			return err
+		}
+
+		destRpcClient, err := rpc.Dial(cfg.DestRPCUrl)
+		if err != nil {
+			return err
+		}
+
+		p.destSignalService = destSignalService
+		p.destCaller = destRpcClient
+	}
+
 	var q queue.Queue
 	if cfg.TargetTxHash == nil {
 		q, err = cfg.OpenQueueFunc()
@@ -285,6 +336,7 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 	p.prover = prover
 	p.eventRepo = eventRepository
+	p.messageHistoryRepo = messageHistoryRepository
 
 	p.srcEthClient = srcEthClient
 	p.destEthClient = destEthClient
@@ -300,6 +352,15 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 	p.relayerAddr = relayerAddr
 
 	p.profitableOnly = cfg.ProfitableOnly
+	p.profitMarginBps = cfg.ProfitMarginBps
+
+	if len(cfg.TokenAllowlist) > 0 {
+		p.tokenAllowlist = make(map[common.Address]struct{}, len(cfg.TokenAllowlist))
+
+		for _, token := range cfg.TokenAllowlist {
+			p.tokenAllowlist[token] = struct{}{}
+		}
+	}
 
 	p.queue = q
 
@@ -327,8 +388,27 @@ func InitFromConfig(ctx context.Context, p *Processor, cfg *Config) error {
 
 	p.minFeeToProcess = p.cfg.MinFeeToProcess
 
+	p.retryMessages = cfg.RetryMessages
+	p.retryMessagesMaxAttempts = cfg.RetryMessagesMaxAttempts
+	p.retryMessagesInterval = time.Duration(cfg.RetryMessagesInterval) * time.Second
+	p.recallCandidates = cfg.RecallCandidates
+
+	// a single targeted message has nothing to be batched with.
+	if cfg.BatchProcessorAddress != relayer.ZeroAddress && cfg.TargetTxHash == nil {
+		p.batchProcessorAddress = cfg.BatchProcessorAddress
+		p.batchWindow = time.Duration(cfg.BatchWindow) * time.Second
+		p.batchMaxSize = int(cfg.BatchMaxSize)
+		p.batchCh = make(chan *batchedCall)
+	}
+
 	slog.Info("minFeeToProcess", "minFeeToProcess", p.minFeeToProcess)
 
+	if len(cfg.Routes) > 0 {
+		if err := p.initRoutes(ctx, db); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -347,6 +427,15 @@ func (p *Processor) Close(ctx context.Context) {
 
 	p.wg.Wait()
 
+	// The routes share the db connection, so stop them before closing it.
+	for _, route := range p.routes {
+		if route.cancel != nil {
+			route.cancel()
+		}
+
+		route.wg.Wait()
+	}
+
 	// Close db connection.
 	if err := p.eventRepo.Close(); err != nil {
 		slog.Error("Failed to close db connection", "err", err)
@@ -397,6 +486,10 @@ func (p *Processor) Start() error {
 
 	go p.eventLoop(ctx)
 
+	if p.batchCh != nil {
+		go p.batchLoop(ctx)
+	}
+
 	go func() {
 		if err := backoff.Retry(func() error {
 			return utils.ScanBlocks(ctx, p.srcEthClient, &p.wg)
@@ -405,10 +498,20 @@ func (p *Processor) Start() error {
 		}
 	}()
 
+	for _, route := range p.routes {
+		if err := route.Start(); err != nil {
+			return fmt.Errorf("failed to start route %s: %w", route.queueName(), err)
+		}
+	}
+
 	return nil
 }
 
 func (p *Processor) queueName() string {
+	if p.cfg != nil && p.cfg.QueueName != "" {
+		return p.cfg.QueueName
+	}
+
 	return fmt.Sprintf("%v-%v-%v-queue", p.srcChainId.String(), p.destChainId.String(), relayer.EventNameMessageSent)
 }
 
@@ -539,5 +642,6 @@ func isTransientProcessMessageError(err error) bool {
 		strings.Contains(err.Error(), "timeout") ||
 		strings.Contains(err.Error(), "i/o") ||
 		strings.Contains(err.Error(), "connect") ||
-		strings.Contains(err.Error(), "failed to get tx into the mempool")
+		strings.Contains(err.Error(), "failed to get tx into the mempool") ||
+		errors.Is(err, errBatchedCallFailed)
 }
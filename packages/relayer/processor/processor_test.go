@@ -28,6 +28,7 @@ func newTestProcessor(profitableOnly bool) *Processor {
 
 	return &Processor{
 		eventRepo:                 &mock.EventRepository{},
+		messageHistoryRepo:        mock.NewMessageHistoryRepository(),
 		destBridge:                &mock.Bridge{},
 		srcEthClient:              &mock.EthClient{},
 		destEthClient:             &mock.EthClient{},
@@ -57,12 +58,13 @@ func newTestProcessor(profitableOnly bool) *Processor {
 }
 
 type recordingQueue struct {
-	publishErr     error
-	publishedBody  []byte
-	publishedQueue string
-	acked          int
-	nacked         int
-	requeued       bool
+	publishErr          error
+	publishedBody       []byte
+	publishedQueue      string
+	publishedExpiration *string
+	acked               int
+	nacked              int
+	requeued            bool
 }
 
 func (q *recordingQueue) Start(ctx context.Context, queueName string) error { return nil }
@@ -82,6 +84,7 @@ func (q *recordingQueue) Publish(
 ) error {
 	q.publishedQueue = queueName
 	q.publishedBody = msg
+	q.publishedExpiration = expiration
 
 	return q.publishErr
 }
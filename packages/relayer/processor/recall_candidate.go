@@ -0,0 +1,117 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/proof"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// recallCandidate is the data of a RecallCandidate event, it contains everything needed
+// to call `bridge.recallMessage` on the source chain.
+type recallCandidate struct {
+	Message bridge.IBridgeMessage `json:"Message"`
+	MsgHash string                `json:"MsgHash"`
+	// Proof is the proof of the failed message signal sent on the destination chain, valid
+	// on the source chain once the destination chain block ProofBlockID is synced there.
+	Proof        string `json:"Proof"`
+	ProofBlockID uint64 `json:"ProofBlockID"`
+}
+
+// saveRecallCandidate saves a Failed message as a RecallCandidate event, along with the
+// proof needed to recall it on the source chain, so its owner can find it through the
+// events API.
+func (p *Processor) saveRecallCandidate(ctx context.Context, msgBody *queue.QueueMessageSentBody) error {
+	event := msgBody.Event
+	msgHash := common.Hash(event.MsgHash).Hex()
+
+	existing, err := p.eventRepo.FirstByEventAndMsgHash(ctx, relayer.EventNameRecallCandidate, msgHash)
+	if err != nil {
+		return errors.Wrap(err, "p.eventRepo.FirstByEventAndMsgHash")
+	}
+
+	if existing != nil {
+		return nil
+	}
+
+	signal, err := p.destBridge.SignalForFailedMessage(&bind.CallOpts{Context: ctx}, event.MsgHash)
+	if err != nil {
+		return errors.Wrap(err, "p.destBridge.SignalForFailedMessage")
+	}
+
+	key, err := p.destSignalService.GetSignalSlot(
+		&bind.CallOpts{Context: ctx},
+		p.destChainId.Uint64(),
+		p.cfg.DestBridgeAddress,
+		signal,
+	)
+	if err != nil {
+		return errors.Wrap(err, "p.destSignalService.GetSignalSlot")
+	}
+
+	// the message is already Failed at the latest destination block, so the proof is valid
+	// once that block is synced to the source chain.
+	blockNum, err := p.destEthClient.BlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "p.destEthClient.BlockNumber")
+	}
+
+	synced, err := p.waitHeaderSynced(ctx, p.destEthClient, p.srcChainId.Uint64(), blockNum)
+	if err != nil {
+		return errors.Wrap(err, "p.waitHeaderSynced")
+	}
+
+	encodedSignalProof, err := p.prover.EncodedSignalProof(ctx, proof.SignalProofParams{
+		ChainID:              p.srcChainId,
+		SignalServiceAddress: p.cfg.DestSignalServiceAddress,
+		Blocker:              p.destEthClient,
+		Caller:               p.destCaller,
+		Key:                  key,
+		BlockNumber:          synced.BlockID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "p.prover.EncodedSignalProof")
+	}
+
+	data, err := json.Marshal(&recallCandidate{
+		Message:      event.Message,
+		MsgHash:      msgHash,
+		Proof:        hexutil.Encode(encodedSignalProof),
+		ProofBlockID: synced.BlockID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	if _, err := p.eventRepo.Save(ctx, &relayer.SaveEventOpts{
+		Name:           relayer.EventNameRecallCandidate,
+		Data:           string(data),
+		ChainID:        p.srcChainId,
+		DestChainID:    p.destChainId,
+		Status:         relayer.EventStatusFailed,
+		MsgHash:        msgHash,
+		MessageOwner:   event.Message.SrcOwner.Hex(),
+		Event:          relayer.EventNameRecallCandidate,
+		EmittedBlockID: event.Raw.BlockNumber,
+	}); err != nil {
+		return errors.Wrap(err, "p.eventRepo.Save")
+	}
+
+	relayer.RecallCandidatesSaved.Inc()
+
+	slog.Info("saved recall candidate",
+		"msgHash", msgHash,
+		"srcOwner", event.Message.SrcOwner.Hex(),
+		"proofBlockID", synced.BlockID,
+	)
+
+	return nil
+}
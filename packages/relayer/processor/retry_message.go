@@ -0,0 +1,256 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/encoding"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+var (
+	errRetryGasLimitTooHigh = errors.New("retryMessage gas limit above the cap")
+	errRetryGasPriceTooHigh = errors.New("gas price above the retryMessage cap")
+)
+
+// onMessageInvocationSelector is the selector of `IMessageInvocable.onMessageInvocation`, the
+// only call the bridge invokes on a message recipient.
+var onMessageInvocationSelector = common.Hex2Bytes("7f07c947")
+
+// unableToInvokeMessageCall mirrors `Bridge._unableToInvokeMessageCall`: the message call
+// can't be invoked if it targets no address, the bridge or the signal service, or calls
+// anything other than `onMessageInvocation`.
+func unableToInvokeMessageCall(
+	message bridge.IBridgeMessage,
+	bridgeAddress common.Address,
+	signalServiceAddress common.Address,
+) bool {
+	if message.To == (common.Address{}) || message.To == bridgeAddress || message.To == signalServiceAddress {
+		return true
+	}
+
+	return len(message.Data) >= 4 && !bytes.Equal(message.Data[:4], onMessageInvocationSelector)
+}
+
+// canRetryMessage determines whether the relayer is authorized to retry a message. Anyone
+// can retry a message whose call can't be invoked, its value then goes to the destination
+// owner, or a message with a non-zero gas limit, but only the destination owner can retry
+// a message with a zero gas limit. The relayer never makes the last attempt, which marks
+// the message as Failed, that is left to the destination owner.
+func (p *Processor) canRetryMessage(message bridge.IBridgeMessage) bool {
+	if unableToInvokeMessageCall(message, p.cfg.DestBridgeAddress, p.cfg.DestSignalServiceAddress) {
+		return true
+	}
+
+	return message.GasLimit != 0 || message.DestOwner == p.relayerAddr
+}
+
+// retryMessage makes one attempt to retry a Retriable message. A failed attempt is published
+// again with a delay, doubled after each attempt, until the maximum attempts are reached. It
+// returns whether the message should be requeued, when it couldn't be published again.
+func (p *Processor) retryMessage(
+	ctx context.Context,
+	msg queue.Message,
+	msgBody *queue.QueueMessageSentBody,
+) (bool, error) {
+	event := msgBody.Event
+
+	if !p.canRetryMessage(event.Message) {
+		slog.Info("not authorized to retry message",
+			"msgHash", common.Hash(event.MsgHash).Hex(),
+			"destOwner", event.Message.DestOwner.Hex(),
+		)
+
+		return false, nil
+	}
+
+	msgBody.RetryMessageAttempts++
+
+	slog.Info("retrying message",
+		"msgHash", common.Hash(event.MsgHash).Hex(),
+		"srcTxHash", event.Raw.TxHash.Hex(),
+		"attempt", msgBody.RetryMessageAttempts,
+	)
+
+	err := p.sendRetryMessageCall(ctx, msg, msgBody)
+	if err == nil {
+		return false, nil
+	}
+
+	var permanent *backoff.PermanentError
+
+	if errors.As(err, &permanent) || msgBody.RetryMessageAttempts >= max(p.retryMessagesMaxAttempts, 1) {
+		relayer.RetryMessageErrors.Inc()
+
+		slog.Warn("giving up retrying message",
+			"msgHash", common.Hash(event.MsgHash).Hex(),
+			"srcTxHash", event.Raw.TxHash.Hex(),
+			"attempts", msgBody.RetryMessageAttempts,
+			"error", err,
+		)
+
+		// the message stays Retriable, its owner can still retry it, so it is acknowledged anyway.
+		return false, nil
+	}
+
+	slog.Warn("retry message attempt failed",
+		"msgHash", common.Hash(event.MsgHash).Hex(),
+		"attempt", msgBody.RetryMessageAttempts,
+		"error", err,
+	)
+
+	if err := p.requeueRetryMessage(ctx, msgBody); err != nil {
+		slog.Error("error publishing message to retry", "error", err)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// requeueRetryMessage publishes the message to the unprofitable queue, which delivers it back
+// to the processing queue once it expires, so the next attempt doesn't hold a worker while
+// it waits.
+func (p *Processor) requeueRetryMessage(ctx context.Context, msgBody *queue.QueueMessageSentBody) error {
+	body, err := json.Marshal(msgBody)
+	if err != nil {
+		return errors.Wrap(err, "json.Marshal")
+	}
+
+	delay := p.retryMessagesInterval << (msgBody.RetryMessageAttempts - 1)
+	expiration := strconv.FormatInt(delay.Milliseconds(), 10)
+
+	if err := p.queue.Publish(
+		ctx,
+		fmt.Sprintf("%v-unprofitable", p.queueName()),
+		body,
+		nil,
+		&expiration,
+	); err != nil {
+		return errors.Wrap(err, "p.queue.Publish")
+	}
+
+	return nil
+}
+
+// sendRetryMessageCall calls `bridge.retryMessage` if the message is still Retriable, and the
+// gas limit and gas price are within the configured caps.
+func (p *Processor) sendRetryMessageCall(
+	ctx context.Context,
+	msg queue.Message,
+	msgBody *queue.QueueMessageSentBody,
+) error {
+	event := msgBody.Event
+
+	eventStatus, err := p.eventStatusFromMsgHash(ctx, event.MsgHash)
+	if err != nil {
+		return errors.Wrap(err, "p.eventStatusFromMsgHash")
+	}
+
+	// retried or recalled by someone else in the meantime.
+	if eventStatus != relayer.EventStatusRetriable {
+		slog.Info("message no longer retriable", "eventStatus", eventStatus.String())
+
+		return nil
+	}
+
+	if maxGasPrice := p.cfg.RetryMessagesMaxGasPrice; maxGasPrice != 0 {
+		baseFee, err := p.getBaseFee(ctx)
+		if err != nil {
+			return err
+		}
+
+		gasTipCap, err := p.destEthClient.SuggestGasTipCap(ctx)
+		if err != nil {
+			return err
+		}
+
+		gasPrice := new(big.Int).Add(baseFee, relayer.EffectiveGasTipCap(gasTipCap, p.minTipCap))
+		if gasPrice.Cmp(new(big.Int).SetUint64(maxGasPrice)) > 0 {
+			slog.Info("gas price above retryMessage cap", "gasPrice", gasPrice, "maxGasPrice", maxGasPrice)
+
+			return errRetryGasPriceTooHigh
+		}
+	}
+
+	data, err := encoding.BridgeABI.Pack("retryMessage", event.Message, false)
+	if err != nil {
+		return backoff.Permanent(err)
+	}
+
+	// the estimation fails if the message call still reverts.
+	gasLimit, err := p.destEthClient.EstimateGas(ctx, ethereum.CallMsg{
+		From: p.relayerAddr,
+		To:   &p.cfg.DestBridgeAddress,
+		Data: data,
+	})
+	if err != nil {
+		return errors.Wrap(err, "p.destEthClient.EstimateGas")
+	}
+
+	if maxGasLimit := p.cfg.RetryMessagesMaxGasLimit; maxGasLimit != 0 && gasLimit > maxGasLimit {
+		return backoff.Permanent(errRetryGasLimitTooHigh)
+	}
+
+	receipt, err := p.txmgr.Send(ctx, txmgr.TxCandidate{
+		TxData:   data,
+		To:       &p.cfg.DestBridgeAddress,
+		GasLimit: gasLimit,
+	})
+	if err != nil {
+		slog.Warn("Failed to send RetryMessage transaction", "error", err.Error())
+
+		p.saveMessageHistory(ctx, event, relayer.SaveMessageHistoryOpts{
+			Kind:  relayer.MessageHistoryKindRetryAttempt,
+			Error: err.Error(),
+		})
+
+		return err
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		slog.Warn("RetryMessage transaction reverted",
+			"txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+			"srcTxHash", event.Raw.TxHash.Hex(),
+		)
+
+		p.saveReceiptHistory(ctx, event, relayer.MessageHistoryKindRetryAttempt, receipt, errTxReverted)
+
+		return errTxReverted
+	}
+
+	relayer.RetriedMessages.Inc()
+
+	p.saveReceiptHistory(ctx, event, relayer.MessageHistoryKindRetryAttempt, receipt, nil)
+
+	slog.Info("Retried message",
+		"txHash", hex.EncodeToString(receipt.TxHash.Bytes()),
+		"srcTxHash", event.Raw.TxHash.Hex(),
+	)
+
+	if msg.Internal != nil {
+		if err := p.eventRepo.UpdateStatus(ctx, msgBody.ID, relayer.EventStatusDone); err != nil {
+			return backoff.Permanent(err)
+		}
+	}
+
+	if err := p.saveMessageStatusChangedEvent(ctx, receipt, event); err != nil {
+		return backoff.Permanent(err)
+	}
+
+	return nil
+}
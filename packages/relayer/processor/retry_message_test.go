@@ -0,0 +1,340 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/bridge"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/bindings/taikol2"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/queue"
+)
+
+// recordingTxManager records the transactions sent, and returns receipts with the given status.
+type recordingTxManager struct {
+	mock.TxManager
+	status uint64
+	sent   []txmgr.TxCandidate
+}
+
+func (t *recordingTxManager) Send(ctx context.Context, candidate txmgr.TxCandidate) (*types.Receipt, error) {
+	t.sent = append(t.sent, candidate)
+
+	return &types.Receipt{Status: t.status}, nil
+}
+
+// estimateGasEthClient estimates the given gas for every call.
+type estimateGasEthClient struct {
+	*mock.EthClient
+	gas uint64
+}
+
+func (c *estimateGasEthClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return c.gas, nil
+}
+
+func newRetryMessageBody(msgHash [32]byte, gasLimit uint32) *queue.QueueMessageSentBody {
+	return &queue.QueueMessageSentBody{
+		ID: 1,
+		Event: &bridge.BridgeMessageSent{
+			MsgHash: msgHash,
+			Message: bridge.IBridgeMessage{
+				GasLimit:  gasLimit,
+				DestOwner: common.HexToAddress("0x4"),
+				To:        common.HexToAddress("0x5"),
+				Data:      common.Hex2Bytes("7f07c947"),
+			},
+		},
+	}
+}
+
+// newRetryTestProcessor returns a processor retrying messages through a recording queue and
+// tx manager, with a saved Retriable event the message body refers to.
+func newRetryTestProcessor(t *testing.T, status uint64) (*Processor, *recordingQueue, *recordingTxManager) {
+	q := &recordingQueue{}
+	tm := &recordingTxManager{status: status}
+
+	p := newTestProcessor(false)
+	p.queue = q
+	p.txmgr = tm
+	p.retryMessagesMaxAttempts = 3
+	p.retryMessagesInterval = 30 * time.Second
+	p.cfg.DestSignalServiceAddress = common.HexToAddress("0x6")
+
+	_, err := p.eventRepo.Save(context.Background(), &relayer.SaveEventOpts{
+		Name:        relayer.EventNameMessageSent,
+		Data:        "{}",
+		ChainID:     mock.MockChainID,
+		DestChainID: mock.MockChainID,
+		Status:      relayer.EventStatusRetriable,
+		MsgHash:     common.Hash(mock.RetriableMsgHash).Hex(),
+		Event:       relayer.EventNameMessageSent,
+	})
+	assert.Nil(t, err)
+
+	return p, q, tm
+}
+
+// retryAndHandle retries the message, then acknowledges it the way the event loop does.
+func retryAndHandle(p *Processor, msgBody *queue.QueueMessageSentBody) {
+	msg := queue.Message{Body: []byte(`{}`), Internal: struct{}{}}
+
+	shouldRequeue, err := p.retryMessage(context.Background(), msg, msgBody)
+	p.handleProcessMessageResult(context.Background(), msg, shouldRequeue, msgBody.TimesRetried, err)
+}
+
+func TestCanRetryMessage(t *testing.T) {
+	p := newTestProcessor(false)
+	p.relayerAddr = common.HexToAddress("0x1")
+	p.cfg.DestSignalServiceAddress = common.HexToAddress("0x6")
+
+	invocation := common.Hex2Bytes("7f07c94700")
+
+	tests := []struct {
+		name    string
+		message bridge.IBridgeMessage
+		want    bool
+	}{
+		{
+			"nonZeroGasLimit",
+			bridge.IBridgeMessage{GasLimit: 1, DestOwner: common.HexToAddress("0x2"), To: common.HexToAddress("0x3")},
+			true,
+		},
+		{
+			"zeroGasLimitNotDestOwner",
+			bridge.IBridgeMessage{DestOwner: common.HexToAddress("0x2"), To: common.HexToAddress("0x3"), Data: invocation},
+			false,
+		},
+		{
+			"zeroGasLimitDestOwner",
+			bridge.IBridgeMessage{DestOwner: p.relayerAddr, To: common.HexToAddress("0x3")},
+			true,
+		},
+		{
+			"zeroGasLimitNoRecipient",
+			bridge.IBridgeMessage{DestOwner: common.HexToAddress("0x2")},
+			true,
+		},
+		{
+			"zeroGasLimitToBridge",
+			bridge.IBridgeMessage{DestOwner: common.HexToAddress("0x2"), To: p.cfg.DestBridgeAddress},
+			true,
+		},
+		{
+			"zeroGasLimitToSignalService",
+			bridge.IBridgeMessage{DestOwner: common.HexToAddress("0x2"), To: p.cfg.DestSignalServiceAddress},
+			true,
+		},
+		{
+			"zeroGasLimitNotMessageInvocation",
+			bridge.IBridgeMessage{
+				DestOwner: common.HexToAddress("0x2"),
+				To:        common.HexToAddress("0x3"),
+				Data:      common.Hex2Bytes("a9059cbb00"),
+			},
+			true,
+		},
+		{
+			"zeroGasLimitShortData",
+			bridge.IBridgeMessage{DestOwner: common.HexToAddress("0x2"), To: common.HexToAddress("0x3"), Data: []byte{0x1}},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.canRetryMessage(tt.message))
+		})
+	}
+}
+
+func TestSendRetryMessageCall_NotRetriable(t *testing.T) {
+	p := newTestProcessor(false)
+
+	err := p.sendRetryMessageCall(context.Background(), queue.Message{}, newRetryMessageBody(mock.SuccessMsgHash, 1))
+
+	assert.Nil(t, err)
+}
+
+func TestSendRetryMessageCall_Reverted(t *testing.T) {
+	p := newTestProcessor(false)
+
+	// the mock tx manager returns a receipt without a successful status.
+	err := p.sendRetryMessageCall(context.Background(), queue.Message{}, newRetryMessageBody(mock.RetriableMsgHash, 1))
+
+	assert.ErrorIs(t, err, errTxReverted)
+}
+
+func TestSendRetryMessageCall_GasPriceAboveCap(t *testing.T) {
+	p := newTestProcessor(false)
+	p.cfg.RetryMessagesMaxGasPrice = 200
+	p.taikoL2 = &taikol2.TaikoL2{}
+	p.destEthClient = &blockByNumberEthClient{
+		EthClient: &mock.EthClient{},
+		block:     processorBlockWithBaseFee(big.NewInt(123)),
+	}
+
+	err := p.sendRetryMessageCall(context.Background(), queue.Message{}, newRetryMessageBody(mock.RetriableMsgHash, 1))
+
+	assert.ErrorIs(t, err, errRetryGasPriceTooHigh)
+}
+
+func TestRetryMessage_Succeeds(t *testing.T) {
+	p, q, tm := newRetryTestProcessor(t, types.ReceiptStatusSuccessful)
+
+	retryAndHandle(p, newRetryMessageBody(mock.RetriableMsgHash, 1))
+
+	assert.Len(t, tm.sent, 1)
+	assert.Equal(t, p.cfg.DestBridgeAddress, *tm.sent[0].To)
+	assert.Nil(t, q.publishedBody)
+	assert.Equal(t, 1, q.acked)
+	assert.Equal(t, 0, q.nacked)
+
+	event, err := p.eventRepo.FirstByMsgHash(context.Background(), common.Hash(mock.RetriableMsgHash).Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, relayer.EventStatusDone, event.Status)
+}
+
+func TestRetryMessage_RequeuesFailedAttemptWithDelay(t *testing.T) {
+	p, q, tm := newRetryTestProcessor(t, types.ReceiptStatusFailed)
+
+	msgBody := newRetryMessageBody(mock.RetriableMsgHash, 1)
+	msgBody.RetryMessageAttempts = 1
+
+	retryAndHandle(p, msgBody)
+
+	assert.Len(t, tm.sent, 1)
+	assert.Equal(t, 1, q.acked)
+	assert.Equal(t, 0, q.nacked)
+
+	// the second attempt failed, the third is delayed by twice the interval.
+	assert.Equal(t, "167001-167001-MessageSent-queue-unprofitable", q.publishedQueue)
+	assert.Equal(t, "60000", *q.publishedExpiration)
+
+	var published queue.QueueMessageSentBody
+
+	assert.Nil(t, json.Unmarshal(q.publishedBody, &published))
+	assert.Equal(t, uint64(2), published.RetryMessageAttempts)
+	assert.Equal(t, msgBody.Event.MsgHash, published.Event.MsgHash)
+
+	event, err := p.eventRepo.FirstByMsgHash(context.Background(), common.Hash(mock.RetriableMsgHash).Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, relayer.EventStatusRetriable, event.Status)
+}
+
+func TestRetryMessage_NacksWhenRequeueFails(t *testing.T) {
+	p, q, tm := newRetryTestProcessor(t, types.ReceiptStatusFailed)
+	q.publishErr = errors.New("publish failed")
+
+	retryAndHandle(p, newRetryMessageBody(mock.RetriableMsgHash, 1))
+
+	assert.Len(t, tm.sent, 1)
+	assert.Equal(t, 0, q.acked)
+	assert.Equal(t, 1, q.nacked)
+	assert.True(t, q.requeued)
+}
+
+func TestRetryMessage_GivesUpAfterMaxAttempts(t *testing.T) {
+	p, q, tm := newRetryTestProcessor(t, types.ReceiptStatusFailed)
+
+	msgBody := newRetryMessageBody(mock.RetriableMsgHash, 1)
+	msgBody.RetryMessageAttempts = p.retryMessagesMaxAttempts - 1
+
+	retryAndHandle(p, msgBody)
+
+	assert.Len(t, tm.sent, 1)
+	assert.Nil(t, q.publishedBody)
+	assert.Equal(t, 1, q.acked)
+	assert.Equal(t, 0, q.nacked)
+
+	event, err := p.eventRepo.FirstByMsgHash(context.Background(), common.Hash(mock.RetriableMsgHash).Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, relayer.EventStatusRetriable, event.Status)
+}
+
+func TestRetryMessage_GivesUpWhenGasLimitAboveCap(t *testing.T) {
+	p, q, tm := newRetryTestProcessor(t, types.ReceiptStatusSuccessful)
+	p.cfg.RetryMessagesMaxGasLimit = 1
+	p.destEthClient = &estimateGasEthClient{EthClient: &mock.EthClient{}, gas: 2}
+
+	retryAndHandle(p, newRetryMessageBody(mock.RetriableMsgHash, 1))
+
+	assert.Empty(t, tm.sent)
+	assert.Nil(t, q.publishedBody)
+	assert.Equal(t, 1, q.acked)
+}
+
+func TestRetryMessage_NotAuthorized(t *testing.T) {
+	p, q, tm := newRetryTestProcessor(t, types.ReceiptStatusSuccessful)
+
+	retryAndHandle(p, newRetryMessageBody(mock.RetriableMsgHash, 0))
+
+	assert.Empty(t, tm.sent)
+	assert.Nil(t, q.publishedBody)
+	assert.Equal(t, 1, q.acked)
+	assert.Equal(t, 0, q.nacked)
+
+	event, err := p.eventRepo.FirstByMsgHash(context.Background(), common.Hash(mock.RetriableMsgHash).Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, relayer.EventStatusRetriable, event.Status)
+}
+
+func TestRetryMessage_UninvocableMessageCall(t *testing.T) {
+	p, q, tm := newRetryTestProcessor(t, types.ReceiptStatusSuccessful)
+
+	// anyone can retry a message whose call can't be invoked, even with a zero gas limit.
+	msgBody := newRetryMessageBody(mock.RetriableMsgHash, 0)
+	msgBody.Event.Message.To = p.cfg.DestSignalServiceAddress
+
+	retryAndHandle(p, msgBody)
+
+	assert.Len(t, tm.sent, 1)
+	assert.Equal(t, 1, q.acked)
+}
+
+func TestSaveRecallCandidate(t *testing.T) {
+	p := newTestProcessor(false)
+	p.destSignalService = &mock.SignalService{}
+	p.destCaller = &mock.Caller{}
+	p.cfg.DestSignalServiceAddress = common.HexToAddress("0x6")
+
+	msgBody := &queue.QueueMessageSentBody{
+		Event: &bridge.BridgeMessageSent{
+			MsgHash: mock.FailSignal,
+			Message: bridge.IBridgeMessage{
+				SrcOwner: common.HexToAddress("0x7"),
+				Value:    big.NewInt(1),
+			},
+		},
+	}
+
+	assert.Nil(t, p.saveRecallCandidate(context.Background(), msgBody))
+
+	msgHash := common.Hash(mock.FailSignal).Hex()
+
+	event, err := p.eventRepo.FirstByEventAndMsgHash(context.Background(), relayer.EventNameRecallCandidate, msgHash)
+	assert.Nil(t, err)
+	assert.NotNil(t, event)
+	assert.Equal(t, relayer.EventStatusFailed, event.Status)
+	assert.Equal(t, common.HexToAddress("0x7").Hex(), event.MessageOwner)
+
+	var candidate recallCandidate
+
+	assert.Nil(t, json.Unmarshal(event.Data, &candidate))
+	assert.Equal(t, msgHash, candidate.MsgHash)
+	assert.NotEmpty(t, candidate.Proof)
+
+	// a message is saved as a recall candidate only once.
+	assert.Nil(t, p.saveRecallCandidate(context.Background(), msgBody))
+	assert.Equal(t, 1, p.eventRepo.(*mock.EventRepository).SavedCount())
+}
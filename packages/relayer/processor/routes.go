@@ -0,0 +1,180 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/relayer"
+	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/db"
+	pkgFlags "github.com/taikoxyz/taiko-mono/packages/relayer/pkg/flags"
+)
+
+// RouteConfig is an additional (source, destination) route served by the processor, read from
+// the routes file. The processing, queue, database and tx manager settings are shared with the
+// route configured by the command line flags.
+type RouteConfig struct {
+	SrcRPCUrl               string         `json:"srcRpcUrl"`
+	DestRPCUrl              string         `json:"destRpcUrl"`
+	SrcSignalServiceAddress common.Address `json:"srcSignalServiceAddress"`
+	DestBridgeAddress       common.Address `json:"destBridgeAddress"`
+	DestERC20VaultAddress   common.Address `json:"destERC20VaultAddress"`
+	DestERC721VaultAddress  common.Address `json:"destERC721VaultAddress"`
+	DestERC1155VaultAddress common.Address `json:"destERC1155VaultAddress"`
+	DestTaikoAddress        common.Address `json:"destTaikoAddress"`
+	DestQuotaManagerAddress common.Address `json:"destQuotaManagerAddress"`
+	EnableTaikoL2           bool           `json:"enableTaikoL2"`
+	// DestSignalServiceAddress is only required if recall candidates are enabled.
+	DestSignalServiceAddress common.Address `json:"destSignalServiceAddress"`
+	// ProcessorPrivateKey is optional, the key from the command line flags is used if empty.
+	ProcessorPrivateKey string `json:"processorPrivateKey"`
+	// QueueName is optional, it defaults to the queue the indexer of the route publishes to.
+	QueueName string `json:"queueName"`
+	// BatchProcessorAddress is optional, the messages of the route are not batched if empty.
+	BatchProcessorAddress common.Address `json:"batchProcessorAddress"`
+}
+
+// validate checks the required fields of the route.
+func (r *RouteConfig) validate() error {
+	switch {
+	case r.SrcRPCUrl == "":
+		return fmt.Errorf("srcRpcUrl not provided")
+	case r.DestRPCUrl == "":
+		return fmt.Errorf("destRpcUrl not provided")
+	case r.SrcSignalServiceAddress == relayer.ZeroAddress:
+		return fmt.Errorf("srcSignalServiceAddress not provided")
+	case r.DestBridgeAddress == relayer.ZeroAddress:
+		return fmt.Errorf("destBridgeAddress not provided")
+	case r.DestERC20VaultAddress == relayer.ZeroAddress:
+		return fmt.Errorf("destERC20VaultAddress not provided")
+	}
+
+	return nil
+}
+
+// loadRouteConfigs reads the routes from the given JSON file.
+func loadRouteConfigs(path string) ([]*RouteConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read routes file: %w", err)
+	}
+
+	var routes []*RouteConfig
+	if err := json.Unmarshal(data, &routes); err != nil {
+		return nil, fmt.Errorf("failed to decode routes file: %w", err)
+	}
+
+	for i, route := range routes {
+		if err := route.validate(); err != nil {
+			return nil, fmt.Errorf("invalid route %d: %w", i, err)
+		}
+	}
+
+	return routes, nil
+}
+
+// newRouteConfigs builds the processor config of each route in the routes file, based on the
+// config of the route from the command line flags.
+func newRouteConfigs(c *cli.Context, base *Config, path string) ([]*Config, error) {
+	routes, err := loadRouteConfigs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]*Config, 0, len(routes))
+
+	for i, route := range routes {
+		privateKey := base.ProcessorPrivateKey
+		if route.ProcessorPrivateKey != "" {
+			if privateKey, err = crypto.ToECDSA(common.FromHex(route.ProcessorPrivateKey)); err != nil {
+				return nil, fmt.Errorf("invalid processorPrivateKey of route %d: %w", i, err)
+			}
+		}
+
+		cfg := *base
+		cfg.Routes = nil
+		cfg.ProcessorPrivateKey = privateKey
+		cfg.SrcRPCUrl = route.SrcRPCUrl
+		cfg.DestRPCUrl = route.DestRPCUrl
+		cfg.SrcSignalServiceAddress = route.SrcSignalServiceAddress
+		cfg.DestSignalServiceAddress = route.DestSignalServiceAddress
+		cfg.DestBridgeAddress = route.DestBridgeAddress
+		cfg.DestERC20VaultAddress = route.DestERC20VaultAddress
+		cfg.DestERC721VaultAddress = route.DestERC721VaultAddress
+		cfg.DestERC1155VaultAddress = route.DestERC1155VaultAddress
+		cfg.DestTaikoAddress = route.DestTaikoAddress
+		cfg.DestQuotaManagerAddress = route.DestQuotaManagerAddress
+		cfg.EnableTaikoL2 = route.EnableTaikoL2
+		cfg.QueueName = route.QueueName
+		cfg.BatchProcessorAddress = route.BatchProcessorAddress
+		// Every route sends its transactions through its own tx manager, so the nonces
+		// of the different destination chains are managed separately.
+		cfg.TxmgrConfigs = pkgFlags.InitTxmgrConfigsFromCli(route.DestRPCUrl, privateKey, c)
+
+		configs = append(configs, &cfg)
+	}
+
+	return configs, nil
+}
+
+// initRoutes initializes a child processor for each additional route, the children share the
+// database connection of the processor.
+func (p *Processor) initRoutes(ctx context.Context, database db.DB) error {
+	queueNames := map[string]struct{}{p.queueName(): {}}
+	accounts := map[string]struct{}{routeAccount(p): {}}
+
+	for i, cfg := range p.cfg.Routes {
+		cfg.OpenDBFunc = func() (db.DB, error) {
+			return database, nil
+		}
+
+		route := new(Processor)
+		if err := InitFromConfig(ctx, route, cfg); err != nil {
+			return fmt.Errorf("failed to initialize route %d: %w", i, err)
+		}
+
+		name := route.queueName()
+		if _, ok := queueNames[name]; ok {
+			return fmt.Errorf("duplicate route %d consuming queue %s", i, name)
+		}
+
+		queueNames[name] = struct{}{}
+
+		// Two tx managers sending from the same account on the same chain would
+		// race on the nonces.
+		account := routeAccount(route)
+		if _, ok := accounts[account]; ok {
+			return fmt.Errorf(
+				"route %d shares processor account %s on chain %v with another route",
+				i,
+				route.relayerAddr.Hex(),
+				route.destChainId,
+			)
+		}
+
+		accounts[account] = struct{}{}
+
+		slog.Info(
+			"Initialized processor route",
+			"srcChainID", route.srcChainId,
+			"destChainID", route.destChainId,
+			"queue", name,
+			"relayer", route.relayerAddr.Hex(),
+		)
+
+		p.routes = append(p.routes, route)
+	}
+
+	return nil
+}
+
+// routeAccount returns the key of the account sending the transactions of the given processor.
+func routeAccount(p *Processor) string {
+	return fmt.Sprintf("%v-%v", p.destChainId.String(), p.relayerAddr.Hex())
+}
@@ -2,9 +2,11 @@ package processor
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/taikoxyz/taiko-mono/packages/relayer"
 )
 
@@ -26,3 +28,14 @@ func (p *Processor) waitForConfirmations(ctx context.Context, txHash common.Hash
 
 	return nil
 }
+
+// isOrphaned returns whether the block the event was emitted in has been reorged out of the
+// source chain since it was queued. The indexer reindexes and queues the canonical event.
+func (p *Processor) isOrphaned(ctx context.Context, log types.Log) (bool, error) {
+	header, err := p.srcEthClient.HeaderByNumber(ctx, new(big.Int).SetUint64(log.BlockNumber))
+	if err != nil {
+		return false, err
+	}
+
+	return header.Hash() != log.BlockHash, nil
+}
@@ -2,8 +2,11 @@ package processor
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/taikoxyz/taiko-mono/packages/relayer/pkg/mock"
 )
@@ -14,3 +17,21 @@ func Test_waitForConfirmations(t *testing.T) {
 	err := p.waitForConfirmations(context.TODO(), mock.SucceedTxHash)
 	assert.Nil(t, err)
 }
+
+func Test_isOrphaned(t *testing.T) {
+	p := newTestProcessor(true)
+
+	orphaned, err := p.isOrphaned(context.TODO(), types.Log{
+		BlockNumber: 1,
+		BlockHash:   (&types.Header{Number: big.NewInt(1)}).Hash(),
+	})
+	assert.Nil(t, err)
+	assert.False(t, orphaned)
+
+	orphaned, err = p.isOrphaned(context.TODO(), types.Log{
+		BlockNumber: 1,
+		BlockHash:   common.HexToHash("0x1"),
+	})
+	assert.Nil(t, err)
+	assert.True(t, orphaned)
+}
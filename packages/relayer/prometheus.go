@@ -102,6 +102,18 @@ var (
 		Name: "events_processed_retriable_status_ops_total",
 		Help: "The total number of processed events that ended up in Retriable status",
 	})
+	RetriedMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "messages_retried_ops_total",
+		Help: "The total number of Retriable messages successfully retried by the processor",
+	})
+	RetryMessageErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "messages_retried_error_ops_total",
+		Help: "The total number of Retriable messages the processor gave up retrying",
+	})
+	RecallCandidatesSaved = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "recall_candidates_saved_ops_total",
+		Help: "The total number of Failed messages saved as recall candidates",
+	})
 	DoneEvents = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "events_processed_done_status_ops_total",
 		Help: "The total number of processed events that ended up in Done status",
@@ -142,6 +154,35 @@ var (
 		Name: "message_processed_events_after_retry_error_count",
 		Help: "The total number of errors logged for MessageProcessed events after retries",
 	})
+	WebhookDeliveriesSent = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "webhook_deliveries_sent_ops_total",
+		Help: "The total number of webhook notifications delivered",
+	})
+	WebhookDeliveryAttemptsFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "webhook_delivery_attempts_failed_ops_total",
+		Help: "The total number of failed attempts to deliver a webhook notification",
+	})
+	OrphanedEventsDeleted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orphaned_events_deleted_ops_total",
+		Help: "The total number of indexed events deleted because their block was reorged out",
+	})
+	OrphanedMessagesSkipped = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "orphaned_messages_skipped_ops_total",
+		Help: "The total number of queued messages skipped because their block was reorged out",
+	})
+	ReorgDepth = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "reorg_depth",
+		Help:    "The number of blocks from the lowest reorged out block to the head, per detected reorg",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 10),
+	})
+	ProcessMessageBatchesSent = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "process_message_batches_sent_ops_total",
+		Help: "The total number of batch transactions of processMessage calls sent",
+	})
+	ProcessMessageBatchedCallsFailed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "process_message_batched_calls_failed_ops_total",
+		Help: "The total number of processMessage calls that failed within a mined batch transaction",
+	})
 	RelayerKeyBalanceGauge = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "relayer_key_balance",
 		Help: "Current balance of the relayer key",
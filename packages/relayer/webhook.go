@@ -0,0 +1,91 @@
+package relayer
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// Statuses of a webhook delivery.
+var (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// MessageStatusNotification is sent to the webhook subscribers, and streamed to the WebSocket
+// clients, when the indexer changes the status of a message.
+type MessageStatusNotification struct {
+	MsgHash     string      `json:"msgHash"`
+	Owner       string      `json:"owner"`
+	Event       string      `json:"event"`
+	Status      EventStatus `json:"status"`
+	StatusName  string      `json:"statusName"`
+	ChainID     int64       `json:"chainID"`
+	DestChainID int64       `json:"destChainID"`
+	TxHash      string      `json:"txHash,omitempty"`
+	BlockID     uint64      `json:"blockID,omitempty"`
+}
+
+// WebhookSubscription is a URL notified of the status changes of the messages of an owner
+// address, or of a single message. The secret signs the notifications sent to the URL.
+type WebhookSubscription struct {
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`
+	Owner     string    `json:"owner,omitempty"`
+	MsgHash   string    `json:"msgHash,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type SaveWebhookSubscriptionOpts struct {
+	URL     string
+	Secret  string
+	Owner   string
+	MsgHash string
+}
+
+// WebhookDelivery is a notification to send to a subscription, retried with backoff until it
+// is delivered or runs out of attempts.
+type WebhookDelivery struct {
+	ID             int            `json:"id"`
+	SubscriptionID int            `json:"subscriptionID"`
+	MsgHash        string         `json:"msgHash"`
+	Payload        datatypes.JSON `json:"payload"`
+	Status         string         `json:"status"`
+	Attempts       uint64         `json:"attempts"`
+	LastError      string         `json:"lastError,omitempty"`
+	NextAttemptAt  time.Time      `json:"nextAttemptAt"`
+	DeliveredAt    *time.Time     `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time      `json:"createdAt"`
+}
+
+type SaveWebhookDeliveryOpts struct {
+	SubscriptionID int
+	MsgHash        string
+	Payload        string
+}
+
+// UpdateWebhookDeliveryOpts records the outcome of an attempt to send a delivery.
+type UpdateWebhookDeliveryOpts struct {
+	Status        string
+	Attempts      uint64
+	LastError     string
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+}
+
+// WebhookRepository is used to interact with webhook subscriptions and deliveries in the store
+type WebhookRepository interface {
+	SaveSubscription(ctx context.Context, opts *SaveWebhookSubscriptionOpts) (*WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int) error
+	FindSubscriptions(ctx context.Context) ([]*WebhookSubscription, error)
+	FindSubscription(ctx context.Context, id int) (*WebhookSubscription, error)
+	FindSubscriptionsByMessage(ctx context.Context, owner string, msgHash string) ([]*WebhookSubscription, error)
+	SaveDelivery(ctx context.Context, opts *SaveWebhookDeliveryOpts) (*WebhookDelivery, error)
+	// ClaimDueDeliveries returns the pending deliveries due to be sent, and postpones them by the
+	// lease, so another dispatcher does not send them at the same time.
+	ClaimDueDeliveries(ctx context.Context, lease time.Duration, limit int) ([]*WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, id int, opts *UpdateWebhookDeliveryOpts) error
+}
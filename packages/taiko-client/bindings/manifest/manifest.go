@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"math"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -10,8 +11,16 @@ import (
 )
 
 const (
-	// Version number for Shasta / Unzen payloads.
+	// Version number for Shasta / Unzen payloads, the manifest is compressed with zlib.
 	ShastaPayloadVersion = 0x1
+	// BrotliPayloadVersion marks the payloads whose manifest is compressed with brotli, it is only
+	// valid from the compressed payloads fork.
+	BrotliPayloadVersion = 0x2
+	// ZstdPayloadVersion marks the payloads whose manifest is compressed with zstd, it is only
+	// valid from the compressed payloads fork.
+	ZstdPayloadVersion = 0x3
+	// MaxDecompressedPayloadBytes The maximum size of a decompressed brotli or zstd manifest.
+	MaxDecompressedPayloadBytes = 16 * 1024 * 1024
 	// BlobBytes The maximum number of bytes in a blob.
 	BlobBytes = params.BlobTxBytesPerFieldElement * params.BlobTxFieldElementsPerBlob
 	// ProposalMaxBlocks The maximum number of blocks allowed in a pre-Unzen proposal source.
@@ -77,6 +86,36 @@ func TimestampMaxOffsetByChainID(chainID *big.Int) uint64 {
 	return TimestampMaxOffset
 }
 
+// CompressedPayloadsForkTimes are the proposal timestamps from which the brotli and zstd payload versions
+// are valid, keyed by chain ID. The fork is not scheduled on any network yet, so that all derivation
+// clients agree on deriving the default manifest for these versions until it is.
+var CompressedPayloadsForkTimes = map[uint64]uint64{}
+
+// CompressedPayloadsForkTimeByChainID returns the compressed payloads fork activation timestamp based
+// on chainID, math.MaxUint64 means the fork is not scheduled.
+func CompressedPayloadsForkTimeByChainID(chainID *big.Int) uint64 {
+	if chainID == nil {
+		return math.MaxUint64
+	}
+	if forkTime, ok := CompressedPayloadsForkTimes[chainID.Uint64()]; ok {
+		return forkTime
+	}
+	return math.MaxUint64
+}
+
+// IsPayloadVersionValid returns whether the given payload version is valid for a proposal with the given
+// timestamp, the manifest of a payload with an invalid version is replaced by the default manifest.
+func IsPayloadVersionValid(chainID *big.Int, version uint32, proposalTimestamp uint64) bool {
+	switch version {
+	case ShastaPayloadVersion:
+		return true
+	case BrotliPayloadVersion, ZstdPayloadVersion:
+		return proposalTimestamp >= CompressedPayloadsForkTimeByChainID(chainID)
+	default:
+		return false
+	}
+}
+
 // ShastaForkTimeByChainID returns the Shasta fork activation timestamp based on chainID.
 //
 // The values are sourced from the taiko-geth fork schedule (the same source consumed by
@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"math"
 	"math/big"
 	"testing"
 
@@ -41,3 +42,20 @@ func TestShastaForkTimeByChainID(t *testing.T) {
 	require.NotZero(t, ShastaForkTimeByChainID(params.TaikoHoodiNetworkID))
 	require.NotZero(t, ShastaForkTimeByChainID(params.TaikoMainnetNetworkID))
 }
+
+func TestIsPayloadVersionValid(t *testing.T) {
+	// The compressed payloads fork is not scheduled on any network.
+	require.Equal(t, uint64(math.MaxUint64), CompressedPayloadsForkTimeByChainID(params.TaikoMainnetNetworkID))
+	require.Equal(t, uint64(math.MaxUint64), CompressedPayloadsForkTimeByChainID(nil))
+	require.True(t, IsPayloadVersionValid(params.TaikoMainnetNetworkID, ShastaPayloadVersion, 0))
+	require.False(t, IsPayloadVersionValid(params.TaikoMainnetNetworkID, BrotliPayloadVersion, math.MaxUint64-1))
+	require.False(t, IsPayloadVersionValid(params.TaikoMainnetNetworkID, 0x4, 0))
+
+	CompressedPayloadsForkTimes[params.TaikoInternalNetworkID.Uint64()] = 100
+	t.Cleanup(func() { delete(CompressedPayloadsForkTimes, params.TaikoInternalNetworkID.Uint64()) })
+
+	require.False(t, IsPayloadVersionValid(params.TaikoInternalNetworkID, ZstdPayloadVersion, 99))
+	require.True(t, IsPayloadVersionValid(params.TaikoInternalNetworkID, ZstdPayloadVersion, 100))
+	require.True(t, IsPayloadVersionValid(params.TaikoInternalNetworkID, BrotliPayloadVersion, 100))
+	require.False(t, IsPayloadVersionValid(params.TaikoHoodiNetworkID, BrotliPayloadVersion, 100))
+}
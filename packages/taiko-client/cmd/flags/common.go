@@ -16,6 +16,7 @@ var (
 	proposerCategory = "PROPOSER"
 	proverCategory   = "PROVER"
 	txmgrCategory    = "TX_MANAGER"
+	deriveCategory   = "DERIVE"
 )
 
 // Required flags used by all client software.
@@ -0,0 +1,57 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// Flags used by the derivation replay command.
+var (
+	DeriveFromProposalID = &cli.Uint64Flag{
+		Name:     "derive.from",
+		Usage:    "First proposal ID to replay",
+		Required: true,
+		Category: deriveCategory,
+		EnvVars:  []string{"DERIVE_FROM"},
+	}
+	DeriveToProposalID = &cli.Uint64Flag{
+		Name:     "derive.to",
+		Usage:    "Last proposal ID to replay, only the --derive.from proposal is replayed if not set",
+		Category: deriveCategory,
+		EnvVars:  []string{"DERIVE_TO"},
+	}
+	DeriveDiff = &cli.BoolFlag{
+		Name:     "derive.diff",
+		Usage:    "Compare the derived blocks with the blocks on the L2 node and report the mismatches",
+		Value:    false,
+		Category: deriveCategory,
+		EnvVars:  []string{"DERIVE_DIFF"},
+	}
+	DeriveOutput = &cli.StringFlag{
+		Name:     "derive.output",
+		Usage:    "Path of the file to write the derived blocks to as JSON, stdout is used if not set",
+		Category: deriveCategory,
+		EnvVars:  []string{"DERIVE_OUTPUT"},
+	}
+)
+
+// DeriveFlags All derivation replay command flags.
+var DeriveFlags = []cli.Flag{
+	InboxAddress,
+	TaikoAnchorAddress,
+	L1WSEndpoint,
+	L1HTTPEndpoint,
+	L1BeaconEndpoint,
+	L2WSEndpoint,
+	L2HTTPEndpoint,
+	L2AuthEndpoint,
+	JWTSecret,
+	BlobServerEndpoint,
+	BlobArchiveDir,
+	RPCTimeout,
+	Verbosity,
+	LogJSON,
+	DeriveFromProposalID,
+	DeriveToProposalID,
+	DeriveDiff,
+	DeriveOutput,
+}
@@ -28,6 +28,20 @@ var (
 		Category: driverCategory,
 		EnvVars:  []string{"BLOB_SERVER"},
 	}
+	// blob archive
+	BlobArchiveDir = &cli.StringFlag{
+		Name:     "blob.archive.dir",
+		Usage:    "Directory to archive the fetched blob sidecars in, archived blobs are served before the beacon node",
+		Category: driverCategory,
+		EnvVars:  []string{"BLOB_ARCHIVE_DIR"},
+	}
+	BlobArchiveServerPort = &cli.Uint64Flag{
+		Name: "blob.archive.serverPort",
+		Usage: "HTTP port of the blob archive server, which can be used as the blob server of other nodes, " +
+			"0 means disabled",
+		Category: driverCategory,
+		EnvVars:  []string{"BLOB_ARCHIVE_SERVER_PORT"},
+	}
 	// preconfirmation block server
 	PreconfBlockServerPort = &cli.Uint64Flag{
 		Name:     "preconfirmation.serverPort",
@@ -67,6 +81,8 @@ var DriverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	P2PSync,
 	CheckPointSyncURL,
 	BlobServerEndpoint,
+	BlobArchiveDir,
+	BlobArchiveServerPort,
 	PreconfBlockServerPort,
 	PreconfBlockServerJWTSecret,
 	PreconfBlockServerCORSOrigins,
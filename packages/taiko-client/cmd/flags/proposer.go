@@ -60,6 +60,56 @@ var (
 		Category: proposerCategory,
 		EnvVars:  []string{"TX_POOL_MAX_TX_LISTS_PER_EPOCH"},
 	}
+	CostAware = &cli.BoolFlag{
+		Name: "epoch.costAware",
+		Usage: "Defer the proposals until the collected L2 tips cover the estimated L1 cost, " +
+			"or until --epoch.minProposingInterval forces one",
+		Value:    false,
+		Category: proposerCategory,
+		EnvVars:  []string{"EPOCH_COST_AWARE"},
+	}
+	ProposeExecutionGas = &cli.Uint64Flag{
+		Name:     "epoch.costAware.executionGas",
+		Usage:    "Estimated L1 gas used by the inbox contract to handle a proposal, excluding the intrinsic gas",
+		Value:    300_000,
+		Category: proposerCategory,
+		EnvVars:  []string{"EPOCH_COST_AWARE_EXECUTION_GAS"},
+	}
+	ManifestCodec = &cli.StringFlag{
+		Name: "manifest.codec",
+		Usage: "Codec to compress the derivation source manifests with: zlib, brotli or zstd, " +
+			"brotli and zstd are only used from the compressed payloads fork of the network",
+		Value:    "zlib",
+		Category: proposerCategory,
+		EnvVars:  []string{"MANIFEST_CODEC"},
+	}
+	// Transaction selection related.
+	PriorityAccounts = &cli.StringSliceFlag{
+		Name:     "txPool.priorityAccounts",
+		Usage:    "Comma separated list of accounts whose transactions are always proposed first",
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_PRIORITY_ACCOUNTS"},
+	}
+	DeniedAddresses = &cli.StringSliceFlag{
+		Name:     "txPool.denylist",
+		Usage:    "Comma separated list of senders and contracts whose transactions are never proposed",
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_DENYLIST"},
+	}
+	MaxTxsPerSender = &cli.Uint64Flag{
+		Name:     "txPool.maxTxsPerSender",
+		Usage:    "Maximum number of transactions proposed for a single sender in one epoch, 0 means unlimited",
+		Value:    0,
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_MAX_TXS_PER_SENDER"},
+	}
+	OrderByFeePerByte = &cli.BoolFlag{
+		Name:     "txPool.orderByFeePerByte",
+		Usage:    "Order the transactions of each list by the proposer tip revenue per byte",
+		Value:    false,
+		Category: proposerCategory,
+		EnvVars:  []string{"TX_POOL_ORDER_BY_FEE_PER_BYTE"},
+	}
 )
 
 // ProposerFlags All proposer flags.
@@ -73,5 +123,12 @@ var ProposerFlags = MergeFlags(CommonFlags, []cli.Flag{
 	MinTip,
 	MinProposingInternal,
 	AllowZeroTipInterval,
+	CostAware,
+	ProposeExecutionGas,
 	MaxTxListsPerEpoch,
+	ManifestCodec,
+	PriorityAccounts,
+	DeniedAddresses,
+	MaxTxsPerSender,
+	OrderByFeePerByte,
 }, TxmgrFlags)
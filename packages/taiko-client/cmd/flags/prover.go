@@ -40,6 +40,15 @@ var (
 		Value:    10 * time.Minute,
 		EnvVars:  []string{"RAIKO_REQUEST_TIMEOUT"},
 	}
+	RaikoBackendsConfigPath = &cli.StringFlag{
+		Name: "raiko.backendsConfigPath",
+		Usage: "Path to a JSON file routing proof types (sgx, sgxgeth, risc0, sp1) to their own proof backends, " +
+			"each entry has proofType, endpoint, and optional protocol, apiKeyPath and timeout fields. " +
+			"Multiple entries with the same proof type are used for failover in the listed order, " +
+			"proof types without an entry are requested from --raiko.host",
+		Category: proverCategory,
+		EnvVars:  []string{"RAIKO_BACKENDS_CONFIG_PATH"},
+	}
 	StartingProposalID = &cli.Uint64Flag{
 		Name:     "prover.startingProposalID",
 		Usage:    "If set, prover will start proving proposals from the proposal with this ID",
@@ -109,6 +118,76 @@ var (
 		Category: proverCategory,
 		EnvVars:  []string{"PROVER_ZK_ONLY_PROOFS"},
 	}
+	ProverDataDir = &cli.StringFlag{
+		Name: "prover.dataDir",
+		Usage: "Directory to persist the generated proofs which are not submitted yet, " +
+			"if set, buffered and cached proofs will be replayed after a prover restart instead of " +
+			"being requested from raiko again",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_DATA_DIR"},
+	}
+	// Admin server.
+	AdminServerPort = &cli.Uint64Flag{
+		Name:     "prover.admin.serverPort",
+		Usage:    "HTTP port of the prover admin server, 0 means disabled",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_ADMIN_SERVER_PORT"},
+	}
+	AdminServerJWTSecret = &cli.StringFlag{
+		Name:     "prover.admin.jwtSecret",
+		Usage:    "Path to a JWT secret to use for the prover admin server",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_ADMIN_JWT_SECRET"},
+	}
+	// Proving policy.
+	PolicyEnabled = &cli.BoolFlag{
+		Name: "prover.policy.enabled",
+		Usage: "Whether to estimate the cost of proving each proposal against the liveness bond, " +
+			"and skip or deprioritise the unprofitable proposals which are not designated to this prover",
+		Value:    false,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_POLICY_ENABLED"},
+	}
+	PolicyCostPerBlock = &cli.StringSliceFlag{
+		Name:     "prover.policy.costPerBlock",
+		Usage:    "Comma separated list of proofType=gwei, the estimated proving cost of a single L2 block",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_POLICY_COST_PER_BLOCK"},
+	}
+	PolicyCostPerMillionGas = &cli.StringSliceFlag{
+		Name:     "prover.policy.costPerMillionGas",
+		Usage:    "Comma separated list of proofType=gwei, the estimated proving cost of one million L2 gas",
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_POLICY_COST_PER_MILLION_GAS"},
+	}
+	PolicyProveTxGas = &cli.Uint64Flag{
+		Name:     "prover.policy.proveTxGas",
+		Usage:    "Estimated L1 gas used by a prove transaction, amortized over prover.zkvm.batchSize proposals",
+		Value:    500_000,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_POLICY_PROVE_TX_GAS"},
+	}
+	PolicyBondTokenEthRate = &cli.Float64Flag{
+		Name:     "prover.policy.bondTokenEthRate",
+		Usage:    "Price of one bond token in ETH, used to value the liveness bond",
+		Value:    1,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_POLICY_BOND_TOKEN_ETH_RATE"},
+	}
+	PolicyMinProfit = &cli.Uint64Flag{
+		Name:     "prover.policy.minProfit",
+		Usage:    "Minimum expected profit in gwei to prove a proposal right away, otherwise it is deprioritised",
+		Value:    0,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_POLICY_MIN_PROFIT"},
+	}
+	PolicyDeprioritiseDelay = &cli.DurationFlag{
+		Name:     "prover.policy.deprioritiseDelay",
+		Usage:    "Time to wait before proving a proposal whose expected profit is below prover.policy.minProfit",
+		Value:    10 * time.Minute,
+		Category: proverCategory,
+		EnvVars:  []string{"PROVER_POLICY_DEPRIORITISE_DELAY"},
+	}
 	// Special flags for testing.
 	Dummy = &cli.BoolFlag{
 		Name:     "prover.dummy",
@@ -166,6 +245,7 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	JWTSecret,
 	RaikoHostEndpoint,
 	RaikoApiKeyPath,
+	RaikoBackendsConfigPath,
 	L1ProverPrivKey,
 	StartingProposalID,
 	Dummy,
@@ -181,4 +261,14 @@ var ProverFlags = MergeFlags(CommonFlags, []cli.Flag{
 	ForceSP1Proof,
 	ForceSGXProof,
 	ZkOnlyProofs,
+	ProverDataDir,
+	AdminServerPort,
+	AdminServerJWTSecret,
+	PolicyEnabled,
+	PolicyCostPerBlock,
+	PolicyCostPerMillionGas,
+	PolicyProveTxGas,
+	PolicyBondTokenEthRate,
+	PolicyMinProfit,
+	PolicyDeprioritiseDelay,
 }, opsigner.CLIFlags("PROVER", proverCategory), TxmgrFlags)
@@ -48,6 +48,13 @@ func main() {
 			Description: "Taiko prover software",
 			Action:      utils.SubcommandAction(new(prover.Prover)),
 		},
+		{
+			Name:        "derive",
+			Flags:       flags.DeriveFlags,
+			Usage:       "Replays the derivation of proposals offline",
+			Description: "Derives the L2 blocks of the given proposals without the engine API, and prints them as JSON",
+			Action:      utils.DeriveAction,
+		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/urfave/cli/v2"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/logger"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/event/derivation"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// DeriveAction replays the derivation of the given proposals offline and writes the derived
+// blocks as JSON, no L2 block will be inserted through the engine API.
+func DeriveAction(c *cli.Context) error {
+	logger.InitLogger(c)
+
+	from, to, err := deriveProposalRange(c)
+	if err != nil {
+		return err
+	}
+
+	jwtSecret, err := jwt.ParseSecretFromFile(c.String(flags.JWTSecret.Name))
+	if err != nil {
+		return fmt.Errorf("invalid JWT secret file: %w", err)
+	}
+
+	var blobServerEndpoint *url.URL
+	if c.IsSet(flags.BlobServerEndpoint.Name) {
+		if blobServerEndpoint, err = url.Parse(c.String(flags.BlobServerEndpoint.Name)); err != nil {
+			return fmt.Errorf("failed to create blob data source: %w", err)
+		}
+	}
+	if c.String(flags.L1BeaconEndpoint.Name) == "" &&
+		blobServerEndpoint == nil &&
+		c.String(flags.BlobArchiveDir.Name) == "" {
+		return errors.New("empty L1 beacon endpoint, blob server and blob archive")
+	}
+
+	l1Endpoint := c.String(flags.L1WSEndpoint.Name)
+	if l1Endpoint == "" {
+		l1Endpoint = c.String(flags.L1HTTPEndpoint.Name)
+	}
+	l2Endpoint := c.String(flags.L2WSEndpoint.Name)
+	if l2Endpoint == "" {
+		l2Endpoint = c.String(flags.L2HTTPEndpoint.Name)
+	}
+	if l1Endpoint == "" || l2Endpoint == "" {
+		return errors.New("must provide one of the WS / HTTP endpoint flags for both L1 and L2")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rpcClient, err := rpc.NewClient(ctx, &rpc.ClientConfig{
+		L1Endpoint:         l1Endpoint,
+		L1BeaconEndpoint:   c.String(flags.L1BeaconEndpoint.Name),
+		L2Endpoint:         l2Endpoint,
+		InboxAddress:       common.HexToAddress(c.String(flags.InboxAddress.Name)),
+		TaikoAnchorAddress: common.HexToAddress(c.String(flags.TaikoAnchorAddress.Name)),
+		L2EngineEndpoint:   c.String(flags.L2AuthEndpoint.Name),
+		JwtSecret:          string(jwtSecret),
+		Timeout:            c.Duration(flags.RPCTimeout.Name),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create RPC client: %w", err)
+	}
+
+	dataSource := rpc.NewBlobDataSource(ctx, rpcClient, blobServerEndpoint)
+	if dir := c.String(flags.BlobArchiveDir.Name); dir != "" {
+		archive, err := rpc.NewFileBlobArchive(dir)
+		if err != nil {
+			return fmt.Errorf("failed to create blob archive: %w", err)
+		}
+		dataSource.SetArchive(archive)
+	}
+
+	var (
+		replayer  = derivation.NewReplayer(rpcClient, dataSource)
+		diff      = c.Bool(flags.DeriveDiff.Name)
+		proposals = make([]*derivation.ReplayedProposal, 0, to-from+1)
+	)
+	for id := from; id <= to; id++ {
+		log.Info("Replaying proposal derivation", "proposalID", id, "diff", diff)
+
+		proposal, err := replayer.Replay(ctx, new(big.Int).SetUint64(id), diff)
+		if err != nil {
+			return fmt.Errorf("failed to replay proposal %d: %w", id, err)
+		}
+		proposals = append(proposals, proposal)
+	}
+
+	var out io.Writer = os.Stdout
+	if path := c.String(flags.DeriveOutput.Name); path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(proposals)
+}
+
+// deriveProposalRange returns the range of the proposal IDs to replay, both ends are inclusive.
+func deriveProposalRange(c *cli.Context) (uint64, uint64, error) {
+	from := c.Uint64(flags.DeriveFromProposalID.Name)
+	if from == 0 {
+		return 0, 0, errors.New("proposal ID must be greater than 0")
+	}
+
+	to := from
+	if c.IsSet(flags.DeriveToProposalID.Name) {
+		to = c.Uint64(flags.DeriveToProposalID.Name)
+	}
+	if to < from {
+		return 0, 0, fmt.Errorf("invalid proposal range: %d > %d", from, to)
+	}
+
+	return from, to, nil
+}
@@ -43,3 +43,31 @@ func TestApplyDevnetUnzenTimeOverride_LeavesPackageVarWhenFlagAbsent(t *testing.
 
 	require.Equal(t, uint64(7), gethcore.DevnetUnzenTime)
 }
+
+func TestDeriveProposalRange(t *testing.T) {
+	newContext := func(args ...string) *cli.Context {
+		app := cli.NewApp()
+		app.Flags = []cli.Flag{flags.DeriveFromProposalID, flags.DeriveToProposalID}
+		set := flag.NewFlagSet("test", 0)
+		require.NoError(t, flags.DeriveFromProposalID.Apply(set))
+		require.NoError(t, flags.DeriveToProposalID.Apply(set))
+		require.NoError(t, set.Parse(args))
+		return cli.NewContext(app, set, nil)
+	}
+
+	from, to, err := deriveProposalRange(newContext("--derive.from", "5"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), from)
+	require.Equal(t, uint64(5), to)
+
+	from, to, err = deriveProposalRange(newContext("--derive.from", "5", "--derive.to", "8"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), from)
+	require.Equal(t, uint64(8), to)
+
+	_, _, err = deriveProposalRange(newContext("--derive.from", "5", "--derive.to", "4"))
+	require.Error(t, err)
+
+	_, _, err = deriveProposalRange(newContext("--derive.from", "0"))
+	require.Error(t, err)
+}
@@ -0,0 +1,128 @@
+package blobserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// @title Taiko Blob Archive API
+// @version 1.0
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name API Support
+// @contact.url https://community.taiko.xyz/
+// @contact.email info@taiko.xyz
+
+// @license.name MIT
+// @license.url https://github.com/taikoxyz/taiko-mono/blob/main/LICENSE
+// BlobArchiveServer represents a blob archive server instance, the responses are compatible with
+// the blob server API, so that other nodes can use it as their `--blob.server`.
+type BlobArchiveServer struct {
+	echo    *echo.Echo
+	archive rpc.BlobArchive
+}
+
+// New creates a new blob archive server instance.
+func New(archive rpc.BlobArchive) *BlobArchiveServer {
+	server := &BlobArchiveServer{
+		echo:    echo.New(),
+		archive: archive,
+	}
+
+	server.echo.HideBanner = true
+	server.configureMiddleware()
+	server.configureRoutes()
+
+	return server
+}
+
+// LogSkipper implements the `middleware.Skipper` interface,
+// skip all ECHO logs for the blob archive server.
+func LogSkipper(c echo.Context) bool {
+	return true
+}
+
+// configureMiddleware configures the server middlewares.
+func (s *BlobArchiveServer) configureMiddleware() {
+	s.echo.Use(middleware.RequestID())
+
+	// nolint:staticcheck
+	s.echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Skipper: LogSkipper,
+		Format: `{"time":"${time_rfc3339_nano}","level":"INFO","message":{"id":"${id}","remote_ip":"${remote_ip}",` +
+			`"host":"${host}","method":"${method}","uri":"${uri}","user_agent":"${user_agent}",` +
+			`"response_status":${status},"error":"${error}","latency":${latency},"latency_human":"${latency_human}",` +
+			`"bytes_in":${bytes_in},"bytes_out":${bytes_out}}}` + "\n",
+		Output: os.Stdout,
+	}))
+}
+
+// Start starts the HTTP server.
+func (s *BlobArchiveServer) Start(port uint64) error {
+	return s.echo.Start(fmt.Sprintf(":%v", port))
+}
+
+// Shutdown shuts down the HTTP server.
+func (s *BlobArchiveServer) Shutdown(ctx context.Context) error {
+	return s.echo.Shutdown(ctx)
+}
+
+// configureRoutes contains all routes which will be used by the HTTP server.
+func (s *BlobArchiveServer) configureRoutes() {
+	s.echo.GET("/", s.HealthCheck)
+	s.echo.GET("/healthz", s.HealthCheck)
+	s.echo.GET("/blobs/:versionedHash", s.GetBlob)
+}
+
+// HealthCheck is the endpoints for probes.
+//
+//	@Summary		Health check
+//	@ID			   	health-check
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} string
+//	@Router			/healthz [get]
+func (s *BlobArchiveServer) HealthCheck(c echo.Context) error {
+	return c.NoContent(http.StatusOK)
+}
+
+// GetBlob returns the archived blob with the given versioned hash.
+//
+//	@Summary		Get an archived blob by its versioned hash
+//	@Param			versionedHash	path	string	true	"blob versioned hash"
+//	@Produce		json
+//	@Success		200	{object} rpc.BlobServerResponse
+//	@Router			/blobs/{versionedHash} [get]
+func (s *BlobArchiveServer) GetBlob(c echo.Context) error {
+	param := c.Param("versionedHash")
+	if len(common.FromHex(param)) != common.HashLength {
+		return s.returnError(c, http.StatusBadRequest, errors.New("invalid versioned hash"))
+	}
+
+	response, err := s.archive.Get(common.HexToHash(param))
+	if err != nil {
+		if errors.Is(err, rpc.ErrBlobNotArchived) {
+			return s.returnError(c, http.StatusNotFound, err)
+		}
+		return s.returnError(c, http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// returnError is a helper function to return an error response.
+func (s *BlobArchiveServer) returnError(c echo.Context, statusCode int, err error) error {
+	log.Debug("Blob archive request error", "status", statusCode, "error", err.Error())
+
+	return c.JSON(statusCode, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,198 @@
+package derivation
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	consensus "github.com/ethereum/go-ethereum/consensus/taiko"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// ReplayedBlock is a L2 block derived from a proposal without inserting it into the L2 execution engine.
+type ReplayedBlock struct {
+	Number            *big.Int       `json:"number"`
+	Timestamp         uint64         `json:"timestamp"`
+	Coinbase          common.Address `json:"coinbase"`
+	AnchorBlockNumber uint64         `json:"anchorBlockNumber"`
+	GasLimit          uint64         `json:"gasLimit"`
+	TxHashes          []common.Hash  `json:"txHashes"`
+	// Mismatches lists the differences with the same block on the L2 node, only set when diffing.
+	Mismatches []string `json:"mismatches,omitempty"`
+}
+
+// ReplayedSource is a derivation source of a replayed proposal.
+type ReplayedSource struct {
+	Index             int              `json:"index"`
+	IsForcedInclusion bool             `json:"isForcedInclusion"`
+	Default           bool             `json:"default"`
+	Blocks            []*ReplayedBlock `json:"blocks"`
+}
+
+// ReplayedProposal is the derivation result of a single proposal.
+type ReplayedProposal struct {
+	ProposalID *big.Int          `json:"proposalId"`
+	Proposer   common.Address    `json:"proposer"`
+	L1Height   *big.Int          `json:"l1Height"`
+	L1Hash     common.Hash       `json:"l1Hash"`
+	Timestamp  uint64            `json:"timestamp"`
+	Sources    []*ReplayedSource `json:"sources"`
+}
+
+// Replayer derives the L2 blocks of the given proposals offline, it fetches the blobs and applies the same
+// metadata rules as the driver, but never touches the engine API of the L2 execution engine.
+type Replayer struct {
+	rpc     *rpc.Client
+	fetcher *DerivationSourceFetcher
+}
+
+// NewReplayer creates a new Replayer instance.
+func NewReplayer(cli *rpc.Client, dataSource *rpc.BlobDataSource) *Replayer {
+	return &Replayer{rpc: cli, fetcher: NewDerivationSourceFetcher(cli, dataSource)}
+}
+
+// Replay derives the L2 blocks of the given proposal, the parent block of the proposal is read from the L2 node,
+// when diff is true, each derived block is also compared with the block at the same height on the L2 node.
+func (r *Replayer) Replay(ctx context.Context, proposalID *big.Int, diff bool) (*ReplayedProposal, error) {
+	if proposalID.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid proposal ID %d", proposalID)
+	}
+
+	event, eventLog, err := r.rpc.GetProposalByID(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	l1Header, err := r.rpc.L1.HeaderByHash(ctx, eventLog.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch L1 header %s: %w", eventLog.BlockHash, err)
+	}
+	meta := metadata.NewTaikoProposalMetadataShasta(event, l1Header.Time)
+
+	parentBlockID, err := r.rpc.ProposalLastBlockID(ctx, new(big.Int).Sub(proposalID, common.Big1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the last block of proposal %d: %w", proposalID.Uint64()-1, err)
+	}
+	parent, err := r.rpc.L2.BlockByNumber(ctx, parentBlockID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parent block %d: %w", parentBlockID, err)
+	}
+	parentAnchorBlockNumber, err := ParentAnchorBlockNumber(ctx, r.rpc, meta, parent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch parent anchor block number: %w", err)
+	}
+
+	result := &ReplayedProposal{
+		ProposalID: proposalID,
+		Proposer:   event.Proposer,
+		L1Height:   meta.GetRawBlockHeight(),
+		L1Hash:     meta.GetRawBlockHash(),
+		Timestamp:  meta.GetTimestamp(),
+		Sources:    make([]*ReplayedSource, 0, len(event.Sources)),
+	}
+	for i := range event.Sources {
+		sourcePayload, err := r.fetcher.Fetch(ctx, meta, i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch derivation payload for index %d: %w", i, err)
+		}
+		sourcePayload.ParentBlock = parent
+		ResolveSourcePayload(r.rpc, meta, i, sourcePayload, parentAnchorBlockNumber)
+
+		source := &ReplayedSource{
+			Index:             i,
+			IsForcedInclusion: event.Sources[i].IsForcedInclusion,
+			Default:           sourcePayload.Default,
+			Blocks:            make([]*ReplayedBlock, 0, len(sourcePayload.BlockPayloads)),
+		}
+		for j, blockPayload := range sourcePayload.BlockPayloads {
+			block := &ReplayedBlock{
+				Number:            new(big.Int).Add(parent.Number(), big.NewInt(int64(j+1))),
+				Timestamp:         blockPayload.Timestamp,
+				Coinbase:          blockPayload.Coinbase,
+				AnchorBlockNumber: blockPayload.AnchorBlockNumber,
+				GasLimit:          blockPayload.GasLimit,
+				TxHashes:          make([]common.Hash, 0, len(blockPayload.Transactions)),
+			}
+			for _, tx := range blockPayload.Transactions {
+				block.TxHashes = append(block.TxHashes, tx.Hash())
+			}
+			if diff {
+				if block.Mismatches, err = r.diff(ctx, block); err != nil {
+					return nil, err
+				}
+			}
+			source.Blocks = append(source.Blocks, block)
+		}
+		result.Sources = append(result.Sources, source)
+
+		// The derived blocks are never inserted, so the parent of the next source is rebuilt from the last
+		// derived block, the anchor block number in the last block is the highest one of the source.
+		last := sourcePayload.BlockPayloads[len(sourcePayload.BlockPayloads)-1]
+		parent = types.NewBlockWithHeader(&types.Header{
+			Number:   new(big.Int).Add(parent.Number(), big.NewInt(int64(len(sourcePayload.BlockPayloads)))),
+			Time:     last.Timestamp,
+			GasLimit: last.GasLimit + consensus.AnchorV3V4GasLimit,
+		})
+		parentAnchorBlockNumber = last.AnchorBlockNumber
+	}
+
+	return result, nil
+}
+
+// diff compares the given derived block with the block at the same height on the L2 node.
+func (r *Replayer) diff(ctx context.Context, derived *ReplayedBlock) ([]string, error) {
+	block, err := r.rpc.L2.BlockByNumber(ctx, derived.Number)
+	if err != nil {
+		return []string{fmt.Sprintf("block not found on L2 node: %v", err)}, nil
+	}
+
+	var mismatches []string
+	if block.Time() != derived.Timestamp {
+		mismatches = append(mismatches, fmt.Sprintf("timestamp: %d != %d", derived.Timestamp, block.Time()))
+	}
+	if block.Coinbase() != derived.Coinbase {
+		mismatches = append(mismatches, fmt.Sprintf("coinbase: %s != %s", derived.Coinbase, block.Coinbase()))
+	}
+	if block.GasLimit() != derived.GasLimit+consensus.AnchorV3V4GasLimit {
+		mismatches = append(
+			mismatches,
+			fmt.Sprintf("gas limit: %d != %d", derived.GasLimit+consensus.AnchorV3V4GasLimit, block.GasLimit()),
+		)
+	}
+	if block.Transactions().Len() == 0 {
+		return append(mismatches, "anchor transaction not found"), nil
+	}
+	_, anchorBlockNumber, _, err := r.rpc.GetSyncedL1SnippetFromAnchor(block.Transactions()[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode anchor transaction of block %d: %w", derived.Number, err)
+	}
+	if anchorBlockNumber != derived.AnchorBlockNumber {
+		mismatches = append(
+			mismatches,
+			fmt.Sprintf("anchor block number: %d != %d", derived.AnchorBlockNumber, anchorBlockNumber),
+		)
+	}
+
+	// The L2 execution engine drops the invalid transactions, so only report the missing and unexpected ones.
+	included := make(map[common.Hash]bool, block.Transactions().Len()-1)
+	for _, tx := range block.Transactions()[1:] {
+		included[tx.Hash()] = true
+	}
+	var dropped int
+	for _, hash := range derived.TxHashes {
+		if !included[hash] {
+			dropped++
+		}
+		delete(included, hash)
+	}
+	if dropped > 0 {
+		mismatches = append(mismatches, fmt.Sprintf("transactions not included: %d", dropped))
+	}
+	if len(included) > 0 {
+		mismatches = append(mismatches, fmt.Sprintf("unexpected transactions: %d", len(included)))
+	}
+	return mismatches, nil
+}
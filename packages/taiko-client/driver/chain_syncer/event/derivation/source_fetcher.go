@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	consensus "github.com/ethereum/go-ethereum/consensus/taiko"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/manifest"
@@ -90,12 +92,23 @@ func (f *DerivationSourceFetcher) manifestFromBlobBytes(
 		return defaultPayload, nil
 	}
 
-	if version != manifest.ShastaPayloadVersion {
+	// The manifest version marks the codec used to compress the manifest bytes, the versions of the
+	// brotli and zstd codecs are only valid from the compressed payloads fork.
+	if !manifest.IsPayloadVersionValid(f.cli.L2.ChainID, version, meta.GetTimestamp()) {
+		log.Warn(
+			"Unsupported manifest version, use default payload instead",
+			"version", version,
+			"proposalTimestamp", meta.GetTimestamp(),
+		)
+		return defaultPayload, nil
+	}
+	codec, err := utils.CodecByVersion(version)
+	if err != nil {
 		log.Warn("Unsupported manifest version, use default payload instead", "version", version)
 		return defaultPayload, nil
 	}
 
-	log.Info("Extracted manifest version and size from blobs", "version", version, "size", size)
+	log.Info("Extracted manifest version and size from blobs", "version", version, "codec", codec.Name(), "size", size)
 
 	// Ensure the blob slice [offset+64, offset+64+size) is within bounds before slicing.
 	start := offset + 64
@@ -109,8 +122,8 @@ func (f *DerivationSourceFetcher) manifestFromBlobBytes(
 		)
 		return defaultPayload, nil
 	}
-	encoded, err := utils.Decompress(b[start : start+int(size)])
 	// Decompress the manifest bytes.
+	encoded, err := codec.Decompress(b[start : start+int(size)])
 	if err != nil {
 		log.Warn(
 			"Failed to decompress manifest bytes, use default payload instead",
@@ -230,6 +243,82 @@ func ExtractSize(data []byte, offset int) (uint64, error) {
 	return size.Uint64(), nil
 }
 
+// ParentAnchorBlockNumber returns the anchor block number recorded by the given parent block, which is the
+// lower bound of the anchor block numbers in the next derivation source.
+func ParentAnchorBlockNumber(
+	ctx context.Context,
+	cli *rpc.Client,
+	meta metadata.TaikoProposalMetaDataShasta,
+	parent *types.Block,
+) (uint64, error) {
+	proposalID := meta.GetEventData().Id
+	if cli.L2.ChainID.Cmp(params.TaikoMainnetNetworkID) == 0 &&
+		proposalID.Uint64() <= manifest.MainnetAnchorCheckSkipProposalOffset {
+		_, anchorBlockNumber, _, err := cli.GetSyncedL1SnippetFromAnchor(parent.Transactions()[0])
+		return anchorBlockNumber, err
+	}
+
+	latestBlockState, err := cli.GetAnchorState(&bind.CallOpts{BlockHash: parent.Hash(), Context: ctx})
+	if err != nil {
+		return 0, err
+	}
+	if proposalID.Cmp(common.Big1) == 0 && parent.Number().Cmp(common.Big0) != 0 {
+		_, anchorBlockNumber, _, err := cli.GetSyncedL1SnippetFromAnchor(parent.Transactions()[0])
+		return anchorBlockNumber, err
+	}
+	return latestBlockState.AnchorBlockNumber.Uint64(), nil
+}
+
+// ResolveSourcePayload applies the protocol metadata rules to the given derivation source payload, whose
+// parent block must be set. A forced-inclusion source inherits its metadata from the parent block at first,
+// then the payload is validated and replaced with the default payload if the validation fails. It returns
+// false if the default payload is used.
+func ResolveSourcePayload(
+	cli *rpc.Client,
+	meta metadata.TaikoProposalMetaDataShasta,
+	derivationIdx int,
+	sourcePayload *DerivationSourcePayload,
+	parentAnchorBlockNumber uint64,
+) bool {
+	isForcedInclusion := meta.GetEventData().Sources[derivationIdx].IsForcedInclusion
+
+	// If the derivation source is forced inclusion, we apply inherited metadata first.
+	if isForcedInclusion {
+		ApplyInheritedMetadata(
+			sourcePayload,
+			meta.GetEventData(),
+			meta.GetTimestamp(),
+			parentAnchorBlockNumber,
+			cli.L2.ChainID,
+		)
+	}
+
+	if ValidateMetadata(
+		cli,
+		sourcePayload,
+		meta.GetEventData(),
+		meta.GetTimestamp(),
+		meta.GetRawBlockHeight().Uint64()-1,
+		parentAnchorBlockNumber,
+		isForcedInclusion,
+	) {
+		return true
+	}
+
+	sourcePayload.Default = true
+	sourcePayload.BlockPayloads = []*BlockPayload{
+		{BlockManifest: manifest.BlockManifest{Transactions: types.Transactions{}}},
+	}
+	ApplyInheritedMetadata(
+		sourcePayload,
+		meta.GetEventData(),
+		meta.GetTimestamp(),
+		parentAnchorBlockNumber,
+		cli.L2.ChainID,
+	)
+	return false
+}
+
 // ValidateMetadata validates block-level metadata according to protocol rules, return true if validation passes.
 func ValidateMetadata(
 	rpc *rpc.Client,
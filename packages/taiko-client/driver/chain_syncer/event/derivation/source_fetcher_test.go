@@ -15,6 +15,7 @@ import (
 	shastaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/shasta"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
 	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
 )
 
@@ -63,6 +64,37 @@ func (s *DerivationSourceFetcherTestSuite) TestManifestEncodeDecode() {
 	s.Equal(len(m.Blocks[0].Transactions), len(decoded.BlockPayloads[0].Transactions))
 }
 
+func (s *DerivationSourceFetcherTestSuite) TestManifestDecodeDispatchesOnCodecVersion() {
+	chainID := s.RPCClient.L2.ChainID.Uint64()
+	s.T().Cleanup(func() { delete(manifest.CompressedPayloadsForkTimes, chainID) })
+	manifest.CompressedPayloadsForkTimes[chainID] = 100
+
+	fetcher := &DerivationSourceFetcher{cli: s.RPCClient}
+
+	for _, codec := range utils.Codecs() {
+		b, err := builder.EncodeSourceManifestWithCodec(sourceManifestWithBlockCount(2), codec)
+		s.Nil(err)
+
+		decoded, err := fetcher.manifestFromBlobBytes(b, shastaMetaWithTimestamp(100), 0)
+		s.Nil(err)
+		s.False(decoded.Default, codec.Name())
+		s.Equal(2, len(decoded.BlockPayloads), codec.Name())
+
+		// Before the compressed payloads fork, only the zlib payload version is valid.
+		decoded, err = fetcher.manifestFromBlobBytes(b, shastaMetaWithTimestamp(99), 0)
+		s.Nil(err)
+		s.Equal(codec.Version() != manifest.ShastaPayloadVersion, decoded.Default, codec.Name())
+	}
+
+	// A manifest compressed by one codec but marked with another version falls back to the default payload.
+	b, err := builder.EncodeSourceManifestWithCodec(sourceManifestWithBlockCount(2), utils.ZstdCodec{})
+	s.Nil(err)
+	b[31] = byte(manifest.BrotliPayloadVersion)
+	decoded, err := fetcher.manifestFromBlobBytes(b, shastaMetaWithTimestamp(100), 0)
+	s.Nil(err)
+	s.True(decoded.Default)
+}
+
 func (s *DerivationSourceFetcherTestSuite) TestForcedInclusionMustBeSingleBlock() {
 	// A forced-inclusion source must contain exactly one block. The rule is keyed on the source's
 	// IsForcedInclusion flag rather than its position, so a multi-block forced inclusion degrades to
@@ -13,10 +13,8 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
-	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/manifest"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
 	anchorTxConstructor "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/anchor_tx_constructor"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer/beaconsync"
@@ -41,6 +39,7 @@ type Syncer struct {
 	reorgDetectedFlag      bool
 
 	// Derivation source fetcher
+	blobDataSource          *rpc.BlobDataSource
 	derivationSourceFetcher *derivation.DerivationSourceFetcher
 }
 
@@ -71,6 +70,7 @@ func NewSyncer(
 			constructor,
 			latestSeenProposalCh,
 		),
+		blobDataSource:          blobDataSource,
 		derivationSourceFetcher: derivation.NewDerivationSourceFetcher(client, blobDataSource),
 	}, nil
 }
@@ -286,7 +286,6 @@ func (s *Syncer) processProposal(
 			return fmt.Errorf("missing derivation payload for index %d", derivationIdx)
 		}
 		sourcePayload.ParentBlock = parent
-		isForcedInclusion := meta.GetEventData().Sources[derivationIdx].IsForcedInclusion
 
 		log.Info(
 			"Parent block info for derivation payload",
@@ -298,64 +297,13 @@ func (s *Syncer) processProposal(
 			"parentTimestamp", sourcePayload.ParentBlock.Time(),
 		)
 
-		var lastAnchorBlockNumber uint64
-		if s.rpc.L2.ChainID.Cmp(params.TaikoMainnetNetworkID) == 0 &&
-			meta.GetEventData().Id.Uint64() <= manifest.MainnetAnchorCheckSkipProposalOffset {
-			if _, lastAnchorBlockNumber, _, err = s.rpc.GetSyncedL1SnippetFromAnchor(
-				sourcePayload.ParentBlock.Transactions()[0],
-			); err != nil {
-				return err
-			}
-		} else {
-			latestBlockState, err := s.rpc.GetAnchorState(
-				&bind.CallOpts{BlockHash: sourcePayload.ParentBlock.Hash(), Context: ctx},
-			)
-			if err != nil {
-				return err
-			}
-
-			lastAnchorBlockNumber = latestBlockState.AnchorBlockNumber.Uint64()
-			if meta.GetEventData().Id.Cmp(common.Big1) == 0 && sourcePayload.ParentBlock.Number().Cmp(common.Big0) != 0 {
-				if _, lastAnchorBlockNumber, _, err = s.rpc.GetSyncedL1SnippetFromAnchor(
-					sourcePayload.ParentBlock.Transactions()[0],
-				); err != nil {
-					return err
-				}
-			}
-		}
-
-		// If the derivation source is forced inclusion, we apply inherited metadata first.
-		if isForcedInclusion {
-			derivation.ApplyInheritedMetadata(
-				sourcePayload,
-				meta.GetEventData(),
-				meta.GetTimestamp(),
-				lastAnchorBlockNumber,
-				s.rpc.L2.ChainID,
-			)
+		lastAnchorBlockNumber, err := derivation.ParentAnchorBlockNumber(ctx, s.rpc, meta, sourcePayload.ParentBlock)
+		if err != nil {
+			return err
 		}
 
 		// If the derivation source payload's metadata is invalid, we replace it with default metadata.
-		if !derivation.ValidateMetadata(
-			s.rpc,
-			sourcePayload,
-			meta.GetEventData(),
-			meta.GetTimestamp(),
-			meta.GetRawBlockHeight().Uint64()-1,
-			lastAnchorBlockNumber,
-			isForcedInclusion,
-		) {
-			sourcePayload.Default = true
-			sourcePayload.BlockPayloads = []*derivation.BlockPayload{
-				{BlockManifest: manifest.BlockManifest{Transactions: types.Transactions{}}},
-			}
-			derivation.ApplyInheritedMetadata(
-				sourcePayload,
-				meta.GetEventData(),
-				meta.GetTimestamp(),
-				lastAnchorBlockNumber,
-				s.rpc.L2.ChainID,
-			)
+		if !derivation.ResolveSourcePayload(s.rpc, meta, derivationIdx, sourcePayload, lastAnchorBlockNumber) {
 			log.Info(
 				"Use default derivation payload",
 				"proposalID", meta.GetEventData().Id,
@@ -458,6 +406,11 @@ func (s *Syncer) checkReorg(
 	return reorgCheckResult, nil
 }
 
+// SetBlobArchive sets the local blob archive used by the derivation blob data source.
+func (s *Syncer) SetBlobArchive(archive rpc.BlobArchive) {
+	s.blobDataSource.SetArchive(archive)
+}
+
 // BlocksInserter returns the blocks inserter.
 func (s *Syncer) BlocksInserter() *blocksInserter.Shasta {
 	return s.blocksInserter.(*blocksInserter.Shasta)
@@ -43,6 +43,8 @@ type Config struct {
 	P2PSync                       bool
 	RetryInterval                 time.Duration
 	BlobServerEndpoint            *url.URL
+	BlobArchiveDir                string
+	BlobArchiveServerPort         uint64
 	PreconfBlockServerPort        uint64
 	PreconfBlockServerJWTSecret   []byte
 	PreconfBlockServerCORSOrigins string
@@ -87,6 +89,10 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, errors.New("empty L1 beacon endpoint, blob server and Social Scan endpoint")
 	}
 
+	if c.Uint64(flags.BlobArchiveServerPort.Name) > 0 && c.String(flags.BlobArchiveDir.Name) == "" {
+		return nil, errors.New("blob.archive.dir is required when blob.archive.serverPort is enabled")
+	}
+
 	var preconfBlockServerJWTSecret []byte
 	if c.String(flags.PreconfBlockServerJWTSecret.Name) != "" {
 		if preconfBlockServerJWTSecret, err = jwt.ParseSecretFromFile(
@@ -166,6 +172,8 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		RetryInterval:                 c.Duration(flags.BackOffRetryInterval.Name),
 		P2PSync:                       p2pSync,
 		BlobServerEndpoint:            blobServerEndpoint,
+		BlobArchiveDir:                c.String(flags.BlobArchiveDir.Name),
+		BlobArchiveServerPort:         c.Uint64(flags.BlobArchiveServerPort.Name),
 		PreconfBlockServerPort:        c.Uint64(flags.PreconfBlockServerPort.Name),
 		PreconfBlockServerJWTSecret:   preconfBlockServerJWTSecret,
 		PreconfBlockServerCORSOrigins: c.String(flags.PreconfBlockServerCORSOrigins.Name),
@@ -22,6 +22,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/encoding"
+	blobServer "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/blob_server"
 	chainSyncer "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/chain_syncer"
 	preconfBlocks "github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/preconf_blocks"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/driver/state"
@@ -41,6 +42,10 @@ const (
 // block server to drain in-flight requests.
 const preconfServerShutdownTimeout = 5 * time.Second
 
+// blobArchiveServerShutdownTimeout bounds how long Close waits for the blob
+// archive server, whose responses can carry many blobs, to finish serving them.
+const blobArchiveServerShutdownTimeout = 10 * time.Second
+
 // Driver keeps the L2 execution engine's local block chain in sync with the TaikoInbox
 // contract.
 type Driver struct {
@@ -48,6 +53,7 @@ type Driver struct {
 	rpc                *rpc.Client
 	l2ChainSyncer      *chainSyncer.L2ChainSyncer
 	preconfBlockServer *preconfBlocks.PreconfBlockAPIServer
+	blobArchiveServer  *blobServer.BlobArchiveServer
 	state              *state.State
 	protocolConfig     config.ProtocolConfigs
 
@@ -118,6 +124,18 @@ func (d *Driver) InitFromConfig(ctx context.Context, cfg *Config) (err error) {
 		return fmt.Errorf("failed to create L2 chain syncer: %w", err)
 	}
 
+	if d.BlobArchiveDir != "" {
+		archive, err := rpc.NewFileBlobArchive(d.BlobArchiveDir)
+		if err != nil {
+			return fmt.Errorf("failed to create blob archive: %w", err)
+		}
+		d.l2ChainSyncer.EventSyncer().SetBlobArchive(archive)
+
+		if d.BlobArchiveServerPort > 0 {
+			d.blobArchiveServer = blobServer.New(archive)
+		}
+	}
+
 	d.l1HeadSub = d.state.SubL1HeadsFeed(d.l1HeadCh)
 	if d.protocolConfig, err = d.rpc.GetProtocolConfigs(&bind.CallOpts{Context: d.ctx}); err != nil {
 		return fmt.Errorf("failed to get protocol configs: %w", err)
@@ -203,6 +221,17 @@ func (d *Driver) Start() error {
 			d.preconfBlockServer.LatestSeenProposalEventLoop(d.ctx)
 		}()
 	}
+	// Start the blob archive server if it is enabled.
+	if d.blobArchiveServer != nil {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			if err := d.blobArchiveServer.Start(d.BlobArchiveServerPort); err != nil &&
+				!errors.Is(err, http.ErrServerClosed) {
+				log.Crit("Failed to start blob archive server", "error", err)
+			}
+		}()
+	}
 	if d.p2pNode != nil && d.p2pNode.Dv5Udp() != nil {
 		log.Info("Start P2P discovery process")
 
@@ -247,6 +276,13 @@ func (d *Driver) Close(_ context.Context) {
 			log.Error("Failed to shutdown preconfirmation block server", "error", err)
 		}
 	}
+	if d.blobArchiveServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), blobArchiveServerShutdownTimeout)
+		defer cancel()
+		if err := d.blobArchiveServer.Shutdown(shutdownCtx); err != nil {
+			log.Error("Failed to shutdown blob archive server", "error", err)
+		}
+	}
 	d.wg.Wait()
 }
 
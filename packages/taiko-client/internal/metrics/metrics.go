@@ -84,6 +84,16 @@ var (
 	ProposerProposedTxListsCounter = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txLists"})
 	ProposerProposedTxsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_proposed_txs"})
 	ProposerPoolContentFetchTime   = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_pool_content_fetch_time"})
+	ProposerSelectedTxsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_selection_selected_txs"})
+	ProposerPriorityTxsCounter     = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_selection_priority_txs"})
+	ProposerDeniedTxsCounter       = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_selection_denied_txs"})
+	ProposerCappedTxsCounter       = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_selection_capped_txs"})
+	ProposerInvalidTxsCounter      = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_selection_invalid_txs"})
+	ProposerOverBudgetTxsCounter   = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_selection_over_budget_txs"})
+	ProposerTxListsFeePerByteGauge = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_selection_fee_per_byte"})
+	ProposerEstimatedCostGauge     = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_estimated_cost"})
+	ProposerEstimatedRevenueGauge  = factory.NewGauge(prometheus.GaugeOpts{Name: "proposer_estimated_revenue"})
+	ProposerDeferredCounter        = factory.NewCounter(prometheus.CounterOpts{Name: "proposer_deferred_proposals"})
 
 	// Prover
 	ProverLatestVerifiedIDGauge      = factory.NewGauge(prometheus.GaugeOpts{Name: "prover_latestVerified_id"})
@@ -176,6 +186,27 @@ var (
 	ProverSubmissionRevertedCounter = factory.NewCounter(prometheus.CounterOpts{
 		Name: "prover_proof_submission_reverted",
 	})
+	ProverPolicyDecisionsCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "prover_policy_decisions_total",
+		Help: "Total number of proving policy decisions by action and reason",
+	}, []string{"action", "reason"})
+	ProverPolicyEstimatedProfitGauge = factory.NewGauge(prometheus.GaugeOpts{
+		Name: "prover_policy_estimated_profit",
+		Help: "Estimated profit in ETH of the latest evaluated proposal",
+	})
+	ProverBackendRequestsCounter = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "prover_backend_requests_total",
+		Help: "Total number of proof requests sent to each proof backend",
+	}, []string{"proof_type", "backend", "status"})
+	ProverBackendRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "prover_backend_request_duration_seconds",
+		Help:    "Duration of proof requests sent to each proof backend in seconds",
+		Buckets: HistogramBuckets,
+	}, []string{"proof_type", "backend"})
+	ProverBackendHealthyGauge = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "prover_backend_healthy",
+		Help: "Whether the proof backend is currently considered healthy",
+	}, []string{"proof_type", "backend"})
 
 	// TxManager
 	TxMgrMetrics   = txmgrMetrics.MakeTxMetrics("client", factory)
@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+)
+
+var ErrBlobNotArchived = errors.New("blob not found in archive")
+
+// blobArchiveFileExt is the file extension of the archived blobs.
+const blobArchiveFileExt = ".json"
+
+// BlobArchive is a content-addressed blob store keyed by the blob versioned hash.
+type BlobArchive interface {
+	Get(blobHash common.Hash) (*BlobServerResponse, error)
+	Put(blobHash common.Hash, sidecar *structs.Sidecar) error
+}
+
+// FileBlobArchive is a BlobArchive which keeps every blob in its own file under the given
+// directory, the files are sharded by the first byte of the versioned hash. Blobs are immutable,
+// so an existing file is never rewritten.
+type FileBlobArchive struct {
+	dir string
+}
+
+// NewFileBlobArchive creates a new FileBlobArchive instance.
+func NewFileBlobArchive(dir string) (*FileBlobArchive, error) {
+	if len(dir) == 0 {
+		return nil, errors.New("empty blob archive directory")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob archive directory: %w", err)
+	}
+
+	return &FileBlobArchive{dir: dir}, nil
+}
+
+// Get implements the BlobArchive interface, ErrBlobNotArchived is returned if the blob
+// has not been archived yet.
+func (a *FileBlobArchive) Get(blobHash common.Hash) (*BlobServerResponse, error) {
+	data, err := os.ReadFile(a.blobPath(blobHash))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrBlobNotArchived
+		}
+		return nil, fmt.Errorf("failed to read archived blob %s: %w", blobHash, err)
+	}
+
+	response := new(BlobServerResponse)
+	if err := json.Unmarshal(data, response); err != nil {
+		return nil, fmt.Errorf("failed to decode archived blob %s: %w", blobHash, err)
+	}
+	if common.HexToHash(response.VersionedHash) != blobHash {
+		return nil, fmt.Errorf("archived blob versioned hash mismatch: %s != %s", response.VersionedHash, blobHash)
+	}
+	return response, nil
+}
+
+// Put implements the BlobArchive interface.
+func (a *FileBlobArchive) Put(blobHash common.Hash, sidecar *structs.Sidecar) error {
+	path := a.blobPath(blobHash)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(&BlobServerResponse{
+		Commitment:    hexutil.Encode(common.FromHex(sidecar.KzgCommitment)),
+		Data:          hexutil.Encode(common.FromHex(sidecar.Blob)),
+		VersionedHash: blobHash.Hex(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode blob %s: %w", blobHash, err)
+	}
+
+	shardDir := filepath.Dir(path)
+	if err := os.MkdirAll(shardDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create blob archive shard directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(shardDir, "blob-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temporary blob file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary blob file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to persist blob file: %w", err)
+	}
+
+	return nil
+}
+
+// blobPath returns the file path of the blob with the given versioned hash.
+func (a *FileBlobArchive) blobPath(blobHash common.Hash) string {
+	hex := blobHash.Hex()
+	return filepath.Join(a.dir, hex[2:4], hex+blobArchiveFileExt)
+}
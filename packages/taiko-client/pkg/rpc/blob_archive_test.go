@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileBlobArchivePutGet(t *testing.T) {
+	archive, err := NewFileBlobArchive(t.TempDir())
+	require.NoError(t, err)
+
+	blob, commitment, blobHash := testBlobWithCommitment(t, []byte("archived derivation data"))
+
+	_, err = archive.Get(blobHash)
+	require.ErrorIs(t, err, ErrBlobNotArchived)
+
+	require.NoError(t, archive.Put(blobHash, &structs.Sidecar{
+		KzgCommitment: common.Bytes2Hex(commitment[:]),
+		Blob:          blob.String(),
+	}))
+
+	response, err := archive.Get(blobHash)
+	require.NoError(t, err)
+	require.Equal(t, blobHash, common.HexToHash(response.VersionedHash))
+	require.Equal(t, commitment[:], common.FromHex(response.Commitment))
+	require.Equal(t, blob.String(), response.Data)
+}
+
+func TestBlobDataSourceServesArchivedBlobs(t *testing.T) {
+	blob, commitment, blobHash := testBlobWithCommitment(t, []byte("archived derivation data"))
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(BlobServerResponse{
+			VersionedHash: blobHash.String(),
+			Commitment:    common.Bytes2Hex(commitment[:]),
+			Data:          blob.String(),
+		}))
+	}))
+	defer server.Close()
+
+	endpoint, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	archive, err := NewFileBlobArchive(t.TempDir())
+	require.NoError(t, err)
+
+	ds := NewBlobDataSource(context.Background(), &Client{}, endpoint)
+	ds.SetArchive(archive)
+
+	for i := 0; i < 2; i++ {
+		sidecars, err := ds.GetSidecars(context.Background(), 0, []common.Hash{blobHash})
+		require.NoError(t, err)
+		require.Len(t, sidecars, 1)
+		require.Equal(t, blob.String(), sidecars[0].Blob)
+	}
+	require.Equal(t, 1, requests)
+}
@@ -26,6 +26,7 @@ type BlobDataSource struct {
 	ctx                context.Context
 	client             *Client
 	blobServerEndpoint *url.URL
+	archive            BlobArchive
 }
 
 type BlobData struct {
@@ -56,6 +57,12 @@ func NewBlobDataSource(
 	}
 }
 
+// SetArchive sets the local blob archive, the archived blobs are served before querying the
+// L1 beacon node and the blob server, and every fetched blob is archived.
+func (ds *BlobDataSource) SetArchive(archive BlobArchive) {
+	ds.archive = archive
+}
+
 // UnmarshalJSON overwrites to parse data based on different json keys
 func (p *BlobServerResponse) UnmarshalJSON(data []byte) error {
 	var response struct {
@@ -124,6 +131,16 @@ func (ds *BlobDataSource) GetSidecars(
 		allSidecars []*structs.Sidecar
 		err         error
 	)
+	if ds.archive != nil {
+		archived, err := ds.getSidecarsFromArchive(blobHashes)
+		if err == nil {
+			log.Debug("Serving blobs from local archive", "timestamp", timestamp)
+			return archived, nil
+		}
+		if !errors.Is(err, ErrBlobNotArchived) {
+			log.Warn("Failed to read blobs from local archive", "timestamp", timestamp, "error", err)
+		}
+	}
 	if ds.client.L1Beacon == nil {
 		err = pkg.ErrBeaconNotFound
 	} else {
@@ -181,6 +198,35 @@ func (ds *BlobDataSource) GetSidecars(
 	if len(sidecars) != len(blobHashes) {
 		return nil, fmt.Errorf("blob sidecar count mismatch: expected %d, got %d", len(blobHashes), len(sidecars))
 	}
+	if ds.archive != nil {
+		for i, sidecar := range sidecars {
+			if err := ds.archive.Put(blobHashes[i], sidecar); err != nil {
+				log.Warn("Failed to archive blob", "blobHash", blobHashes[i], "error", err)
+			}
+		}
+	}
+	return sidecars, nil
+}
+
+// getSidecarsFromArchive reads all the given blobs from the local archive, each archived blob
+// is verified against its versioned hash, ErrBlobNotArchived is returned if any blob is missing.
+func (ds *BlobDataSource) getSidecarsFromArchive(blobHashes []common.Hash) ([]*structs.Sidecar, error) {
+	sidecars := make([]*structs.Sidecar, 0, len(blobHashes))
+	for _, blobHash := range blobHashes {
+		response, err := ds.archive.Get(blobHash)
+		if err != nil {
+			return nil, err
+		}
+		sidecar, err := sidecarFromBlobServer(&BlobData{
+			BlobHash:      response.VersionedHash,
+			KzgCommitment: response.Commitment,
+			Blob:          response.Data,
+		}, blobHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid archived blob %s: %w", blobHash, err)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
 	return sidecars, nil
 }
 
@@ -585,6 +585,17 @@ func (c *EthClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
 	return result, err
 }
 
+// BlobBaseFee retrieves the current blob base fee.
+func (c *EthClient) BlobBaseFee(ctx context.Context) (*big.Int, error) {
+	start := time.Now()
+	ctxWithTimeout, cancel := CtxWithTimeoutOrDefault(ctx, c.timeout)
+	defer cancel()
+
+	result, err := c.ethClient.BlobBaseFee(ctxWithTimeout)
+	recordRPCMetrics("eth_blobBaseFee", c.rpcURL, start, err)
+	return result, err
+}
+
 // FeeHistory retrieves the fee market history.
 func (c *EthClient) FeeHistory(
 	ctx context.Context,
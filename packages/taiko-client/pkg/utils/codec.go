@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/manifest"
+)
+
+// Codec compresses and decompresses the RLP encoded derivation source manifests.
+type Codec interface {
+	// Name returns the name of the codec.
+	Name() string
+	// Version returns the manifest payload version which marks the codec in blobs.
+	Version() uint32
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte) ([]byte, error)
+}
+
+var (
+	codecs = []Codec{ZlibCodec{}, BrotliCodec{}, ZstdCodec{}}
+)
+
+// Codecs returns all supported codecs.
+func Codecs() []Codec {
+	return codecs
+}
+
+// CodecByVersion returns the codec marked by the given manifest payload version.
+func CodecByVersion(version uint32) (Codec, error) {
+	for _, codec := range codecs {
+		if codec.Version() == version {
+			return codec, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported manifest payload version: %d", version)
+}
+
+// CodecByName returns the codec with the given name.
+func CodecByName(name string) (Codec, error) {
+	for _, codec := range codecs {
+		if codec.Name() == name {
+			return codec, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported compression codec: %s", name)
+}
+
+// ZlibCodec is the default codec of the Shasta payloads.
+type ZlibCodec struct{}
+
+// Name implements the Codec interface.
+func (ZlibCodec) Name() string { return "zlib" }
+
+// Version implements the Codec interface.
+func (ZlibCodec) Version() uint32 { return manifest.ShastaPayloadVersion }
+
+// Compress implements the Codec interface.
+func (ZlibCodec) Compress(data []byte) ([]byte, error) { return Compress(data) }
+
+// Decompress implements the Codec interface.
+func (ZlibCodec) Decompress(data []byte) ([]byte, error) { return Decompress(data) }
+
+// BrotliCodec compresses the manifests with brotli at the best compression level.
+type BrotliCodec struct{}
+
+// Name implements the Codec interface.
+func (BrotliCodec) Name() string { return "brotli" }
+
+// Version implements the Codec interface.
+func (BrotliCodec) Version() uint32 { return manifest.BrotliPayloadVersion }
+
+// Compress implements the Codec interface.
+func (BrotliCodec) Compress(data []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := brotli.NewWriterLevel(&b, brotli.BestCompression)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Decompress implements the Codec interface.
+func (BrotliCodec) Decompress(data []byte) ([]byte, error) {
+	return readAllLimited(brotli.NewReader(bytes.NewReader(data)))
+}
+
+// ZstdCodec compresses the manifests with zstd at the best compression level.
+type ZstdCodec struct{}
+
+// Name implements the Codec interface.
+func (ZstdCodec) Name() string { return "zstd" }
+
+// Version implements the Codec interface.
+func (ZstdCodec) Version() uint32 { return manifest.ZstdPayloadVersion }
+
+// Compress implements the Codec interface.
+func (ZstdCodec) Compress(data []byte) ([]byte, error) {
+	w, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression))
+	if err != nil {
+		return nil, err
+	}
+	defer w.Close()
+
+	return w.EncodeAll(data, nil), nil
+}
+
+// Decompress implements the Codec interface.
+func (ZstdCodec) Decompress(data []byte) ([]byte, error) {
+	r, err := zstd.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return readAllLimited(r)
+}
+
+// readAllLimited reads the decompressed manifest from the given reader, failing once it exceeds
+// manifest.MaxDecompressedPayloadBytes, so that a small payload can't exhaust the memory of the decoder.
+func readAllLimited(r io.Reader) ([]byte, error) {
+	b, err := io.ReadAll(io.LimitReader(r, manifest.MaxDecompressedPayloadBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > manifest.MaxDecompressedPayloadBytes {
+		return nil, fmt.Errorf("decompressed manifest exceeds %d bytes", manifest.MaxDecompressedPayloadBytes)
+	}
+	return b, nil
+}
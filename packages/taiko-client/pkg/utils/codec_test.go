@@ -0,0 +1,153 @@
+package utils_test
+
+import (
+	"encoding/binary"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/manifest"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/testutils"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
+)
+
+// manifestBenchDirEnv is the environment variable of a directory containing real derivation source
+// payloads (the 64 bytes version and size prefix followed by the compressed manifest), such as the
+// concatenated data of the blobs of a proposal.
+const manifestBenchDirEnv = "MANIFEST_BENCH_DIR"
+
+func TestCodecsRoundTrip(t *testing.T) {
+	b := testutils.RandomBytes(1024)
+
+	for _, codec := range utils.Codecs() {
+		compressed, err := codec.Compress(b)
+		require.NoError(t, err, codec.Name())
+
+		decompressed, err := codec.Decompress(compressed)
+		require.NoError(t, err, codec.Name())
+		require.Equal(t, b, decompressed, codec.Name())
+
+		byVersion, err := utils.CodecByVersion(codec.Version())
+		require.NoError(t, err)
+		require.Equal(t, codec, byVersion)
+
+		byName, err := utils.CodecByName(codec.Name())
+		require.NoError(t, err)
+		require.Equal(t, codec, byName)
+	}
+
+	_, err := utils.CodecByVersion(0)
+	require.Error(t, err)
+	_, err = utils.CodecByName("lz4")
+	require.Error(t, err)
+
+	// A small payload which decompresses beyond the limit is rejected.
+	bomb := make([]byte, manifest.MaxDecompressedPayloadBytes+1)
+	for _, codec := range []utils.Codec{utils.BrotliCodec{}, utils.ZstdCodec{}} {
+		compressed, err := codec.Compress(bomb)
+		require.NoError(t, err, codec.Name())
+		require.Less(t, len(compressed), manifest.BlobBytes, codec.Name())
+
+		_, err = codec.Decompress(compressed)
+		require.ErrorContains(t, err, "decompressed manifest exceeds", codec.Name())
+	}
+}
+
+// BenchmarkCodecs compares the codecs over the manifests in MANIFEST_BENCH_DIR, or synthetic manifests
+// of random transfers if it is not set, and reports the compressed size and the transactions per blob.
+func BenchmarkCodecs(b *testing.B) {
+	manifests := loadBenchManifests(b)
+
+	for _, codec := range utils.Codecs() {
+		b.Run(codec.Name(), func(b *testing.B) {
+			var size, txs int
+			for i := 0; i < b.N; i++ {
+				size, txs = 0, 0
+				for _, m := range manifests {
+					compressed, err := utils.EncodeAndCompressSourceManifestWithCodec(m, codec)
+					require.NoError(b, err)
+					size += len(compressed)
+					for _, block := range m.Blocks {
+						txs += len(block.Transactions)
+					}
+				}
+			}
+			b.ReportMetric(float64(size)/float64(len(manifests)), "bytes/manifest")
+			if size != 0 {
+				b.ReportMetric(float64(txs)*float64(eth.MaxBlobDataSize)/float64(size), "txs/blob")
+			}
+		})
+	}
+}
+
+// loadBenchManifests loads the manifests to benchmark.
+func loadBenchManifests(b *testing.B) []*manifest.DerivationSourceManifest {
+	dir := os.Getenv(manifestBenchDirEnv)
+	if dir == "" {
+		return syntheticManifests(b, 8, 512)
+	}
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(b, err)
+
+	var manifests []*manifest.DerivationSourceManifest
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		require.NoError(b, err)
+		require.GreaterOrEqual(b, len(data), 64, entry.Name())
+
+		codec, err := utils.CodecByVersion(binary.BigEndian.Uint32(data[28:32]))
+		require.NoError(b, err, entry.Name())
+		size := new(big.Int).SetBytes(data[32:64]).Uint64()
+		require.LessOrEqual(b, size, uint64(len(data)-64), entry.Name())
+
+		encoded, err := codec.Decompress(data[64 : 64+size])
+		require.NoError(b, err, entry.Name())
+		m := new(manifest.DerivationSourceManifest)
+		require.NoError(b, rlp.DecodeBytes(encoded, m), entry.Name())
+		manifests = append(manifests, m)
+	}
+	require.NotEmpty(b, manifests)
+	return manifests
+}
+
+// syntheticManifests builds manifests of random transfers.
+func syntheticManifests(b *testing.B, count int, txsPerManifest int) []*manifest.DerivationSourceManifest {
+	key, err := crypto.GenerateKey()
+	require.NoError(b, err)
+	signer := types.LatestSignerForChainID(big.NewInt(167))
+
+	manifests := make([]*manifest.DerivationSourceManifest, 0, count)
+	for i := 0; i < count; i++ {
+		txs := make(types.Transactions, 0, txsPerManifest)
+		for j := 0; j < txsPerManifest; j++ {
+			to := common.BytesToAddress(testutils.RandomBytes(20))
+			tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+				ChainID:   big.NewInt(167),
+				Nonce:     uint64(i*txsPerManifest + j),
+				GasTipCap: big.NewInt(1_000_000),
+				GasFeeCap: big.NewInt(10_000_000),
+				Gas:       21_000,
+				To:        &to,
+				Value:     big.NewInt(int64(j + 1)),
+			})
+			require.NoError(b, err)
+			txs = append(txs, tx)
+		}
+		manifests = append(manifests, &manifest.DerivationSourceManifest{
+			Blocks: []*manifest.BlockManifest{{Transactions: txs}},
+		})
+	}
+	return manifests
+}
@@ -16,12 +16,18 @@ import (
 // EncodeAndCompress RLP-encodes the provided data and returns the zlib-compressed bytes.
 // The descriptor clarifies the type of data in error messages.
 func EncodeAndCompress[T any](data T, descriptor string) ([]byte, error) {
+	return EncodeAndCompressWithCodec(data, descriptor, ZlibCodec{})
+}
+
+// EncodeAndCompressWithCodec RLP-encodes the provided data and returns the bytes compressed by the
+// given codec. The descriptor clarifies the type of data in error messages.
+func EncodeAndCompressWithCodec[T any](data T, descriptor string, codec Codec) ([]byte, error) {
 	b, err := rlp.EncodeToBytes(data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to RLP encode %s: %w", descriptor, err)
 	}
 
-	compressed, err := Compress(b)
+	compressed, err := codec.Compress(b)
 	if err != nil {
 		return nil, fmt.Errorf("failed to compress RLP encoded %s: %w", descriptor, err)
 	}
@@ -41,6 +47,15 @@ func EncodeAndCompressSourceManifest(sourceManifest *manifest.DerivationSourceMa
 	return EncodeAndCompress(sourceManifest, "derivation source manifest")
 }
 
+// EncodeAndCompressSourceManifestWithCodec encodes and compresses the given derivation source manifest
+// using RLP encoding followed by the given codec.
+func EncodeAndCompressSourceManifestWithCodec(
+	sourceManifest *manifest.DerivationSourceManifest,
+	codec Codec,
+) ([]byte, error) {
+	return EncodeAndCompressWithCodec(sourceManifest, "derivation source manifest", codec)
+}
+
 // Compress compresses the given txList bytes using zlib.
 func Compress(txList []byte) ([]byte, error) {
 	var b bytes.Buffer
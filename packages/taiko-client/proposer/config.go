@@ -16,6 +16,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
+	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/tx_selector"
 )
 
 // Config contains all configurations to initialize a Taiko proposer.
@@ -27,7 +28,11 @@ type Config struct {
 	MinTip                  uint64
 	MinProposingInternal    time.Duration
 	AllowZeroTipInterval    uint64
+	CostAware               bool
+	ProposeExecutionGas     uint64
 	MaxTxListsPerEpoch      uint64
+	TxSelection             *selector.Config
+	ManifestCodec           utils.Codec
 	ProposeBatchTxGasLimit  uint64
 	TxmgrConfigs            *txmgr.CLIConfig
 	PrivateTxmgrConfigs     *txmgr.CLIConfig
@@ -64,6 +69,20 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		)
 	}
 
+	priorityAccounts, err := parseAddresses(c.StringSlice(flags.PriorityAccounts.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", flags.PriorityAccounts.Name, err)
+	}
+	deniedAddresses, err := parseAddresses(c.StringSlice(flags.DeniedAddresses.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", flags.DeniedAddresses.Name, err)
+	}
+
+	manifestCodec, err := utils.CodecByName(c.String(flags.ManifestCodec.Name))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", flags.ManifestCodec.Name, err)
+	}
+
 	// Enforce WS endpoints after the format validations above, so existing
 	// error-precedence assertions in tests stay intact.
 	if err := flags.CheckWSEndpointsRequired(c, "proposer"); err != nil {
@@ -87,7 +106,16 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		MinProposingInternal:    c.Duration(flags.MinProposingInternal.Name),
 		MaxTxListsPerEpoch:      maxTxListsPerEpoch,
 		AllowZeroTipInterval:    c.Uint64(flags.AllowZeroTipInterval.Name),
-		ProposeBatchTxGasLimit:  c.Uint64(flags.TxGasLimit.Name),
+		CostAware:               c.Bool(flags.CostAware.Name),
+		ProposeExecutionGas:     c.Uint64(flags.ProposeExecutionGas.Name),
+		ManifestCodec:           manifestCodec,
+		TxSelection: &selector.Config{
+			PriorityAccounts:  priorityAccounts,
+			DeniedAddresses:   deniedAddresses,
+			MaxTxsPerSender:   c.Uint64(flags.MaxTxsPerSender.Name),
+			OrderByFeePerByte: c.Bool(flags.OrderByFeePerByte.Name),
+		},
+		ProposeBatchTxGasLimit: c.Uint64(flags.TxGasLimit.Name),
 		TxmgrConfigs: pkgFlags.InitTxmgrConfigsFromCli(
 			c.String(flags.L1WSEndpoint.Name),
 			l1ProposerPrivKey,
@@ -100,3 +128,15 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		),
 	}, nil
 }
+
+// parseAddresses parses the given hex addresses.
+func parseAddresses(values []string) ([]common.Address, error) {
+	addresses := make([]common.Address, 0, len(values))
+	for _, value := range values {
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("invalid address: %s", value)
+		}
+		addresses = append(addresses, common.HexToAddress(value))
+	}
+	return addresses, nil
+}
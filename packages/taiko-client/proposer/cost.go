@@ -0,0 +1,86 @@
+package proposer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ProposalCost is the estimated L1 cost of a proposal transaction.
+type ProposalCost struct {
+	GasUsed     uint64
+	BaseFee     *big.Int
+	GasTipCap   *big.Int
+	BlobBaseFee *big.Int
+	Blobs       uint64
+	// Total is the estimated total cost in wei, including the execution and the blob gas.
+	Total *big.Int
+}
+
+// estimateProposalCost estimates the L1 cost of the given proposal transaction candidate, the execution
+// gas is the intrinsic gas including the calldata, plus the estimated gas used by the inbox contract.
+func (p *Proposer) estimateProposalCost(ctx context.Context, txCandidate *txmgr.TxCandidate) (*ProposalCost, error) {
+	l1Head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 head: %w", err)
+	}
+	if l1Head.BaseFee == nil {
+		return nil, fmt.Errorf("missing base fee in L1 header %d", l1Head.Number)
+	}
+	gasTipCap, err := p.rpc.L1.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 gas tip cap: %w", err)
+	}
+	blobBaseFee, err := p.rpc.L1.BlobBaseFee(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get L1 blob base fee: %w", err)
+	}
+
+	return calculateProposalCost(txCandidate, p.ProposeExecutionGas, l1Head.BaseFee, gasTipCap, blobBaseFee), nil
+}
+
+// calculateProposalCost calculates the L1 cost of the given proposal transaction candidate with the
+// given L1 fees.
+func calculateProposalCost(
+	txCandidate *txmgr.TxCandidate,
+	executionGas uint64,
+	baseFee *big.Int,
+	gasTipCap *big.Int,
+	blobBaseFee *big.Int,
+) *ProposalCost {
+	gasUsed := params.TxGas + executionGas
+	for _, b := range txCandidate.TxData {
+		if b == 0 {
+			gasUsed += params.TxDataZeroGas
+		} else {
+			gasUsed += params.TxDataNonZeroGasEIP2028
+		}
+	}
+	if txCandidate.GasLimit != 0 {
+		gasUsed = min(gasUsed, txCandidate.GasLimit)
+	}
+
+	var (
+		blobs   = uint64(len(txCandidate.Blobs))
+		gasCost = new(big.Int).Mul(new(big.Int).Add(baseFee, gasTipCap), new(big.Int).SetUint64(gasUsed))
+		blobFee = new(big.Int).Mul(blobBaseFee, new(big.Int).SetUint64(blobs*params.BlobTxBlobGasPerBlob))
+	)
+	return &ProposalCost{
+		GasUsed:     gasUsed,
+		BaseFee:     baseFee,
+		GasTipCap:   gasTipCap,
+		BlobBaseFee: blobBaseFee,
+		Blobs:       blobs,
+		Total:       new(big.Int).Add(gasCost, blobFee),
+	}
+}
+
+// coversCost returns whether the given estimated tip revenue covers the given proposal cost, the revenue
+// must be estimated from the gas used by the transactions rather than their gas limits, which would
+// overstate it.
+func coversCost(revenue *big.Int, cost *ProposalCost) bool {
+	return revenue.Cmp(cost.Total) >= 0
+}
@@ -0,0 +1,66 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/txmgr"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/tx_selector"
+)
+
+func TestCalculateProposalCost(t *testing.T) {
+	txCandidate := &txmgr.TxCandidate{
+		TxData: []byte{0x00, 0x01, 0x02},
+		Blobs:  []*eth.Blob{{}, {}},
+	}
+
+	cost := calculateProposalCost(txCandidate, 100_000, big.NewInt(10), big.NewInt(2), big.NewInt(3))
+	gasUsed := params.TxGas + 100_000 + params.TxDataZeroGas + 2*params.TxDataNonZeroGasEIP2028
+	require.Equal(t, gasUsed, cost.GasUsed)
+	require.Equal(t, uint64(2), cost.Blobs)
+	require.Equal(
+		t,
+		new(big.Int).SetUint64(gasUsed*12+2*params.BlobTxBlobGasPerBlob*3),
+		cost.Total,
+	)
+
+	// The gas limit of the transaction candidate caps the gas used.
+	txCandidate.GasLimit = 50_000
+	cost = calculateProposalCost(txCandidate, 100_000, big.NewInt(10), big.NewInt(2), big.NewInt(3))
+	require.Equal(t, uint64(50_000), cost.GasUsed)
+}
+
+func TestCoversCostWithOverestimatedGasLimit(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainID := big.NewInt(167)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), &types.DynamicFeeTx{
+		ChainID:   chainID,
+		GasTipCap: big.NewInt(10),
+		GasFeeCap: big.NewInt(11),
+		Gas:       5_000_000,
+		To:        &common.Address{},
+	})
+	require.NoError(t, err)
+
+	// The cost is covered by the tips paid for the gas limit, but not by the tips paid for the gas used.
+	cost := calculateProposalCost(&txmgr.TxCandidate{}, 1_000_000, big.NewInt(1), big.NewInt(0), big.NewInt(1))
+	require.Equal(t, big.NewInt(int64(params.TxGas+1_000_000)), cost.Total)
+	require.True(t, coversCost(new(big.Int).Mul(big.NewInt(10), new(big.Int).SetUint64(tx.Gas())), cost))
+
+	_, stats := selector.New(&selector.Config{}, chainID).Select(
+		[]*miner.PreBuiltTxList{{TxList: types.Transactions{tx}, EstimatedGasUsed: 50_000}},
+		big.NewInt(1),
+		0,
+	)
+	require.Equal(t, big.NewInt(10*50_000), stats.Revenue)
+	require.False(t, coversCost(stats.Revenue, cost))
+}
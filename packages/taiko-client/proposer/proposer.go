@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum-optimism/optimism/op-service/eth"
 	"github.com/ethereum-optimism/optimism/op-service/txmgr"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -24,8 +25,12 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
 	builder "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/transaction_builder"
+	selector "github.com/taikoxyz/taiko-mono/packages/taiko-client/proposer/tx_selector"
 )
 
+// maxProposalBlobBytes is the blob bytes budget of the transactions in a single proposal.
+const maxProposalBlobBytes = eth.MaxBlobsPerBlobTx * eth.MaxBlobDataSize
+
 // Proposer keep proposing new transactions from L2 execution engine's tx pool at a fixed interval.
 type Proposer struct {
 	// configurations
@@ -42,6 +47,9 @@ type Proposer struct {
 	// Transaction builder
 	txBuilder *builder.BlobTransactionBuilder
 
+	// Transaction selection policies
+	txSelector *selector.TxSelector
+
 	// Protocol configurations
 	protocolConfigs config.ProtocolConfigs
 
@@ -117,12 +125,19 @@ func (p *Proposer) InitFromConfig(
 
 	p.txmgrSelector = utils.NewTxMgrSelector(txMgr, privateTxMgr, nil)
 	p.chainConfig = config.NewChainConfig(p.rpc.L2.ChainID, 0)
+	if cfg.TxSelection == nil {
+		cfg.TxSelection = &selector.Config{}
+	}
+	p.txSelector = selector.New(cfg.TxSelection, p.rpc.L2.ChainID)
 	p.txBuilder = builder.NewBlobTransactionBuilder(
 		p.rpc,
 		cfg.InboxAddress,
 		cfg.L2SuggestedFeeRecipient,
 		cfg.ProposeBatchTxGasLimit,
 	)
+	if cfg.ManifestCodec != nil {
+		p.txBuilder.SetCodec(cfg.ManifestCodec)
+	}
 
 	return nil
 }
@@ -167,7 +182,8 @@ func (p *Proposer) Close(_ context.Context) {
 }
 
 // fetchPoolContent fetches the transaction pool content from L2 execution engine.
-func (p *Proposer) fetchPoolContent(allowEmptyPoolContent bool) ([]types.Transactions, error) {
+// The estimated tip revenue of the selected transactions is returned along with the lists.
+func (p *Proposer) fetchPoolContent(allowEmptyPoolContent bool) ([]types.Transactions, *big.Int, error) {
 	var (
 		minTip  = p.MinTip
 		startAt = time.Now()
@@ -181,31 +197,34 @@ func (p *Proposer) fetchPoolContent(allowEmptyPoolContent bool) ([]types.Transac
 	// For proposals submission in current implementation, we always use the parent block's gas limit.
 	l2Head, err := p.rpc.L2.HeaderByNumber(p.ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get L2 head: %w", err)
+		return nil, nil, fmt.Errorf("failed to get L2 head: %w", err)
 	}
 
-	// Fetch the pool content.
+	// Fetch the pool content, the priority accounts are treated as local accounts by the L2 mempool.
 	preBuiltTxList, err := p.rpc.GetPoolContent(
 		p.ctx,
 		p.proposerAddress,
 		uint32(l2Head.GasLimit),
 		rpc.BlockMaxTxListBytes,
-		[]common.Address{},
+		p.TxSelection.PriorityAccounts,
 		p.MaxTxListsPerEpoch,
 		minTip,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch transaction pool content: %w", err)
+		return nil, nil, fmt.Errorf("failed to fetch transaction pool content: %w", err)
 	}
 
 	poolContentFetchTime := time.Since(startAt)
 	metrics.ProposerPoolContentFetchTime.Set(poolContentFetchTime.Seconds())
 
-	// Extract the transaction lists from the pre-built transaction lists information.
-	txLists := make([]types.Transactions, 0, len(preBuiltTxList))
-	for _, txs := range preBuiltTxList {
-		txLists = append(txLists, txs.TxList)
+	baseFee, err := p.rpc.CalculateBaseFee(p.ctx, l2Head)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to calculate L2 base fee: %w", err)
 	}
+
+	// Apply the transaction selection policies to the pre-built transaction lists.
+	txLists, stats := p.txSelector.Select(preBuiltTxList, baseFee, maxProposalBlobBytes)
+	reportTxSelectionStats(stats)
 	// If the pool content is empty and the `--epoch.minProposingInterval` flag is set, we check
 	// whether the proposer should propose an empty block.
 	if allowEmptyPoolContent && len(txLists) == 0 {
@@ -223,9 +242,32 @@ func (p *Proposer) fetchPoolContent(allowEmptyPoolContent bool) ([]types.Transac
 		"count", len(txLists),
 		"minTip", utils.WeiToEther(new(big.Int).SetUint64(minTip)),
 		"poolContentFetchTime", poolContentFetchTime,
+		"selectedTxs", stats.Selected,
+		"priorityTxs", stats.Priority,
+		"deniedTxs", stats.Denied,
+		"cappedTxs", stats.Capped,
+		"overBudgetTxs", stats.OverBudget,
+		"revenue", utils.WeiToEther(stats.Revenue),
 	)
 
-	return txLists, nil
+	return txLists, stats.Revenue, nil
+}
+
+// reportTxSelectionStats reports the transaction selection results via metrics.
+func reportTxSelectionStats(stats *selector.Stats) {
+	metrics.ProposerSelectedTxsCounter.Add(float64(stats.Selected))
+	metrics.ProposerPriorityTxsCounter.Add(float64(stats.Priority))
+	metrics.ProposerDeniedTxsCounter.Add(float64(stats.Denied))
+	metrics.ProposerCappedTxsCounter.Add(float64(stats.Capped))
+	metrics.ProposerInvalidTxsCounter.Add(float64(stats.Invalid))
+	metrics.ProposerOverBudgetTxsCounter.Add(float64(stats.OverBudget))
+	if stats.Bytes != 0 {
+		feePerByte, _ := new(big.Float).Quo(
+			new(big.Float).SetInt(stats.Revenue),
+			new(big.Float).SetUint64(stats.Bytes),
+		).Float64()
+		metrics.ProposerTxListsFeePerByteGauge.Set(feePerByte)
+	}
 }
 
 // ProposeOp performs a proposing operation, fetching transactions
@@ -257,7 +299,7 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 	)
 
 	// Fetch pending L2 transactions from mempool.
-	txLists, err := p.fetchPoolContent(allowEmptyPoolContent)
+	txLists, revenue, err := p.fetchPoolContent(allowEmptyPoolContent)
 	if err != nil {
 		return err
 	}
@@ -267,8 +309,21 @@ func (p *Proposer) ProposeOp(ctx context.Context) error {
 		return nil
 	}
 
+	// In cost-aware mode, the proposal is deferred until the collected tips cover its L1 cost,
+	// unless the `--epoch.minProposingInterval` flag forces one.
+	if !p.CostAware || (p.MinProposingInternal > 0 && allowEmptyPoolContent) {
+		revenue = nil
+	}
+
 	// Propose the transactions lists.
-	return p.ProposeTxLists(ctx, txLists)
+	proposed, err := p.proposeTxList(ctx, txLists, revenue)
+	if err != nil {
+		return err
+	}
+	if proposed {
+		p.lastProposedAt = time.Now()
+	}
+	return nil
 }
 
 // ProposeTxLists proposes the given transactions lists to TaikoInbox smart contract.
@@ -285,6 +340,18 @@ func (p *Proposer) ProposeTxLists(
 
 // ProposeTxList proposes the given transaction lists to the inbox contract.
 func (p *Proposer) ProposeTxList(ctx context.Context, proposalTxLists []types.Transactions) error {
+	_, err := p.proposeTxList(ctx, proposalTxLists, nil)
+	return err
+}
+
+// proposeTxList proposes the given transaction lists to the inbox contract, if the given revenue is not nil,
+// the proposal is only sent when the revenue covers its estimated L1 cost. Returns whether the proposal
+// has been sent.
+func (p *Proposer) proposeTxList(
+	ctx context.Context,
+	proposalTxLists []types.Transactions,
+	revenue *big.Int,
+) (bool, error) {
 	// Count the total number of transactions.
 	var txs uint64
 	for _, txList := range proposalTxLists {
@@ -294,12 +361,12 @@ func (p *Proposer) ProposeTxList(ctx context.Context, proposalTxLists []types.Tr
 	// Get the last proposal to ensure we are proposing a block after its NextProposalBlockId.
 	state, err := p.rpc.GetCoreState(&bind.CallOpts{Context: ctx})
 	if err != nil {
-		return fmt.Errorf("failed to get inbox core state: %w", err)
+		return false, fmt.Errorf("failed to get inbox core state: %w", err)
 	}
 
 	l1Head, err := p.rpc.L1.HeaderByNumber(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to get L1 head: %w", err)
+		return false, fmt.Errorf("failed to get L1 head: %w", err)
 	}
 
 	log.Info(
@@ -311,14 +378,14 @@ func (p *Proposer) ProposeTxList(ctx context.Context, proposalTxLists []types.Tr
 
 	if state.LastProposalBlockId.Cmp(l1Head.Number) >= 0 {
 		if l1Head, err = p.rpc.WaitL1Header(ctx, new(big.Int).Add(l1Head.Number, common.Big1)); err != nil {
-			return fmt.Errorf("failed to wait for next L1 block: %w", err)
+			return false, fmt.Errorf("failed to wait for next L1 block: %w", err)
 		}
 	}
 
 	// Proposer intentionally keeps the stricter Shasta cap. It is below the
 	// Unzen derivation-source cap, so proposals that pass here are safe there.
 	if len(proposalTxLists) > manifest.ProposalMaxBlocks {
-		return fmt.Errorf(
+		return false, fmt.Errorf(
 			"proposal exceeds proposalMaxBlocks: blocks=%d max=%d",
 			len(proposalTxLists),
 			manifest.ProposalMaxBlocks,
@@ -329,11 +396,35 @@ func (p *Proposer) ProposeTxList(ctx context.Context, proposalTxLists []types.Tr
 	txCandidate, err := p.txBuilder.Build(ctx, proposalTxLists)
 	if err != nil {
 		log.Warn("Failed to build Inbox.propose transaction", "error", encoding.TryParsingCustomError(err))
-		return err
+		return false, err
+	}
+
+	if revenue != nil {
+		cost, err := p.estimateProposalCost(ctx, txCandidate)
+		if err != nil {
+			return false, fmt.Errorf("failed to estimate proposal cost: %w", err)
+		}
+		costInEther, _ := utils.WeiToEther(cost.Total).Float64()
+		revenueInEther, _ := utils.WeiToEther(revenue).Float64()
+		metrics.ProposerEstimatedCostGauge.Set(costInEther)
+		metrics.ProposerEstimatedRevenueGauge.Set(revenueInEther)
+		if !coversCost(revenue, cost) {
+			log.Info(
+				"Deferring unprofitable proposal",
+				"revenue", utils.WeiToEther(revenue),
+				"cost", utils.WeiToEther(cost.Total),
+				"gasUsed", cost.GasUsed,
+				"baseFee", utils.WeiToGWei(cost.BaseFee),
+				"blobBaseFee", utils.WeiToGWei(cost.BlobBaseFee),
+				"blobs", cost.Blobs,
+			)
+			metrics.ProposerDeferredCounter.Add(1)
+			return false, nil
+		}
 	}
 
 	if err := p.SendTx(ctx, txCandidate); err != nil {
-		return err
+		return false, err
 	}
 
 	log.Info("📝 Propose inbox proposal succeeded", "blocksInProposal", len(proposalTxLists), "txs", txs)
@@ -341,7 +432,7 @@ func (p *Proposer) ProposeTxList(ctx context.Context, proposalTxLists []types.Tr
 	metrics.ProposerProposedTxListsCounter.Add(float64(len(proposalTxLists)))
 	metrics.ProposerProposedTxsCounter.Add(float64(txs))
 
-	return nil
+	return true, nil
 }
 
 // updateProposingTicker updates the internal proposing timer.
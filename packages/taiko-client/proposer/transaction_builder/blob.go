@@ -2,6 +2,7 @@ package builder
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"math/big"
@@ -27,6 +28,7 @@ type BlobTransactionBuilder struct {
 	inboxAddress            common.Address
 	l2SuggestedFeeRecipient common.Address
 	gasLimit                uint64
+	codec                   utils.Codec
 }
 
 // NewBlobTransactionBuilder creates a new BlobTransactionBuilder instance.
@@ -41,9 +43,15 @@ func NewBlobTransactionBuilder(
 		inboxAddress:            inboxAddress,
 		l2SuggestedFeeRecipient: l2SuggestedFeeRecipient,
 		gasLimit:                gasLimit,
+		codec:                   utils.ZlibCodec{},
 	}
 }
 
+// SetCodec sets the codec used to compress the derivation source manifests, the default one is zlib.
+func (b *BlobTransactionBuilder) SetCodec(codec utils.Codec) {
+	b.codec = codec
+}
+
 // Build builds an inbox propose transaction that carries the given transaction
 // lists in blobs, returning a tx candidate for the tx manager to send.
 func (b *BlobTransactionBuilder) Build(
@@ -93,8 +101,20 @@ func (b *BlobTransactionBuilder) Build(
 		})
 	}
 
+	// The proposal is included after the current L1 head, fall back to zlib if the configured codec
+	// won't be valid by then, otherwise the proposal would derive the default manifest.
+	codec := b.codec
+	if !manifest.IsPayloadVersionValid(b.rpc.L2.ChainID, codec.Version(), l1Head.Time+1) {
+		log.Warn(
+			"Manifest codec is not valid before the compressed payloads fork, use zlib instead",
+			"codec", codec.Name(),
+			"forkTime", manifest.CompressedPayloadsForkTimeByChainID(b.rpc.L2.ChainID),
+		)
+		codec = utils.ZlibCodec{}
+	}
+
 	// Encode the derivation source manifest.
-	sourceManifestBytes, err := EncodeSourceManifest(derivationSourceManifest)
+	sourceManifestBytes, err := EncodeSourceManifestWithCodec(derivationSourceManifest, codec)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode derivation source manifest: %w", err)
 	}
@@ -154,7 +174,16 @@ func SplitToBlobs(txListBytes []byte) ([]*eth.Blob, error) {
 // EncodeSourceManifest encodes the given derivation source manifest to a byte slice
 // that can be used as input to the inbox propose function.
 func EncodeSourceManifest(sourceManifest *manifest.DerivationSourceManifest) ([]byte, error) {
-	sourceManifestBytes, err := utils.EncodeAndCompressSourceManifest(sourceManifest)
+	return EncodeSourceManifestWithCodec(sourceManifest, utils.ZlibCodec{})
+}
+
+// EncodeSourceManifestWithCodec encodes the given derivation source manifest compressed by the given codec,
+// the payload version marks the codec for the decoders.
+func EncodeSourceManifestWithCodec(
+	sourceManifest *manifest.DerivationSourceManifest,
+	codec utils.Codec,
+) ([]byte, error) {
+	sourceManifestBytes, err := utils.EncodeAndCompressSourceManifestWithCodec(sourceManifest, codec)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +191,7 @@ func EncodeSourceManifest(sourceManifest *manifest.DerivationSourceManifest) ([]
 	// Prepend the version and length bytes to the manifest bytes, then split
 	// the resulting bytes into multiple blobs.
 	versionBytes := make([]byte, 32)
-	versionBytes[31] = byte(manifest.ShastaPayloadVersion)
+	binary.BigEndian.PutUint32(versionBytes[28:], codec.Version())
 
 	lenBytes := make([]byte, 32)
 	lenBig := new(big.Int).SetUint64(uint64(len(sourceManifestBytes)))
@@ -0,0 +1,311 @@
+package selector
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Config contains the configurations of the proposer transaction selection policies.
+type Config struct {
+	// PriorityAccounts are the senders whose transactions are always included and put at the
+	// front of each transaction list, they are neither capped nor denied.
+	PriorityAccounts []common.Address
+	// DeniedAddresses are the senders and contracts whose transactions are never proposed.
+	DeniedAddresses []common.Address
+	// MaxTxsPerSender is the maximum number of transactions proposed for a single sender in one
+	// epoch, 0 means unlimited.
+	MaxTxsPerSender uint64
+	// OrderByFeePerByte orders the transactions of each list by the proposer revenue per byte.
+	OrderByFeePerByte bool
+}
+
+// Stats are the results of a transaction selection.
+type Stats struct {
+	Selected   uint64
+	Priority   uint64
+	Denied     uint64
+	Capped     uint64
+	Invalid    uint64
+	OverBudget uint64
+	// Revenue is the estimated proposer tip revenue in wei of the selected transactions.
+	Revenue *big.Int
+	// Bytes is the estimated compressed size of the selected transactions in the blobs.
+	Bytes uint64
+}
+
+// TxSelector applies the selection policies to the transaction lists fetched from the L2 mempool.
+type TxSelector struct {
+	cfg      *Config
+	signer   types.Signer
+	priority map[common.Address]struct{}
+	denied   map[common.Address]struct{}
+}
+
+// New creates a new TxSelector instance.
+func New(cfg *Config, chainID *big.Int) *TxSelector {
+	s := &TxSelector{
+		cfg:      cfg,
+		signer:   types.LatestSignerForChainID(chainID),
+		priority: make(map[common.Address]struct{}, len(cfg.PriorityAccounts)),
+		denied:   make(map[common.Address]struct{}, len(cfg.DeniedAddresses)),
+	}
+	for _, addr := range cfg.PriorityAccounts {
+		s.priority[addr] = struct{}{}
+	}
+	for _, addr := range cfg.DeniedAddresses {
+		s.denied[addr] = struct{}{}
+	}
+	return s
+}
+
+// selectedTx is a transaction which passed the selection policies.
+type selectedTx struct {
+	tx         *types.Transaction
+	sender     common.Address
+	list       int
+	priority   bool
+	bytes      uint64
+	revenue    *big.Int
+	feePerByte *big.Int
+}
+
+// Select applies the selection policies to the given pre-built transaction lists, the lists emptied by
+// the policies are removed. Transactions are never moved between lists, and once a transaction of
+// a sender is dropped, all its following transactions are dropped too to avoid nonce gaps.
+// When the estimated compressed size of the transactions exceeds the given blob bytes budget, the
+// transactions paying the least per byte are dropped, 0 means no budget.
+func (s *TxSelector) Select(
+	txLists []*miner.PreBuiltTxList,
+	baseFee *big.Int,
+	maxBytes uint64,
+) ([]types.Transactions, *Stats) {
+	var (
+		stats      = &Stats{Revenue: new(big.Int)}
+		counts     = make(map[common.Address]uint64)
+		blocked    = make(map[common.Address]struct{})
+		candidates = make([]*selectedTx, 0)
+	)
+	for i, txList := range txLists {
+		usage := newListUsage(txList)
+		for _, tx := range txList.TxList {
+			sender, err := types.Sender(s.signer, tx)
+			if err != nil {
+				log.Debug("Failed to recover transaction sender", "hash", tx.Hash(), "error", err)
+				stats.Invalid++
+				continue
+			}
+			_, priority := s.priority[sender]
+
+			if _, ok := blocked[sender]; ok {
+				stats.Denied++
+				continue
+			}
+			if !priority && s.isDenied(sender, tx.To()) {
+				blocked[sender] = struct{}{}
+				stats.Denied++
+				continue
+			}
+			if !priority && s.cfg.MaxTxsPerSender != 0 && counts[sender] >= s.cfg.MaxTxsPerSender {
+				stats.Capped++
+				continue
+			}
+			counts[sender]++
+
+			var (
+				bytes   = usage.bytes(tx)
+				revenue = tipRevenue(tx, baseFee, usage.gasUsed(tx))
+			)
+			candidates = append(candidates, &selectedTx{
+				tx:         tx,
+				sender:     sender,
+				list:       i,
+				priority:   priority,
+				bytes:      bytes,
+				revenue:    revenue,
+				feePerByte: new(big.Int).Div(revenue, new(big.Int).SetUint64(bytes)),
+			})
+		}
+	}
+
+	admitted := admit(candidates, maxBytes)
+	stats.OverBudget = uint64(len(candidates) - len(admitted))
+
+	lists := make([][]*selectedTx, len(txLists))
+	for _, item := range candidates {
+		if _, ok := admitted[item]; !ok {
+			continue
+		}
+		lists[item.list] = append(lists[item.list], item)
+		if item.priority {
+			stats.Priority++
+		}
+		stats.Selected++
+		stats.Revenue.Add(stats.Revenue, item.revenue)
+		stats.Bytes += item.bytes
+	}
+
+	result := make([]types.Transactions, 0, len(txLists))
+	for _, selected := range lists {
+		if len(selected) == 0 {
+			continue
+		}
+		txs := make(types.Transactions, 0, len(selected))
+		for _, item := range s.order(selected) {
+			txs = append(txs, item.tx)
+		}
+		result = append(result, txs)
+	}
+
+	return result, stats
+}
+
+// admit returns the transactions which fit in the given blob bytes budget. The priority transactions
+// are always admitted, then the rest transactions by fee per byte while keeping the nonce order of each
+// sender, once a transaction of a sender doesn't fit, all its following transactions are dropped.
+func admit(txs []*selectedTx, maxBytes uint64) map[*selectedTx]struct{} {
+	var (
+		admitted = make(map[*selectedTx]struct{}, len(txs))
+		total    uint64
+	)
+	for _, item := range txs {
+		total += item.bytes
+	}
+	if maxBytes == 0 || total <= maxBytes {
+		for _, item := range txs {
+			admitted[item] = struct{}{}
+		}
+		return admitted
+	}
+
+	var (
+		remaining = maxBytes
+		rest      []*selectedTx
+	)
+	for _, item := range txs {
+		if item.priority {
+			admitted[item] = struct{}{}
+			remaining -= min(remaining, item.bytes)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	byFeePerByte(rest, func(item *selectedTx) bool {
+		if item.bytes > remaining {
+			return false
+		}
+		remaining -= item.bytes
+		admitted[item] = struct{}{}
+		return true
+	})
+	return admitted
+}
+
+// order puts the priority transactions at the front of the list, then the rest transactions either in
+// their original order, or by fee per byte while keeping the nonce order of each sender.
+func (s *TxSelector) order(txs []*selectedTx) []*selectedTx {
+	var priority, rest []*selectedTx
+	for _, item := range txs {
+		if item.priority {
+			priority = append(priority, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	if !s.cfg.OrderByFeePerByte {
+		return append(priority, rest...)
+	}
+
+	ordered := priority
+	byFeePerByte(rest, func(item *selectedTx) bool {
+		ordered = append(ordered, item)
+		return true
+	})
+	return ordered
+}
+
+// byFeePerByte visits the given transactions by fee per byte while keeping the nonce order of each sender,
+// once visit returns false for a transaction, the following transactions of its sender are skipped.
+func byFeePerByte(txs []*selectedTx, visit func(item *selectedTx) bool) {
+	// Group the transactions by sender, the transactions of each sender are already in nonce order.
+	var (
+		senders []common.Address
+		queues  = make(map[common.Address][]*selectedTx)
+	)
+	for _, item := range txs {
+		if _, ok := queues[item.sender]; !ok {
+			senders = append(senders, item.sender)
+		}
+		queues[item.sender] = append(queues[item.sender], item)
+	}
+
+	// Repeatedly pick the sender whose next transaction pays the most per byte.
+	for len(senders) > 0 {
+		sort.SliceStable(senders, func(i, j int) bool {
+			return queues[senders[i]][0].feePerByte.Cmp(queues[senders[j]][0].feePerByte) > 0
+		})
+		best := senders[0]
+		if !visit(queues[best][0]) {
+			queues[best] = nil
+		} else {
+			queues[best] = queues[best][1:]
+		}
+		if len(queues[best]) == 0 {
+			senders = senders[1:]
+		}
+	}
+}
+
+// listUsage estimates the gas used and the compressed size of each transaction of a pre-built list,
+// by sharing the estimated gas used and the compressed size of the whole list between its transactions.
+type listUsage struct {
+	estimatedGasUsed uint64
+	bytesLength      uint64
+	gasLimit         uint64
+	size             uint64
+}
+
+// newListUsage creates a new listUsage instance for the given pre-built list.
+func newListUsage(txList *miner.PreBuiltTxList) *listUsage {
+	usage := &listUsage{estimatedGasUsed: txList.EstimatedGasUsed, bytesLength: txList.BytesLength}
+	for _, tx := range txList.TxList {
+		usage.gasLimit += tx.Gas()
+		usage.size += tx.Size()
+	}
+	return usage
+}
+
+// gasUsed returns the estimated gas used by the given transaction, its share of the estimated gas used
+// of the list in proportion to its gas limit. Without an estimate of the list, the intrinsic gas of a
+// plain transfer is used as a lower bound.
+func (u *listUsage) gasUsed(tx *types.Transaction) uint64 {
+	if u.estimatedGasUsed == 0 || u.gasLimit == 0 {
+		return min(params.TxGas, tx.Gas())
+	}
+	share := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), new(big.Int).SetUint64(u.estimatedGasUsed))
+	return min(share.Div(share, new(big.Int).SetUint64(u.gasLimit)).Uint64(), tx.Gas())
+}
+
+// bytes returns the estimated compressed size of the given transaction, its share of the compressed size
+// of the list in proportion to its RLP encoded size.
+func (u *listUsage) bytes(tx *types.Transaction) uint64 {
+	if u.bytesLength == 0 || u.size == 0 {
+		return max(tx.Size(), 1)
+	}
+	return max((tx.Size()*u.bytesLength+u.size-1)/u.size, 1)
+}
+
+// tipRevenue returns the estimated tip paid to the proposer by the given transaction for the given
+// amount of gas used.
+func tipRevenue(tx *types.Transaction, baseFee *big.Int, gasUsed uint64) *big.Int {
+	tip := tx.EffectiveGasTipValue(baseFee)
+	if tip.Sign() <= 0 {
+		return new(big.Int)
+	}
+	return new(big.Int).Mul(tip, new(big.Int).SetUint64(gasUsed))
+}
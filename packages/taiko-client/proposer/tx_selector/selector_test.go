@@ -0,0 +1,166 @@
+package selector
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testChainID = big.NewInt(167)
+	testBaseFee = big.NewInt(1)
+)
+
+type testAccount struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+func newTestAccount(t *testing.T) *testAccount {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &testAccount{key: key, addr: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (a *testAccount) tx(t *testing.T, nonce uint64, tip int64, to common.Address) *types.Transaction {
+	return a.txWithGas(t, nonce, tip, to, 21_000)
+}
+
+func (a *testAccount) txWithGas(t *testing.T, nonce uint64, tip int64, to common.Address, gas uint64) *types.Transaction {
+	tx, err := types.SignNewTx(a.key, types.LatestSignerForChainID(testChainID), &types.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tip),
+		GasFeeCap: big.NewInt(tip + 1),
+		Gas:       gas,
+		To:        &to,
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestSelectWithoutPolicies(t *testing.T) {
+	alice, bob := newTestAccount(t), newTestAccount(t)
+	txs := types.Transactions{alice.tx(t, 0, 1, bob.addr), bob.tx(t, 0, 2, alice.addr)}
+
+	txLists, stats := New(&Config{}, testChainID).Select(
+		[]*miner.PreBuiltTxList{{TxList: txs}, {TxList: types.Transactions{}}},
+		testBaseFee,
+		0,
+	)
+	require.Len(t, txLists, 1)
+	require.Equal(t, txs, txLists[0])
+	require.Equal(t, uint64(2), stats.Selected)
+	require.Equal(t, big.NewInt(3*21_000), stats.Revenue)
+}
+
+func TestSelectDenylistAndCap(t *testing.T) {
+	alice, bob, carol, contract := newTestAccount(t), newTestAccount(t), newTestAccount(t), newTestAccount(t)
+
+	txLists, stats := New(&Config{
+		DeniedAddresses: []common.Address{bob.addr, contract.addr},
+		MaxTxsPerSender: 2,
+	}, testChainID).Select([]*miner.PreBuiltTxList{
+		{TxList: types.Transactions{
+			alice.tx(t, 0, 1, carol.addr),
+			bob.tx(t, 0, 1, carol.addr),
+			carol.tx(t, 0, 1, contract.addr),
+			carol.tx(t, 1, 1, alice.addr),
+		}},
+		{TxList: types.Transactions{
+			alice.tx(t, 1, 1, carol.addr),
+			alice.tx(t, 2, 1, carol.addr),
+		}},
+	}, testBaseFee, 0)
+
+	require.Len(t, txLists, 2)
+	require.Len(t, txLists[0], 1)
+	require.Equal(t, alice.addr, sender(t, txLists[0][0]))
+	require.Len(t, txLists[1], 1)
+	require.Equal(t, uint64(1), txLists[1][0].Nonce())
+	require.Equal(t, uint64(2), stats.Selected)
+	require.Equal(t, uint64(3), stats.Denied)
+	require.Equal(t, uint64(1), stats.Capped)
+}
+
+func TestSelectPriorityAndFeePerByteOrdering(t *testing.T) {
+	alice, bob, carol := newTestAccount(t), newTestAccount(t), newTestAccount(t)
+
+	txLists, stats := New(&Config{
+		PriorityAccounts:  []common.Address{carol.addr},
+		DeniedAddresses:   []common.Address{carol.addr},
+		MaxTxsPerSender:   1,
+		OrderByFeePerByte: true,
+	}, testChainID).Select([]*miner.PreBuiltTxList{{TxList: types.Transactions{
+		alice.tx(t, 0, 1, bob.addr),
+		bob.tx(t, 0, 5, alice.addr),
+		carol.tx(t, 0, 0, alice.addr),
+		carol.tx(t, 1, 0, alice.addr),
+	}}}, testBaseFee, 0)
+
+	require.Len(t, txLists, 1)
+	require.Len(t, txLists[0], 4)
+	require.Equal(t, carol.addr, sender(t, txLists[0][0]))
+	require.Equal(t, carol.addr, sender(t, txLists[0][1]))
+	require.Equal(t, bob.addr, sender(t, txLists[0][2]))
+	require.Equal(t, alice.addr, sender(t, txLists[0][3]))
+	require.Equal(t, uint64(2), stats.Priority)
+}
+
+func TestSelectRevenueFromEstimatedGasUsed(t *testing.T) {
+	alice, bob := newTestAccount(t), newTestAccount(t)
+	txs := types.Transactions{
+		alice.txWithGas(t, 0, 2, bob.addr, 1_000_000),
+		bob.txWithGas(t, 0, 2, alice.addr, 3_000_000),
+	}
+
+	// The gas used estimated for the whole list is shared in proportion to the gas limits.
+	_, stats := New(&Config{}, testChainID).Select(
+		[]*miner.PreBuiltTxList{{TxList: txs, EstimatedGasUsed: 100_000}},
+		testBaseFee,
+		0,
+	)
+	require.Equal(t, big.NewInt(2*100_000), stats.Revenue)
+
+	// Without an estimate, the gas used of a plain transfer is assumed.
+	_, stats = New(&Config{}, testChainID).Select([]*miner.PreBuiltTxList{{TxList: txs}}, testBaseFee, 0)
+	require.Equal(t, big.NewInt(2*2*21_000), stats.Revenue)
+}
+
+func TestSelectBlobBytesBudget(t *testing.T) {
+	alice, bob, carol, dave := newTestAccount(t), newTestAccount(t), newTestAccount(t), newTestAccount(t)
+	txs := types.Transactions{
+		carol.tx(t, 0, 0, alice.addr),
+		alice.tx(t, 0, 3, bob.addr),
+		bob.tx(t, 0, 1, alice.addr),
+		alice.tx(t, 1, 3, bob.addr),
+		dave.tx(t, 0, 2, alice.addr),
+		bob.tx(t, 1, 9, alice.addr),
+	}
+	budget := txs[0].Size() + txs[1].Size() + txs[3].Size() + txs[4].Size()
+
+	// The priority transaction is always kept, then the transactions paying the most per byte fill the
+	// budget, the following transactions of the dropped sender are dropped too.
+	txLists, stats := New(&Config{PriorityAccounts: []common.Address{carol.addr}}, testChainID).Select(
+		[]*miner.PreBuiltTxList{{TxList: txs}},
+		testBaseFee,
+		budget,
+	)
+	require.Len(t, txLists, 1)
+	require.Equal(t, types.Transactions{txs[0], txs[1], txs[3], txs[4]}, txLists[0])
+	require.Equal(t, uint64(4), stats.Selected)
+	require.Equal(t, uint64(2), stats.OverBudget)
+	require.Equal(t, budget, stats.Bytes)
+}
+
+func sender(t *testing.T, tx *types.Transaction) common.Address {
+	addr, err := types.Sender(types.LatestSignerForChainID(testChainID), tx)
+	require.NoError(t, err)
+	return addr
+}
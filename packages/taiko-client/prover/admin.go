@@ -0,0 +1,96 @@
+package prover
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	adminServer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/admin_server"
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	proofSubmitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
+)
+
+var _ adminServer.Prover = (*Prover)(nil)
+
+// SubmitterStatus implements the adminServer.Prover interface.
+func (p *Prover) SubmitterStatus() *proofSubmitter.Status {
+	status := p.proofSubmitter.Status()
+	status.Inflight = p.inflightRaikoRequests.List()
+	return status
+}
+
+// ProposalCursor implements the adminServer.Prover interface.
+func (p *Prover) ProposalCursor() (uint64, *types.Header) {
+	return p.sharedState.GetLastHandledProposalID(), p.sharedState.GetL1Current()
+}
+
+// ForceAggregate implements the adminServer.Prover interface.
+func (p *Prover) ForceAggregate(proofType proofProducer.ProofType) (bool, error) {
+	return p.proofSubmitter.ForceAggregate(proofType)
+}
+
+// FlushCache implements the adminServer.Prover interface, the flush is handed over to the event
+// loop which owns the proof buffers, and the method returns once the request is accepted.
+func (p *Prover) FlushCache(ctx context.Context, proofType proofProducer.ProofType) error {
+	if !slices.Contains(enabledProofTypes(p.cfg.ForceSGXProof, p.cfg.ZkOnlyProofs), proofType) {
+		return fmt.Errorf("proof type %s is not enabled", proofType)
+	}
+
+	select {
+	case p.flushCacheNotify <- proofType:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RewindProposalCursor implements the adminServer.Prover interface, the given proposal and all
+// proposals after it will be handled again in the next proposal iteration.
+func (p *Prover) RewindProposalCursor(ctx context.Context, proposalID *big.Int) error {
+	coreState, err := p.rpc.GetCoreState(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get core state: %w", err)
+	}
+	if proposalID.Cmp(coreState.LastFinalizedProposalId) <= 0 {
+		return fmt.Errorf(
+			"proposal %d is already finalized, last finalized proposal: %d",
+			proposalID,
+			coreState.LastFinalizedProposalId,
+		)
+	}
+
+	_, eventLog, err := p.rpc.GetProposalByID(ctx, proposalID)
+	if err != nil {
+		return fmt.Errorf("failed to get proposal by ID %d: %w", proposalID, err)
+	}
+	l1Header, err := p.rpc.L1.HeaderByHash(ctx, eventLog.BlockHash)
+	if err != nil {
+		return err
+	}
+
+	if !p.sharedState.RollbackProposalCursor(ctx, proposalID.Uint64()-1, l1Header) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// RequestProof implements the adminServer.Prover interface, it fetches the given proposal from L1
+// and hands it to the proposal handler, which only requests a proof if the proposal is provable
+// by the current prover and accepted by the proving policy.
+func (p *Prover) RequestProof(ctx context.Context, proposalID *big.Int) error {
+	event, eventLog, err := p.rpc.GetProposalByID(ctx, proposalID)
+	if err != nil {
+		return fmt.Errorf("failed to get proposal by ID %d: %w", proposalID, err)
+	}
+	l1Header, err := p.rpc.L1.HeaderByHash(ctx, eventLog.BlockHash)
+	if err != nil {
+		return err
+	}
+
+	return p.eventHandlers.proposalHandler.RequestProof(ctx, metadata.NewTaikoProposalMetadataShasta(event, l1Header.Time))
+}
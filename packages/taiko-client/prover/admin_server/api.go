@@ -0,0 +1,201 @@
+package adminserver
+
+import (
+	"errors"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/labstack/echo/v4"
+
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	submitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
+)
+
+// SharedState represents the proposal cursors of the prover.
+type SharedState struct {
+	// @param lastHandledProposalID uint64 the last proposal ID handled by the prover.
+	LastHandledProposalID uint64 `json:"lastHandledProposalId"`
+	// @param l1Current uint64 the L1 block number the proposal iterator starts from.
+	L1Current *big.Int `json:"l1Current"`
+	// @param l1CurrentHash string the L1 block hash the proposal iterator starts from.
+	L1CurrentHash string `json:"l1CurrentHash"`
+}
+
+// Status represents the current status of the prover.
+type Status struct {
+	*submitter.Status
+	SharedState *SharedState `json:"sharedState"`
+}
+
+// RewindRequestBody represents a request body when rewinding the proposal cursor.
+type RewindRequestBody struct {
+	// @param proposalId uint64 the first proposal ID to be handled again.
+	ProposalID *big.Int `json:"proposalId"`
+}
+
+// ActionResponseBody represents a response body of the admin actions.
+type ActionResponseBody struct {
+	// @param triggered bool whether the action has been triggered.
+	Triggered bool `json:"triggered"`
+}
+
+// HealthCheck is the endpoints for probes.
+//
+//	@Summary		Health check
+//	@ID			   	health-check
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} string
+//	@Router			/healthz [get]
+func (s *AdminAPIServer) HealthCheck(c echo.Context) error {
+	return c.NoContent(http.StatusOK)
+}
+
+// GetStatus returns the proof buffers, the proof caches, the SP1 fallback state, the in-flight
+// raiko requests and the proposal cursors of the prover.
+//
+//	@Summary		Get current prover status
+//	@Produce		json
+//	@Success		200	{object} Status
+//	@Router			/status [get]
+func (s *AdminAPIServer) GetStatus(c echo.Context) error {
+	return c.JSON(http.StatusOK, &Status{Status: s.prover.SubmitterStatus(), SharedState: s.sharedState()})
+}
+
+// GetProofBuffers returns the proposal IDs in each proof buffer and proof cache.
+//
+//	@Summary		Get proof buffers and caches
+//	@Produce		json
+//	@Success		200	{object} submitter.Status
+//	@Router			/proofBuffers [get]
+func (s *AdminAPIServer) GetProofBuffers(c echo.Context) error {
+	status := s.prover.SubmitterStatus()
+	return c.JSON(http.StatusOK, &submitter.Status{
+		ProofBuffers: status.ProofBuffers,
+		CachedProofs: status.CachedProofs,
+	})
+}
+
+// GetSP1Fallback returns the state of the RISC0-to-SP1 fallback state machine.
+//
+//	@Summary		Get SP1 fallback state
+//	@Produce		json
+//	@Success		200	{object} submitter.SP1FallbackStatus
+//	@Router			/sp1Fallback [get]
+func (s *AdminAPIServer) GetSP1Fallback(c echo.Context) error {
+	return c.JSON(http.StatusOK, s.prover.SubmitterStatus().SP1Fallback)
+}
+
+// GetSharedState returns the proposal cursors of the prover.
+//
+//	@Summary		Get proposal cursors
+//	@Produce		json
+//	@Success		200	{object} SharedState
+//	@Router			/sharedState [get]
+func (s *AdminAPIServer) GetSharedState(c echo.Context) error {
+	return c.JSON(http.StatusOK, s.sharedState())
+}
+
+// GetRaikoRequests returns the raiko proof requests which are still waiting for a response.
+//
+//	@Summary		Get in-flight raiko requests
+//	@Produce		json
+//	@Success		200	{array} producer.InflightRaikoRequest
+//	@Router			/raikoRequests [get]
+func (s *AdminAPIServer) GetRaikoRequests(c echo.Context) error {
+	return c.JSON(http.StatusOK, s.prover.SubmitterStatus().Inflight)
+}
+
+// ForceAggregate aggregates the proofs in the buffer of the given proof type right away.
+//
+//	@Summary		Force aggregating a proof buffer
+//	@Param			proofType	path	string	true	"proof type"
+//	@Produce		json
+//	@Success		200	{object} ActionResponseBody
+//	@Router			/proofBuffers/{proofType}/aggregate [post]
+func (s *AdminAPIServer) ForceAggregate(c echo.Context) error {
+	triggered, err := s.prover.ForceAggregate(producer.ProofType(c.Param("proofType")))
+	if err != nil {
+		return s.returnError(c, http.StatusBadRequest, err)
+	}
+	log.Info("Admin forced proof aggregation", "proofType", c.Param("proofType"), "triggered", triggered)
+	return c.JSON(http.StatusOK, &ActionResponseBody{Triggered: triggered})
+}
+
+// FlushCache flushes the cached proofs of the given proof type into its buffer.
+//
+//	@Summary		Flush a proof cache
+//	@Param			proofType	path	string	true	"proof type"
+//	@Produce		json
+//	@Success		200	{object} ActionResponseBody
+//	@Router			/proofCaches/{proofType}/flush [post]
+func (s *AdminAPIServer) FlushCache(c echo.Context) error {
+	if err := s.prover.FlushCache(c.Request().Context(), producer.ProofType(c.Param("proofType"))); err != nil {
+		return s.returnError(c, http.StatusBadRequest, err)
+	}
+	log.Info("Admin flushed proof cache", "proofType", c.Param("proofType"))
+	return c.JSON(http.StatusOK, &ActionResponseBody{Triggered: true})
+}
+
+// RewindProposalCursor rolls the proposal cursor back, so that the given proposal and all
+// proposals after it will be handled again.
+//
+//	@Summary		Rewind the proposal cursor
+//	@Param			request	body	RewindRequestBody	true	"rewind request body"
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object} SharedState
+//	@Router			/sharedState/rewind [post]
+func (s *AdminAPIServer) RewindProposalCursor(c echo.Context) error {
+	reqBody := new(RewindRequestBody)
+	if err := c.Bind(reqBody); err != nil {
+		return s.returnError(c, http.StatusUnprocessableEntity, err)
+	}
+	if reqBody.ProposalID == nil || reqBody.ProposalID.Sign() <= 0 {
+		return s.returnError(c, http.StatusBadRequest, errors.New("invalid proposal ID"))
+	}
+	if err := s.prover.RewindProposalCursor(c.Request().Context(), reqBody.ProposalID); err != nil {
+		return s.returnError(c, http.StatusInternalServerError, err)
+	}
+	log.Info("Admin rewound proposal cursor", "proposalID", reqBody.ProposalID)
+	return c.JSON(http.StatusOK, s.sharedState())
+}
+
+// RequestProof requests a new proof for the given proposal ID, the proposal is still checked
+// against the prover assignment and the proving policy.
+//
+//	@Summary		Re-request a proposal proof
+//	@Param			proposalId	path	integer	true	"proposal ID"
+//	@Produce		json
+//	@Success		200	{object} ActionResponseBody
+//	@Router			/proposals/{proposalId}/prove [post]
+func (s *AdminAPIServer) RequestProof(c echo.Context) error {
+	proposalID, ok := new(big.Int).SetString(c.Param("proposalId"), 10)
+	if !ok || proposalID.Sign() <= 0 {
+		return s.returnError(c, http.StatusBadRequest, errors.New("invalid proposal ID"))
+	}
+	if err := s.prover.RequestProof(c.Request().Context(), proposalID); err != nil {
+		return s.returnError(c, http.StatusInternalServerError, err)
+	}
+	log.Info("Admin re-requested proposal proof", "proposalID", proposalID)
+	return c.JSON(http.StatusOK, &ActionResponseBody{Triggered: true})
+}
+
+// sharedState returns the current proposal cursors of the prover.
+func (s *AdminAPIServer) sharedState() *SharedState {
+	lastHandledProposalID, l1Current := s.prover.ProposalCursor()
+	state := &SharedState{LastHandledProposalID: lastHandledProposalID}
+	if l1Current != nil {
+		state.L1Current = l1Current.Number
+		state.L1CurrentHash = l1Current.Hash().Hex()
+	}
+	return state
+}
+
+// returnError is a helper function to return an error response.
+func (s *AdminAPIServer) returnError(c echo.Context, statusCode int, err error) error {
+	log.Error("Prover admin request error", "status", statusCode, "error", err.Error())
+
+	return c.JSON(statusCode, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,129 @@
+package adminserver
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	echojwt "github.com/labstack/echo-jwt/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	submitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
+)
+
+// Prover is the prover functionality exposed by the admin server.
+type Prover interface {
+	// SubmitterStatus returns a snapshot of the proof buffers, caches and SP1 fallback state.
+	SubmitterStatus() *submitter.Status
+	// ProposalCursor returns the last handled proposal ID and the current L1 header cursor.
+	ProposalCursor() (uint64, *types.Header)
+	// ForceAggregate triggers the aggregation of the given proof type buffer.
+	ForceAggregate(proofType producer.ProofType) (bool, error)
+	// FlushCache flushes the cached proofs of the given proof type into its buffer.
+	FlushCache(ctx context.Context, proofType producer.ProofType) error
+	// RewindProposalCursor rolls the proposal cursor back, so that the given proposal and
+	// all proposals after it will be handled again.
+	RewindProposalCursor(ctx context.Context, proposalID *big.Int) error
+	// RequestProof requests a new proof for the given proposal ID, if the proposal is provable by
+	// the prover and accepted by its proving policy.
+	RequestProof(ctx context.Context, proposalID *big.Int) error
+}
+
+// @title Taiko Prover Admin API
+// @version 1.0
+// @termsOfService http://swagger.io/terms/
+
+// @contact.name API Support
+// @contact.url https://community.taiko.xyz/
+// @contact.email info@taiko.xyz
+
+// @license.name MIT
+// @license.url https://github.com/taikoxyz/taiko-mono/blob/main/LICENSE
+// AdminAPIServer represents a prover admin server instance, which is used for inspecting
+// and controlling a running prover.
+type AdminAPIServer struct {
+	echo   *echo.Echo
+	prover Prover
+}
+
+// New creates a new prover admin server instance, all routes except the health check require a JWT.
+func New(prover Prover, jwtSecret []byte) (*AdminAPIServer, error) {
+	if len(jwtSecret) == 0 {
+		return nil, fmt.Errorf("JWT secret is required for the prover admin server")
+	}
+
+	server := &AdminAPIServer{
+		echo:   echo.New(),
+		prover: prover,
+	}
+
+	server.echo.HideBanner = true
+	server.configureMiddleware()
+	server.configureRoutes()
+	server.echo.Use(echojwt.WithConfig(echojwt.Config{
+		Skipper:    jwtSkipPath,
+		SigningKey: jwtSecret,
+	}))
+
+	return server, nil
+}
+
+// LogSkipper implements the `middleware.Skipper` interface,
+// skip all ECHO logs for the prover admin server.
+func LogSkipper(c echo.Context) bool {
+	return true
+}
+
+// jwtSkipPath returns true for routes that bypass JWT authentication.
+func jwtSkipPath(c echo.Context) bool {
+	switch c.Path() {
+	case "/", "/healthz":
+		return true
+	}
+	return false
+}
+
+// configureMiddleware configures the server middlewares.
+func (s *AdminAPIServer) configureMiddleware() {
+	s.echo.Use(middleware.RequestID())
+
+	// nolint:staticcheck
+	s.echo.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
+		Skipper: LogSkipper,
+		Format: `{"time":"${time_rfc3339_nano}","level":"INFO","message":{"id":"${id}","remote_ip":"${remote_ip}",` +
+			`"host":"${host}","method":"${method}","uri":"${uri}","user_agent":"${user_agent}",` +
+			`"response_status":${status},"error":"${error}","latency":${latency},"latency_human":"${latency_human}",` +
+			`"bytes_in":${bytes_in},"bytes_out":${bytes_out}}}` + "\n",
+		Output: os.Stdout,
+	}))
+}
+
+// Start starts the HTTP server.
+func (s *AdminAPIServer) Start(port uint64) error {
+	return s.echo.Start(fmt.Sprintf(":%v", port))
+}
+
+// Shutdown shuts down the HTTP server.
+func (s *AdminAPIServer) Shutdown(ctx context.Context) error {
+	return s.echo.Shutdown(ctx)
+}
+
+// configureRoutes contains all routes which will be used by the HTTP server.
+func (s *AdminAPIServer) configureRoutes() {
+	s.echo.GET("/", s.HealthCheck)
+	s.echo.GET("/healthz", s.HealthCheck)
+	s.echo.GET("/status", s.GetStatus)
+	s.echo.GET("/proofBuffers", s.GetProofBuffers)
+	s.echo.GET("/sp1Fallback", s.GetSP1Fallback)
+	s.echo.GET("/sharedState", s.GetSharedState)
+	s.echo.GET("/raikoRequests", s.GetRaikoRequests)
+
+	s.echo.POST("/proofBuffers/:proofType/aggregate", s.ForceAggregate)
+	s.echo.POST("/proofCaches/:proofType/flush", s.FlushCache)
+	s.echo.POST("/sharedState/rewind", s.RewindProposalCursor)
+	s.echo.POST("/proposals/:proposalId/prove", s.RequestProof)
+}
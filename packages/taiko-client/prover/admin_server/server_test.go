@@ -0,0 +1,157 @@
+package adminserver
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+	submitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
+)
+
+type testProver struct {
+	aggregated  []producer.ProofType
+	flushed     []producer.ProofType
+	rewoundTo   *big.Int
+	requestedID *big.Int
+}
+
+func (p *testProver) SubmitterStatus() *submitter.Status {
+	return &submitter.Status{
+		ProofBuffers: []*submitter.ProofBufferStatus{{
+			ProofType:   producer.ProofTypeZKSP1,
+			MaxLength:   2,
+			ProposalIDs: []*big.Int{common.Big1},
+		}},
+		CachedProofs: map[producer.ProofType][]*big.Int{producer.ProofTypeZKSP1: {common.Big3}},
+		SP1Fallback:  &submitter.SP1FallbackStatus{Enabled: true, InSP1: true, MaxSP1ProposalID: common.Big3},
+	}
+}
+
+func (p *testProver) ProposalCursor() (uint64, *types.Header) {
+	return 3, &types.Header{Number: big.NewInt(100)}
+}
+
+func (p *testProver) ForceAggregate(proofType producer.ProofType) (bool, error) {
+	p.aggregated = append(p.aggregated, proofType)
+	return true, nil
+}
+
+func (p *testProver) FlushCache(_ context.Context, proofType producer.ProofType) error {
+	p.flushed = append(p.flushed, proofType)
+	return nil
+}
+
+func (p *testProver) RewindProposalCursor(_ context.Context, proposalID *big.Int) error {
+	p.rewoundTo = proposalID
+	return nil
+}
+
+func (p *testProver) RequestProof(_ context.Context, proposalID *big.Int) error {
+	p.requestedID = proposalID
+	return nil
+}
+
+func newTestServer(t *testing.T) (*AdminAPIServer, *testProver) {
+	prover := &testProver{}
+	server, err := New(prover, []byte("secret"))
+	require.NoError(t, err)
+	return server, prover
+}
+
+func TestNewRequiresJWTSecret(t *testing.T) {
+	_, err := New(&testProver{}, nil)
+	require.Error(t, err)
+}
+
+func TestAuthentication(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	rec := httptest.NewRecorder()
+	server.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/status", nil),
+		httptest.NewRequest(http.MethodPost, "/proofBuffers/sp1/aggregate", nil),
+		httptest.NewRequest(http.MethodPost, "/proposals/1/prove", nil),
+	} {
+		rec = httptest.NewRecorder()
+		server.echo.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusUnauthorized, rec.Code, req.URL.Path)
+	}
+}
+
+func TestGetStatus(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	c, rec := newTestContext(server, http.MethodGet, "/status", "")
+	require.NoError(t, server.GetStatus(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var status map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+	require.Contains(t, status, "proofBuffers")
+	require.Contains(t, status, "sp1Fallback")
+	require.Contains(t, status, "sharedState")
+
+	var state SharedState
+	require.NoError(t, json.Unmarshal(status["sharedState"], &state))
+	require.Equal(t, uint64(3), state.LastHandledProposalID)
+	require.Equal(t, int64(100), state.L1Current.Int64())
+}
+
+func TestActions(t *testing.T) {
+	server, prover := newTestServer(t)
+
+	c, rec := newTestContext(server, http.MethodPost, "/proofBuffers/sp1/aggregate", "")
+	c.SetParamNames("proofType")
+	c.SetParamValues("sp1")
+	require.NoError(t, server.ForceAggregate(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, []producer.ProofType{producer.ProofTypeZKSP1}, prover.aggregated)
+
+	c, _ = newTestContext(server, http.MethodPost, "/proofCaches/risc0/flush", "")
+	c.SetParamNames("proofType")
+	c.SetParamValues("risc0")
+	require.NoError(t, server.FlushCache(c))
+	require.Equal(t, []producer.ProofType{producer.ProofTypeZKR0}, prover.flushed)
+
+	c, rec = newTestContext(server, http.MethodPost, "/sharedState/rewind", `{"proposalId": 2}`)
+	require.NoError(t, server.RewindProposalCursor(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, common.Big2, prover.rewoundTo)
+
+	c, rec = newTestContext(server, http.MethodPost, "/sharedState/rewind", `{}`)
+	require.NoError(t, server.RewindProposalCursor(c))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	c, rec = newTestContext(server, http.MethodPost, "/proposals/5/prove", "")
+	c.SetParamNames("proposalId")
+	c.SetParamValues("5")
+	require.NoError(t, server.RequestProof(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, big.NewInt(5), prover.requestedID)
+
+	c, rec = newTestContext(server, http.MethodPost, "/proposals/abc/prove", "")
+	c.SetParamNames("proposalId")
+	c.SetParamValues("abc")
+	require.NoError(t, server.RequestProof(c))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func newTestContext(server *AdminAPIServer, method, path, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return server.echo.NewContext(req, rec), rec
+}
@@ -11,12 +11,15 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/urfave/cli/v2"
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/cmd/flags"
 	pkgFlags "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/flags"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/jwt"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/policy"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
 // Config contains the configurations to initialize a Taiko prover.
@@ -38,6 +41,7 @@ type Config struct {
 	RaikoHostEndpoint             string
 	RaikoApiKey                   string
 	RaikoRequestTimeout           time.Duration
+	ProofBackends                 []*producer.BackendConfig
 	LocalProposerAddresses        []common.Address
 	BlockConfirmations            uint64
 	TxmgrConfigs                  *txmgr.CLIConfig
@@ -51,6 +55,10 @@ type Config struct {
 	ForceSP1Proof                 bool
 	ForceSGXProof                 bool
 	ZkOnlyProofs                  bool
+	DataDir                       string
+	AdminServerPort               uint64
+	AdminServerJWTSecret          []byte
+	Policy                        *policy.Config
 }
 
 // NewConfigFromCliContext creates a new config instance from command line flags.
@@ -93,6 +101,59 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		return nil, fmt.Errorf("--%s is required", flags.RaikoHostEndpoint.Name)
 	}
 
+	var proofBackends []*producer.BackendConfig
+	if c.IsSet(flags.RaikoBackendsConfigPath.Name) {
+		if proofBackends, err = producer.LoadBackendConfigs(c.String(flags.RaikoBackendsConfigPath.Name)); err != nil {
+			return nil, err
+		}
+		for _, backend := range proofBackends {
+			if backend.Timeout == 0 {
+				backend.Timeout = c.Duration(flags.RaikoRequestTimeout.Name)
+			}
+		}
+	}
+
+	var adminServerJWTSecret []byte
+	if c.String(flags.AdminServerJWTSecret.Name) != "" {
+		if adminServerJWTSecret, err = jwt.ParseSecretFromFile(c.String(flags.AdminServerJWTSecret.Name)); err != nil {
+			return nil, fmt.Errorf("invalid admin server JWT secret file: %w", err)
+		}
+	}
+	if c.Uint64(flags.AdminServerPort.Name) > 0 && len(adminServerJWTSecret) == 0 {
+		return nil, fmt.Errorf(
+			"--%s is required when --%s is enabled",
+			flags.AdminServerJWTSecret.Name,
+			flags.AdminServerPort.Name,
+		)
+	}
+
+	var policyConfig *policy.Config
+	if c.Bool(flags.PolicyEnabled.Name) {
+		costPerBlock, err := policy.ParseCostsByProofType(c.StringSlice(flags.PolicyCostPerBlock.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flags.PolicyCostPerBlock.Name, err)
+		}
+		costPerMillionGas, err := policy.ParseCostsByProofType(c.StringSlice(flags.PolicyCostPerMillionGas.Name))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", flags.PolicyCostPerMillionGas.Name, err)
+		}
+		if c.Float64(flags.PolicyBondTokenEthRate.Name) < 0 {
+			return nil, fmt.Errorf("--%s must not be negative", flags.PolicyBondTokenEthRate.Name)
+		}
+		policyConfig = &policy.Config{
+			CostPerBlock:      costPerBlock,
+			CostPerMillionGas: costPerMillionGas,
+			ProveTxGas:        c.Uint64(flags.PolicyProveTxGas.Name),
+			ProofsPerTx:       c.Uint64(flags.ZKVMBatchSize.Name),
+			BondTokenEthRate:  c.Float64(flags.PolicyBondTokenEthRate.Name),
+			MinProfit: new(big.Int).Mul(
+				new(big.Int).SetUint64(c.Uint64(flags.PolicyMinProfit.Name)),
+				big.NewInt(params.GWei),
+			),
+			DeprioritiseDelay: c.Duration(flags.PolicyDeprioritiseDelay.Name),
+		}
+	}
+
 	zkOnlyProofs := c.Bool(flags.ZkOnlyProofs.Name)
 
 	var localProposerAddresses []common.Address
@@ -118,6 +179,7 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		RaikoHostEndpoint:        raikoHostEndpoint,
 		RaikoApiKey:              strings.TrimSpace(string(raikoApiKey)),
 		RaikoRequestTimeout:      c.Duration(flags.RaikoRequestTimeout.Name),
+		ProofBackends:            proofBackends,
 		StartingProposalID:       startingProposalID,
 		Dummy:                    c.Bool(flags.Dummy.Name),
 		BackOffMaxRetries:        c.Uint64(flags.BackOffMaxRetries.Name),
@@ -143,5 +205,9 @@ func NewConfigFromCliContext(c *cli.Context) (*Config, error) {
 		ZKVMProofBufferSize:       c.Uint64(flags.ZKVMBatchSize.Name),
 		ForceBatchProvingInterval: c.Duration(flags.ForceBatchProvingInterval.Name),
 		ProofPollingInterval:      c.Duration(flags.ProofPollingInterval.Name),
+		DataDir:                   strings.TrimSpace(c.String(flags.ProverDataDir.Name)),
+		AdminServerPort:           c.Uint64(flags.AdminServerPort.Name),
+		AdminServerJWTSecret:      adminServerJWTSecret,
+		Policy:                    policyConfig,
 	}, nil
 }
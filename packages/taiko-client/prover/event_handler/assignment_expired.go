@@ -9,6 +9,7 @@ import (
 
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/policy"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
@@ -16,16 +17,19 @@ import (
 type AssignmentExpiredEventHandler struct {
 	rpc               *rpc.Client
 	proofSubmissionCh chan<- *proofProducer.ProofRequestBody
+	policy            *policy.Engine
 }
 
 // NewAssignmentExpiredEventHandler creates a new AssignmentExpiredEventHandler instance.
 func NewAssignmentExpiredEventHandler(
 	rpc *rpc.Client,
 	proofSubmissionCh chan *proofProducer.ProofRequestBody,
+	policy *policy.Engine,
 ) *AssignmentExpiredEventHandler {
 	return &AssignmentExpiredEventHandler{
 		rpc,
 		proofSubmissionCh,
+		policy,
 	}
 }
 
@@ -56,6 +60,24 @@ func (h *AssignmentExpiredEventHandler) Handle(
 			"proposalID", proposalID,
 			"assignedProver", meta.GetProposer(),
 		)
+
+		// The proposal has already waited for the proving window expiration, so a deprioritised
+		// proposal is proved now, only the unprofitable ones are skipped.
+		if h.policy != nil {
+			decision, err := h.policy.Evaluate(ctx, meta, false)
+			if err != nil {
+				return err
+			}
+			if decision.Action == policy.ActionSkip {
+				log.Info(
+					"Skip proving expired proposal by proving policy",
+					"proposalID", proposalID,
+					"reason", decision.Reason,
+					"profit", decision.Estimate.Profit,
+				)
+				return nil
+			}
+		}
 		go func() { h.proofSubmissionCh <- &proofProducer.ProofRequestBody{Meta: meta} }()
 		return nil
 	}
@@ -10,6 +10,7 @@ func (s *EventHandlerTestSuite) TestAssignmentExpiredEventHandlerHandle() {
 	handler := NewAssignmentExpiredEventHandler(
 		s.RPCClient,
 		make(chan *proofProducer.ProofRequestBody, 1024),
+		nil,
 	)
 	s.Nil(handler.Handle(context.Background(), s.ProposeAndInsertValidBlock(s.proposer, s.eventSyncer)))
 }
@@ -15,6 +15,7 @@ type ProposalHandler interface {
 		meta metadata.TaikoProposalMetaData,
 		end eventIterator.EndProposalEventIterFunc,
 	) error
+	RequestProof(ctx context.Context, meta metadata.TaikoProposalMetaData) error
 }
 
 // ProofsReceivedHandler is the interface for handling proof-received events.
@@ -15,6 +15,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
 	eventIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_iterator"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/policy"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 )
 
@@ -194,6 +195,36 @@ func (h *ProposalEventHandler) checkExpirationAndSubmitProof(
 		return nil
 	}
 
+	if h.policy != nil {
+		decision, err := h.policy.Evaluate(ctx, meta, h.shouldProve(designatedProver))
+		if err != nil {
+			return err
+		}
+		switch decision.Action {
+		case policy.ActionSkip:
+			log.Info(
+				"Skip proving proposal by proving policy",
+				"proposalID", proposalID,
+				"designatedProver", designatedProver,
+				"reason", decision.Reason,
+				"profit", decision.Estimate.Profit,
+			)
+			return nil
+		case policy.ActionDeprioritise:
+			log.Info(
+				"Deprioritise proving proposal by proving policy",
+				"proposalID", proposalID,
+				"designatedProver", designatedProver,
+				"reason", decision.Reason,
+				"profit", decision.Estimate.Profit,
+				"delay", h.policy.DeprioritiseDelay(),
+			)
+			time.AfterFunc(h.policy.DeprioritiseDelay(), func() { h.assignmentExpiredCh <- meta })
+			return nil
+		case policy.ActionProve:
+		}
+	}
+
 	log.Info(
 		"Proposed proposal is provable",
 		"proposalID", meta.Shasta().GetEventData().Id,
@@ -10,6 +10,7 @@ import (
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
 	eventIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/policy"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	state "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/shared_state"
 )
@@ -25,6 +26,7 @@ type ProposalEventHandler struct {
 	backOffRetryInterval     time.Duration
 	backOffMaxRetries        uint64
 	proveUnassignedProposals bool
+	policy                   *policy.Engine
 }
 
 // NewProposalEventHandlerOps is the options for creating a new ProposalEventHandler.
@@ -38,6 +40,7 @@ type NewProposalEventHandlerOps struct {
 	BackOffRetryInterval     time.Duration
 	BackOffMaxRetries        uint64
 	ProveUnassignedProposals bool
+	Policy                   *policy.Engine
 }
 
 // NewProposalEventHandler creates a new ProposalEventHandler instance.
@@ -52,6 +55,7 @@ func NewProposalEventHandler(opts *NewProposalEventHandlerOps) *ProposalEventHan
 		opts.BackOffRetryInterval,
 		opts.BackOffMaxRetries,
 		opts.ProveUnassignedProposals,
+		opts.Policy,
 	}
 }
 
@@ -64,6 +68,12 @@ func (h *ProposalEventHandler) Handle(
 	return h.handleProposal(ctx, meta, end)
 }
 
+// RequestProof implements the ProposalHandler interface, it requests a proof for the given
+// proposal again, if it is assigned to the current prover and accepted by the proving policy.
+func (h *ProposalEventHandler) RequestProof(ctx context.Context, meta metadata.TaikoProposalMetaData) error {
+	return h.checkExpirationAndSubmitProof(ctx, meta, meta.GetProposalID(), meta.GetProposer())
+}
+
 // shouldProve checks whether the current running prover is assigned to prove the proposal.
 func (h *ProposalEventHandler) shouldProve(assignedProver common.Address) bool {
 	return assignedProver == h.proverAddress ||
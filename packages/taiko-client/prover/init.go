@@ -11,6 +11,7 @@ import (
 	cmap "github.com/orcaman/concurrent-map/v2"
 
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/policy"
 	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter/transaction"
@@ -39,6 +40,21 @@ func enabledProofTypes(forceSGXProof bool, zkOnlyProofs bool) []producer.ProofTy
 	return []producer.ProofType{producer.ProofTypeZKR0, producer.ProofTypeZKSP1}
 }
 
+// policyProofTypes returns the proof types the proving policy should take into account for every
+// proposal, i.e. the preferred primary proof type and its companion proof type.
+func policyProofTypes(cfg *Config) []producer.ProofType {
+	switch {
+	case cfg.ZkOnlyProofs:
+		return []producer.ProofType{producer.ProofTypeZKSP1, producer.ProofTypeZKR0}
+	case cfg.ForceSGXProof:
+		return []producer.ProofType{producer.ProofTypeSgx, producer.ProofTypeSgxGeth}
+	case cfg.ForceSP1Proof || cfg.MaxRisc0ProofProposalDistance == 0:
+		return []producer.ProofType{producer.ProofTypeZKSP1, producer.ProofTypeSgxGeth}
+	default:
+		return []producer.ProofType{producer.ProofTypeZKR0, producer.ProofTypeSgxGeth}
+	}
+}
+
 // initProofSubmitter initializes the proof submitter from the non-zero verifier addresses set in protocol.
 func (p *Prover) initProofSubmitter(ctx context.Context, txBuilder *transaction.ProveBatchesTxBuilder) error {
 	var (
@@ -79,13 +95,29 @@ func (p *Prover) initProofSubmitter(ctx context.Context, txBuilder *transaction.
 	// Initialize proof verifier IDs and the Raiko proof producer.
 	verifierIDs := verifierIDsByProofType()
 
+	p.inflightRaikoRequests = producer.NewInflightRaikoRequests()
 	zkvmProducer := &producer.ComposeProofProducer{
 		VerifierIDs:         verifierIDs,
 		RaikoHostEndpoint:   p.cfg.RaikoHostEndpoint,
 		ApiKey:              p.cfg.RaikoApiKey,
 		RaikoRequestTimeout: p.cfg.RaikoRequestTimeout,
+		Inflight:            p.inflightRaikoRequests,
 		Dummy:               p.cfg.Dummy,
 	}
+	if len(p.cfg.ProofBackends) > 0 {
+		if zkvmProducer.Backends, err = producer.NewBackendRegistry(p.cfg.ProofBackends); err != nil {
+			return fmt.Errorf("failed to initialize proof backends: %w", err)
+		}
+		zkvmProducer.Backends.Inflight = p.inflightRaikoRequests
+	}
+	// Init the optional persistent proof store.
+	var proofStore producer.ProofStore
+	if len(p.cfg.DataDir) > 0 {
+		if proofStore, err = producer.NewFileProofStore(p.cfg.DataDir); err != nil {
+			return fmt.Errorf("failed to initialize proof store: %w", err)
+		}
+		log.Info("Persistent proof store enabled", "dataDir", p.cfg.DataDir)
+	}
 	// Init proof buffers.
 	var (
 		proofBuffers = make(map[producer.ProofType]*producer.ProofBuffer, proofSubmitter.MaxNumSupportedProofTypes)
@@ -100,12 +132,18 @@ func (p *Prover) initProofSubmitter(ctx context.Context, txBuilder *transaction.
 		cacheMaps[proofType] = cmap.New[*producer.ProofResponse]()
 		switch proofType {
 		case producer.ProofTypeSgx, producer.ProofTypeZKR0, producer.ProofTypeZKSP1:
-			proofBuffers[proofType] = producer.NewProofBuffer(p.cfg.ZKVMProofBufferSize)
+			proofBuffers[proofType] = producer.NewPersistentProofBuffer(p.cfg.ZKVMProofBufferSize, proofStore)
 		default:
 			return fmt.Errorf("unexpected proof type: %s", proofType)
 		}
 	}
 
+	if proofStore != nil {
+		if err := p.replayProofStore(ctx, proofStore, cacheMaps); err != nil {
+			return fmt.Errorf("failed to replay proof store: %w", err)
+		}
+	}
+
 	if p.proofSubmitter, err = proofSubmitter.NewProofSubmitter(
 		p.ctx,
 		zkvmProducer,
@@ -129,6 +167,7 @@ func (p *Prover) initProofSubmitter(ctx context.Context, txBuilder *transaction.
 		p.cfg.ForceSP1Proof,
 		p.cfg.ForceSGXProof,
 		p.cfg.ZkOnlyProofs,
+		proofStore,
 	); err != nil {
 		return fmt.Errorf("failed to initialize proof submitter: %w", err)
 	}
@@ -136,6 +175,76 @@ func (p *Prover) initProofSubmitter(ctx context.Context, txBuilder *transaction.
 	return nil
 }
 
+// replayProofStore loads all unfinalized proofs from the persistent proof store into the cache maps,
+// the proof submitter will then flush the contiguous ones into the proof buffers.
+func (p *Prover) replayProofStore(
+	ctx context.Context,
+	proofStore producer.ProofStore,
+	cacheMaps map[producer.ProofType]cmap.ConcurrentMap[string, *producer.ProofResponse],
+) error {
+	records, err := proofStore.Load()
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+
+	coreState, err := p.rpc.GetCoreState(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return fmt.Errorf("failed to get core state: %w", err)
+	}
+
+	replayed := 0
+	for _, record := range records {
+		cacheMap, ok := cacheMaps[record.ProofType]
+		if !ok {
+			log.Warn(
+				"Skip replaying proof with disabled proof type",
+				"proposalID", record.ProposalID,
+				"proofType", record.ProofType,
+			)
+			continue
+		}
+		if record.ProposalID.Cmp(coreState.LastFinalizedProposalId) <= 0 {
+			if err := proofStore.Delete(record.ProofType, record.ProposalID.Uint64()); err != nil {
+				return err
+			}
+			continue
+		}
+		proofResponse, err := record.ToProofResponse(p.rpc.ShastaClients.Inbox.ParseProposed)
+		if err != nil {
+			log.Warn("Failed to decode stored proof, discard it", "proposalID", record.ProposalID, "error", err)
+			if err := proofStore.Delete(record.ProofType, record.ProposalID.Uint64()); err != nil {
+				return err
+			}
+			continue
+		}
+		cacheMap.Set(record.ProposalID.String(), proofResponse)
+		replayed++
+	}
+
+	// Ask the submitter to move the replayed proofs into the buffers right away, instead of
+	// waiting for the next cache cleanup tick.
+	for proofType, cacheMap := range cacheMaps {
+		if cacheMap.IsEmpty() {
+			continue
+		}
+		select {
+		case p.flushCacheNotify <- proofType:
+		default:
+		}
+	}
+
+	log.Info(
+		"Replayed proofs from proof store",
+		"replayed", replayed,
+		"stored", len(records),
+		"lastFinalizedProposalID", coreState.LastFinalizedProposalId,
+	)
+	return nil
+}
+
 // initL1Current initializes prover's L1Current cursor.
 func (p *Prover) initL1Current(startingProposalID *big.Int) error {
 	if err := p.rpc.WaitTillL2ExecutionEngineSynced(p.ctx); err != nil {
@@ -193,6 +302,13 @@ func (p *Prover) initL1Current(startingProposalID *big.Int) error {
 // initEventHandlers initialize all event handlers which will be used by the current prover.
 func (p *Prover) initEventHandlers() error {
 	p.eventHandlers = &eventHandlers{}
+	// ------- Proving policy -------
+	var proposalPolicy *policy.Engine
+	if p.cfg.Policy != nil {
+		p.cfg.Policy.ProofTypes = policyProofTypes(p.cfg)
+		proposalPolicy = policy.New(p.cfg.Policy, p.rpc, p.protocolConfigs.LivenessBond())
+		log.Info("Proving policy enabled", "proofTypes", p.cfg.Policy.ProofTypes, "minProfit", p.cfg.Policy.MinProfit)
+	}
 	// ------- Proposal -------
 	opts := &handler.NewProposalEventHandlerOps{
 		SharedState:              p.sharedState,
@@ -204,6 +320,7 @@ func (p *Prover) initEventHandlers() error {
 		BackOffRetryInterval:     p.cfg.BackOffRetryInterval,
 		BackOffMaxRetries:        p.cfg.BackOffMaxRetries,
 		ProveUnassignedProposals: p.cfg.ProveUnassignedProposals,
+		Policy:                   proposalPolicy,
 	}
 	p.eventHandlers.proposalHandler = handler.NewProposalEventHandler(opts)
 	// ------- ProofsReceived -------
@@ -212,6 +329,7 @@ func (p *Prover) initEventHandlers() error {
 	p.eventHandlers.assignmentExpiredHandler = handler.NewAssignmentExpiredEventHandler(
 		p.rpc,
 		p.proofSubmissionCh,
+		proposalPolicy,
 	)
 
 	return nil
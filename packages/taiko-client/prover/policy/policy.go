@@ -0,0 +1,254 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/utils"
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+// Action is the decision of the policy engine for a proposal.
+type Action string
+
+// Action constants.
+const (
+	ActionProve        Action = "prove"
+	ActionDeprioritise Action = "deprioritise"
+	ActionSkip         Action = "skip"
+)
+
+// Reasons reported along with the decisions.
+const (
+	ReasonDesignated   = "designated_prover"
+	ReasonProfitable   = "profitable"
+	ReasonBelowMargin  = "below_min_profit"
+	ReasonUnprofitable = "unprofitable"
+)
+
+// Config contains the configurations of the proving policy engine.
+type Config struct {
+	// ProofTypes are all the proofs generated for a single proposal, i.e. the primary proof type
+	// and its companion proof type.
+	ProofTypes []producer.ProofType
+	// CostPerBlock is the estimated proving cost in wei of a single L2 block for each proof type.
+	CostPerBlock map[producer.ProofType]*big.Int
+	// CostPerMillionGas is the estimated proving cost in wei of one million L2 gas for each proof type.
+	CostPerMillionGas map[producer.ProofType]*big.Int
+	// ProveTxGas is the estimated L1 gas used by a prove transaction.
+	ProveTxGas uint64
+	// ProofsPerTx is the number of proposal proofs aggregated into a single prove transaction.
+	ProofsPerTx uint64
+	// BondTokenEthRate is the price of one bond token in ETH.
+	BondTokenEthRate float64
+	// MinProfit is the minimum expected profit in wei to prove a proposal right away.
+	MinProfit *big.Int
+	// DeprioritiseDelay is how long a proposal below the minimum profit waits before being proved.
+	DeprioritiseDelay time.Duration
+}
+
+// Inputs are the on-chain figures used to estimate the cost of proving a proposal.
+type Inputs struct {
+	NumBlocks   uint64
+	GasUsed     uint64
+	L1BaseFee   *big.Int
+	L1GasTipCap *big.Int
+}
+
+// Estimate is the estimated cost and reward of proving a proposal, all in wei.
+type Estimate struct {
+	ProvingCost    *big.Int
+	SubmissionCost *big.Int
+	Reward         *big.Int
+	Profit         *big.Int
+}
+
+// Decision is the result of a proposal evaluation.
+type Decision struct {
+	Action   Action
+	Reason   string
+	Inputs   *Inputs
+	Estimate *Estimate
+}
+
+// Engine decides whether proving a proposal is worth it.
+type Engine struct {
+	cfg          *Config
+	rpc          *rpc.Client
+	livenessBond *big.Int
+}
+
+// New creates a new policy Engine instance, the liveness bond is denominated in gwei of the bond token.
+func New(cfg *Config, rpc *rpc.Client, livenessBond *big.Int) *Engine {
+	return &Engine{cfg: cfg, rpc: rpc, livenessBond: livenessBond}
+}
+
+// Evaluate estimates the profitability of proving the given proposal and decides what to do with it,
+// proposals of a designated prover are always proved, since skipping them would slash its liveness bond.
+func (e *Engine) Evaluate(
+	ctx context.Context,
+	meta metadata.TaikoProposalMetaData,
+	designated bool,
+) (*Decision, error) {
+	proposalID := meta.GetProposalID()
+	inputs, err := e.fetchInputs(ctx, proposalID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch proving cost inputs of proposal %d: %w", proposalID, err)
+	}
+
+	decision := Decide(e.cfg, inputs, e.livenessBond, designated)
+	log.Info(
+		"Proving policy decision",
+		"proposalID", proposalID,
+		"action", decision.Action,
+		"reason", decision.Reason,
+		"blocks", inputs.NumBlocks,
+		"gasUsed", inputs.GasUsed,
+		"provingCost", utils.WeiToEther(decision.Estimate.ProvingCost),
+		"submissionCost", utils.WeiToEther(decision.Estimate.SubmissionCost),
+		"reward", utils.WeiToEther(decision.Estimate.Reward),
+		"profit", utils.WeiToEther(decision.Estimate.Profit),
+	)
+	metrics.ProverPolicyDecisionsCounter.WithLabelValues(string(decision.Action), decision.Reason).Inc()
+	profit, _ := utils.WeiToEther(decision.Estimate.Profit).Float64()
+	metrics.ProverPolicyEstimatedProfitGauge.Set(profit)
+
+	return decision, nil
+}
+
+// DeprioritiseDelay returns how long a deprioritised proposal should wait before being proved.
+func (e *Engine) DeprioritiseDelay() time.Duration {
+	return e.cfg.DeprioritiseDelay
+}
+
+// fetchInputs fetches the L2 blocks of the given proposal and the current L1 gas prices.
+func (e *Engine) fetchInputs(ctx context.Context, proposalID *big.Int) (*Inputs, error) {
+	lastBlockID, err := e.rpc.ProposalLastBlockID(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	prevLastBlockID, err := e.rpc.ProposalLastBlockID(ctx, new(big.Int).Sub(proposalID, common.Big1))
+	if err != nil {
+		return nil, err
+	}
+	if lastBlockID.Cmp(prevLastBlockID) <= 0 {
+		return nil, fmt.Errorf("invalid L2 block range (%d, %d]", prevLastBlockID, lastBlockID)
+	}
+
+	inputs := &Inputs{NumBlocks: new(big.Int).Sub(lastBlockID, prevLastBlockID).Uint64()}
+	for i := new(big.Int).Add(prevLastBlockID, common.Big1); i.Cmp(lastBlockID) <= 0; i.Add(i, common.Big1) {
+		header, err := e.rpc.L2.HeaderByNumber(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+		inputs.GasUsed += header.GasUsed
+	}
+
+	l1Head, err := e.rpc.L1.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	inputs.L1BaseFee = common.Big0
+	if l1Head.BaseFee != nil {
+		inputs.L1BaseFee = l1Head.BaseFee
+	}
+	if inputs.L1GasTipCap, err = e.rpc.L1.SuggestGasTipCap(ctx); err != nil {
+		return nil, err
+	}
+	return inputs, nil
+}
+
+// Decide estimates the cost and reward of proving a proposal with the given inputs, and returns
+// the decision based on the given configurations.
+func Decide(cfg *Config, inputs *Inputs, livenessBond *big.Int, designated bool) *Decision {
+	estimate := estimate(cfg, inputs, livenessBond, designated)
+	decision := &Decision{Inputs: inputs, Estimate: estimate}
+
+	switch {
+	case designated:
+		decision.Action, decision.Reason = ActionProve, ReasonDesignated
+	case estimate.Profit.Sign() < 0:
+		decision.Action, decision.Reason = ActionSkip, ReasonUnprofitable
+	case cfg.MinProfit != nil && estimate.Profit.Cmp(cfg.MinProfit) < 0:
+		decision.Action, decision.Reason = ActionDeprioritise, ReasonBelowMargin
+	default:
+		decision.Action, decision.Reason = ActionProve, ReasonProfitable
+	}
+	return decision
+}
+
+// estimate calculates the proving cost, the amortized L1 submission cost and the expected reward.
+// A designated prover avoids losing its whole liveness bond, while any other prover is credited
+// with half of the slashed bond of a late proposal.
+func estimate(cfg *Config, inputs *Inputs, livenessBond *big.Int, designated bool) *Estimate {
+	provingCost := new(big.Int)
+	for _, proofType := range cfg.ProofTypes {
+		if cost, ok := cfg.CostPerBlock[proofType]; ok {
+			provingCost.Add(provingCost, new(big.Int).Mul(cost, new(big.Int).SetUint64(inputs.NumBlocks)))
+		}
+		if cost, ok := cfg.CostPerMillionGas[proofType]; ok {
+			gasCost := new(big.Int).Mul(cost, new(big.Int).SetUint64(inputs.GasUsed))
+			provingCost.Add(provingCost, gasCost.Div(gasCost, big.NewInt(1_000_000)))
+		}
+	}
+
+	gasPrice := new(big.Int).Add(
+		new(big.Int).Mul(inputs.L1BaseFee, common.Big2),
+		inputs.L1GasTipCap,
+	)
+	submissionCost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(cfg.ProveTxGas))
+	if cfg.ProofsPerTx > 1 {
+		submissionCost.Div(submissionCost, new(big.Int).SetUint64(cfg.ProofsPerTx))
+	}
+
+	reward := new(big.Int)
+	if livenessBond != nil {
+		bond := new(big.Int).Mul(livenessBond, big.NewInt(params.GWei))
+		if !designated {
+			bond.Div(bond, common.Big2)
+		}
+		reward, _ = new(big.Float).Mul(
+			new(big.Float).SetInt(bond),
+			big.NewFloat(cfg.BondTokenEthRate),
+		).Int(nil)
+	}
+
+	return &Estimate{
+		ProvingCost:    provingCost,
+		SubmissionCost: submissionCost,
+		Reward:         reward,
+		Profit:         new(big.Int).Sub(reward, new(big.Int).Add(provingCost, submissionCost)),
+	}
+}
+
+// ParseCostsByProofType parses the given "proofType=gwei" pairs into a map of costs in wei.
+func ParseCostsByProofType(values []string) (map[producer.ProofType]*big.Int, error) {
+	costs := make(map[producer.ProofType]*big.Int, len(values))
+	for _, value := range values {
+		proofType, amount, ok := strings.Cut(strings.TrimSpace(value), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid proof cost %q, expected proofType=gwei", value)
+		}
+		switch producer.ProofType(proofType) {
+		case producer.ProofTypeSgx, producer.ProofTypeSgxGeth, producer.ProofTypeZKR0, producer.ProofTypeZKSP1:
+		default:
+			return nil, fmt.Errorf("invalid proof type in proof cost %q", value)
+		}
+		gwei, ok := new(big.Int).SetString(amount, 10)
+		if !ok || gwei.Sign() < 0 {
+			return nil, fmt.Errorf("invalid amount in proof cost %q", value)
+		}
+		costs[producer.ProofType(proofType)] = gwei.Mul(gwei, big.NewInt(params.GWei))
+	}
+	return costs, nil
+}
@@ -0,0 +1,85 @@
+package policy
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	producer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+func gwei(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.GWei))
+}
+
+func testConfig() *Config {
+	return &Config{
+		ProofTypes:        []producer.ProofType{producer.ProofTypeZKR0, producer.ProofTypeSgxGeth},
+		CostPerBlock:      map[producer.ProofType]*big.Int{producer.ProofTypeZKR0: gwei(1_000_000)},
+		CostPerMillionGas: map[producer.ProofType]*big.Int{producer.ProofTypeZKR0: gwei(2_000_000)},
+		ProveTxGas:        500_000,
+		ProofsPerTx:       5,
+		BondTokenEthRate:  1,
+		MinProfit:         gwei(10_000_000),
+	}
+}
+
+func TestDecide(t *testing.T) {
+	inputs := &Inputs{
+		NumBlocks:   10,
+		GasUsed:     5_000_000,
+		L1BaseFee:   gwei(10),
+		L1GasTipCap: gwei(1),
+	}
+
+	// 10 blocks * 0.001 ETH + 5M gas * 0.002 ETH / 1M gas = 0.02 ETH,
+	// (2 * 10 + 1) gwei * 500_000 gas / 5 proofs = 0.0021 ETH.
+	decision := Decide(testConfig(), inputs, big.NewInt(1_000_000_000), false)
+	require.Equal(t, gwei(20_000_000), decision.Estimate.ProvingCost)
+	require.Equal(t, gwei(2_100_000), decision.Estimate.SubmissionCost)
+	require.Equal(t, gwei(500_000_000), decision.Estimate.Reward)
+	require.Equal(t, gwei(477_900_000), decision.Estimate.Profit)
+	require.Equal(t, ActionProve, decision.Action)
+	require.Equal(t, ReasonProfitable, decision.Reason)
+
+	// A small liveness bond is not worth it.
+	decision = Decide(testConfig(), inputs, big.NewInt(20_000_000), false)
+	require.Equal(t, ActionSkip, decision.Action)
+	require.Equal(t, ReasonUnprofitable, decision.Reason)
+
+	// The designated prover always proves.
+	decision = Decide(testConfig(), inputs, big.NewInt(20_000_000), true)
+	require.Equal(t, ActionProve, decision.Action)
+	require.Equal(t, ReasonDesignated, decision.Reason)
+	require.Equal(t, gwei(20_000_000), decision.Estimate.Reward)
+
+	// A positive profit below the minimum profit is deprioritised.
+	decision = Decide(testConfig(), inputs, big.NewInt(60_000_000), false)
+	require.Equal(t, gwei(7_900_000), decision.Estimate.Profit)
+	require.Equal(t, ActionDeprioritise, decision.Action)
+	require.Equal(t, ReasonBelowMargin, decision.Reason)
+}
+
+func TestDecideBondTokenEthRate(t *testing.T) {
+	cfg := testConfig()
+	cfg.BondTokenEthRate = 0.5
+
+	decision := Decide(cfg, &Inputs{L1BaseFee: new(big.Int), L1GasTipCap: new(big.Int)}, big.NewInt(100), false)
+	require.Equal(t, gwei(25), decision.Estimate.Reward)
+}
+
+func TestParseCostsByProofType(t *testing.T) {
+	costs, err := ParseCostsByProofType([]string{"risc0=100", " sp1=200 "})
+	require.NoError(t, err)
+	require.Equal(t, gwei(100), costs[producer.ProofTypeZKR0])
+	require.Equal(t, gwei(200), costs[producer.ProofTypeZKSP1])
+
+	_, err = ParseCostsByProofType([]string{"risc0"})
+	require.Error(t, err)
+	_, err = ParseCostsByProofType([]string{"op=1"})
+	require.Error(t, err)
+	_, err = ParseCostsByProofType([]string{"sp1=-1"})
+	require.Error(t, err)
+}
@@ -0,0 +1,424 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/internal/metrics"
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+)
+
+// ProtocolRaikoV4 is the protocol adapter name of the Raiko v4 proposal proof HTTP API.
+const ProtocolRaikoV4 = "raiko-v4"
+
+var (
+	ErrNoProofBackend         = errors.New("no proof backend configured")
+	ErrUnknownBackendProtocol = errors.New("unknown proof backend protocol")
+
+	// DefaultBackendCooldown is how long a failing backend endpoint is skipped before it is tried again.
+	DefaultBackendCooldown = 30 * time.Second
+)
+
+// ProofBackend is a protocol adapter which talks to a single proof generation endpoint.
+type ProofBackend interface {
+	// Name returns a human readable name of the backend, used in logs and metrics.
+	Name() string
+	// RequestProposalProof requests a single proposal proof, or an aggregation of the given
+	// proposal proofs when isAggregation is true.
+	RequestProposalProof(
+		ctx context.Context,
+		opts []ProofRequestOptions,
+		metas []metadata.TaikoProposalMetaData,
+		isAggregation bool,
+		proofType ProofType,
+	) (*RaikoRequestProofBodyResponse, error)
+}
+
+// BackendConfig contains the configurations of a single proof backend endpoint.
+type BackendConfig struct {
+	ProofType ProofType
+	Protocol  string
+	Endpoint  string
+	ApiKey    string
+	Timeout   time.Duration
+}
+
+// backendConfigJSON is the on-disk representation of BackendConfig.
+type backendConfigJSON struct {
+	ProofType  ProofType `json:"proofType"`
+	Protocol   string    `json:"protocol"`
+	Endpoint   string    `json:"endpoint"`
+	ApiKeyPath string    `json:"apiKeyPath"`
+	Timeout    string    `json:"timeout"`
+}
+
+// BackendFactory creates a ProofBackend from the given configurations.
+type BackendFactory func(cfg *BackendConfig) (ProofBackend, error)
+
+var (
+	backendFactoriesMutex sync.RWMutex
+	backendFactories      = map[string]BackendFactory{
+		ProtocolRaikoV4: func(cfg *BackendConfig) (ProofBackend, error) { return NewRaikoBackend(cfg), nil },
+	}
+)
+
+// RegisterBackendProtocol registers a new protocol adapter, so that proof types can be routed
+// to proving services which don't speak the Raiko API.
+func RegisterBackendProtocol(protocol string, factory BackendFactory) {
+	backendFactoriesMutex.Lock()
+	defer backendFactoriesMutex.Unlock()
+	backendFactories[protocol] = factory
+}
+
+// NewProofBackend creates a new ProofBackend with the protocol adapter in the given configurations.
+func NewProofBackend(cfg *BackendConfig) (ProofBackend, error) {
+	protocol := cfg.Protocol
+	if len(protocol) == 0 {
+		protocol = ProtocolRaikoV4
+	}
+
+	backendFactoriesMutex.RLock()
+	factory, ok := backendFactories[protocol]
+	backendFactoriesMutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBackendProtocol, protocol)
+	}
+	return factory(cfg)
+}
+
+// LoadBackendConfigs loads the proof backend configurations from the given JSON file, which
+// contains a list of backends, multiple backends with the same proof type are used for failover
+// in the listed order.
+func LoadBackendConfigs(path string) ([]*BackendConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proof backends config: %w", err)
+	}
+
+	var raws []*backendConfigJSON
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, fmt.Errorf("failed to decode proof backends config: %w", err)
+	}
+
+	cfgs := make([]*BackendConfig, 0, len(raws))
+	for i, raw := range raws {
+		switch raw.ProofType {
+		case ProofTypeSgx, ProofTypeSgxGeth, ProofTypeZKR0, ProofTypeZKSP1:
+		default:
+			return nil, fmt.Errorf("invalid proof type of proof backend %d: %q", i, raw.ProofType)
+		}
+		endpoint := strings.TrimSuffix(strings.TrimSpace(raw.Endpoint), "/")
+		if len(endpoint) == 0 {
+			return nil, fmt.Errorf("empty endpoint of proof backend %d", i)
+		}
+		cfg := &BackendConfig{
+			ProofType: raw.ProofType,
+			Protocol:  raw.Protocol,
+			Endpoint:  endpoint,
+		}
+		if len(raw.ApiKeyPath) > 0 {
+			apiKey, err := os.ReadFile(raw.ApiKeyPath)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ApiKey secret file of proof backend %d: %w", i, err)
+			}
+			cfg.ApiKey = strings.TrimSpace(string(apiKey))
+		}
+		if len(raw.Timeout) > 0 {
+			if cfg.Timeout, err = time.ParseDuration(raw.Timeout); err != nil {
+				return nil, fmt.Errorf("invalid timeout of proof backend %d: %w", i, err)
+			}
+		}
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs, nil
+}
+
+// RaikoBackend is the ProofBackend implementation for the Raiko v4 HTTP API.
+type RaikoBackend struct {
+	Endpoint string
+	ApiKey   string
+	Timeout  time.Duration
+}
+
+// NewRaikoBackend creates a new RaikoBackend instance.
+func NewRaikoBackend(cfg *BackendConfig) *RaikoBackend {
+	return &RaikoBackend{Endpoint: cfg.Endpoint, ApiKey: cfg.ApiKey, Timeout: cfg.Timeout}
+}
+
+// Name implements the ProofBackend interface.
+func (b *RaikoBackend) Name() string {
+	return b.Endpoint
+}
+
+// RequestProposalProof implements the ProofBackend interface.
+func (b *RaikoBackend) RequestProposalProof(
+	ctx context.Context,
+	opts []ProofRequestOptions,
+	metas []metadata.TaikoProposalMetaData,
+	isAggregation bool,
+	proofType ProofType,
+) (*RaikoRequestProofBodyResponse, error) {
+	ctx, cancel := rpc.CtxWithTimeoutOrDefault(ctx, b.Timeout)
+	defer cancel()
+
+	output, _, _, err := requestRaikoProposalProofV4(ctx, b.Endpoint, b.ApiKey, opts, metas, isAggregation, proofType)
+	return output, err
+}
+
+// backendEndpoint is a registered backend with its health state.
+type backendEndpoint struct {
+	backend        ProofBackend
+	unhealthyUntil time.Time
+	failures       uint64
+}
+
+// BackendRegistry routes the proof requests of each proof type to its configured backends,
+// an endpoint which fails to answer, or answers with an error status, is skipped for a cooldown
+// period and the request fails over to the next endpoint configured for the same proof type.
+// Once an endpoint accepts a proof task, the following polls of that task are only sent to it,
+// since the other endpoints don't know about the task.
+type BackendRegistry struct {
+	// Inflight optionally keeps track of the requests sent to the backends.
+	Inflight *InflightRaikoRequests
+
+	backends map[ProofType][]*backendEndpoint
+	tasks    map[string]*backendEndpoint
+	cooldown time.Duration
+	mutex    sync.Mutex
+}
+
+// NewBackendRegistry creates a new BackendRegistry instance from the given configurations.
+func NewBackendRegistry(cfgs []*BackendConfig) (*BackendRegistry, error) {
+	r := &BackendRegistry{
+		backends: make(map[ProofType][]*backendEndpoint),
+		tasks:    make(map[string]*backendEndpoint),
+		cooldown: DefaultBackendCooldown,
+	}
+	for _, cfg := range cfgs {
+		backend, err := NewProofBackend(cfg)
+		if err != nil {
+			return nil, err
+		}
+		r.Register(cfg.ProofType, backend)
+	}
+	return r, nil
+}
+
+// Register appends the given backend to the failover list of the given proof type.
+func (r *BackendRegistry) Register(proofType ProofType, backend ProofBackend) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.backends[proofType] = append(r.backends[proofType], &backendEndpoint{backend: backend})
+	metrics.ProverBackendHealthyGauge.WithLabelValues(string(proofType), backend.Name()).Set(1)
+	log.Info("Proof backend registered", "proofType", proofType, "backend", backend.Name())
+}
+
+// Has returns whether there is any backend registered for the given proof type.
+func (r *BackendRegistry) Has(proofType ProofType) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.backends[proofType]) > 0
+}
+
+// RaikoBackends returns all Raiko backends registered for the given proof type.
+func (r *BackendRegistry) RaikoBackends(proofType ProofType) []*RaikoBackend {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var backends []*RaikoBackend
+	for _, endpoint := range r.backends[proofType] {
+		if backend, ok := endpoint.backend.(*RaikoBackend); ok {
+			backends = append(backends, backend)
+		}
+	}
+	return backends
+}
+
+// RequestProposalProof sends the proof request to the backends of the given proof type, healthy
+// backends are tried first in their configured order. A task which has already been accepted by
+// a backend is polled on that backend only, unless it fails, in which case the task is submitted
+// to the other backends again.
+func (r *BackendRegistry) RequestProposalProof(
+	ctx context.Context,
+	opts []ProofRequestOptions,
+	metas []metadata.TaikoProposalMetaData,
+	isAggregation bool,
+	proofType ProofType,
+) (*RaikoRequestProofBodyResponse, error) {
+	candidates := r.candidates(proofType)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w for proof type %s", ErrNoProofBackend, proofType)
+	}
+
+	key := proofTaskKey(metas, isAggregation, proofType)
+	if sticky := r.taskEndpoint(key); sticky != nil {
+		output, err := r.request(ctx, key, sticky, opts, metas, isAggregation, proofType)
+		if err == nil {
+			return output, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		log.Warn(
+			"Proof backend failed to serve an accepted task, resubmitting",
+			"proofType", proofType,
+			"backend", sticky.backend.Name(),
+			"task", key,
+			"error", err,
+		)
+		candidates = slices.DeleteFunc(candidates, func(e *backendEndpoint) bool { return e == sticky })
+	}
+
+	var errs []error
+	for _, endpoint := range candidates {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		output, err := r.request(ctx, key, endpoint, opts, metas, isAggregation, proofType)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", endpoint.backend.Name(), err))
+			continue
+		}
+		return output, nil
+	}
+	return nil, fmt.Errorf("all %s proof backends failed: %w", proofType, errors.Join(errs...))
+}
+
+// request sends the proof request to the given backend, and updates the backend health state
+// and the task assignment with the outcome. A response carrying an error status is treated as
+// a failed request, so that the caller fails over to the next backend.
+func (r *BackendRegistry) request(
+	ctx context.Context,
+	key string,
+	endpoint *backendEndpoint,
+	opts []ProofRequestOptions,
+	metas []metadata.TaikoProposalMetaData,
+	isAggregation bool,
+	proofType ProofType,
+) (*RaikoRequestProofBodyResponse, error) {
+	startAt := time.Now()
+	done := r.Inflight.Track(endpoint.backend.Name(), proofType, isAggregation, metas)
+	output, err := endpoint.backend.RequestProposalProof(ctx, opts, metas, isAggregation, proofType)
+	done()
+	metrics.ProverBackendRequestDuration.WithLabelValues(
+		string(proofType),
+		endpoint.backend.Name(),
+	).Observe(time.Since(startAt).Seconds())
+	if err == nil {
+		if output == nil {
+			err = fmt.Errorf("empty response, proofType: %s", proofType)
+		} else {
+			err = output.Validate()
+		}
+	}
+
+	switch {
+	case errors.Is(err, ErrProofInProgress) || errors.Is(err, ErrRetry):
+		r.setTaskEndpoint(key, endpoint)
+	case err == nil || errors.Is(err, ErrEmptyProof):
+		r.setTaskEndpoint(key, nil)
+	default:
+		metrics.ProverBackendRequestsCounter.WithLabelValues(string(proofType), endpoint.backend.Name(), "error").Inc()
+		r.setTaskEndpoint(key, nil)
+		r.markUnhealthy(proofType, endpoint, err)
+		return nil, err
+	}
+	metrics.ProverBackendRequestsCounter.WithLabelValues(string(proofType), endpoint.backend.Name(), "ok").Inc()
+	r.markHealthy(proofType, endpoint)
+	return output, nil
+}
+
+// proofTaskKey returns the key identifying a proof task on the backends.
+func proofTaskKey(metas []metadata.TaikoProposalMetaData, isAggregation bool, proofType ProofType) string {
+	if len(metas) == 0 {
+		return fmt.Sprintf("%s-%t", proofType, isAggregation)
+	}
+	return fmt.Sprintf(
+		"%s-%t-%s-%s",
+		proofType,
+		isAggregation,
+		metas[0].GetProposalID(),
+		metas[len(metas)-1].GetProposalID(),
+	)
+}
+
+// taskEndpoint returns the backend which accepted the given task, if any.
+func (r *BackendRegistry) taskEndpoint(key string) *backendEndpoint {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.tasks[key]
+}
+
+// setTaskEndpoint records the backend which accepted the given task, a nil endpoint removes
+// the record once the task is finished or has failed.
+func (r *BackendRegistry) setTaskEndpoint(key string, endpoint *backendEndpoint) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if endpoint == nil {
+		delete(r.tasks, key)
+		return
+	}
+	r.tasks[key] = endpoint
+}
+
+// candidates returns the backends of the given proof type, healthy ones first.
+func (r *BackendRegistry) candidates(proofType ProofType) []*backendEndpoint {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var (
+		now       = time.Now()
+		healthy   []*backendEndpoint
+		unhealthy []*backendEndpoint
+	)
+	for _, endpoint := range r.backends[proofType] {
+		if now.Before(endpoint.unhealthyUntil) {
+			unhealthy = append(unhealthy, endpoint)
+		} else {
+			healthy = append(healthy, endpoint)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// markUnhealthy records a failed request to the given backend.
+func (r *BackendRegistry) markUnhealthy(proofType ProofType, endpoint *backendEndpoint, err error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	endpoint.failures++
+	endpoint.unhealthyUntil = time.Now().Add(r.cooldown)
+	metrics.ProverBackendHealthyGauge.WithLabelValues(string(proofType), endpoint.backend.Name()).Set(0)
+	log.Warn(
+		"Proof backend request failed",
+		"proofType", proofType,
+		"backend", endpoint.backend.Name(),
+		"failures", endpoint.failures,
+		"cooldown", r.cooldown,
+		"error", err,
+	)
+}
+
+// markHealthy resets the health state of the given backend after a successful request.
+func (r *BackendRegistry) markHealthy(proofType ProofType, endpoint *backendEndpoint) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if endpoint.failures > 0 {
+		log.Info("Proof backend recovered", "proofType", proofType, "backend", endpoint.backend.Name())
+	}
+	endpoint.failures = 0
+	endpoint.unhealthyUntil = time.Time{}
+	metrics.ProverBackendHealthyGauge.WithLabelValues(string(proofType), endpoint.backend.Name()).Set(1)
+}
@@ -0,0 +1,200 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	shastaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/shasta"
+)
+
+func TestLoadBackendConfigs(t *testing.T) {
+	dir := t.TempDir()
+	apiKeyPath := filepath.Join(dir, "api-key.txt")
+	require.NoError(t, os.WriteFile(apiKeyPath, []byte(" secret \n"), 0o600))
+	configPath := filepath.Join(dir, "backends.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`[
+		{"proofType": "risc0", "endpoint": "http://farm-a/", "apiKeyPath": "`+apiKeyPath+`", "timeout": "5m"},
+		{"proofType": "risc0", "endpoint": "http://farm-b"},
+		{"proofType": "sp1", "endpoint": "http://raiko-2", "protocol": "raiko-v4"}
+	]`), 0o600))
+
+	cfgs, err := LoadBackendConfigs(configPath)
+	require.NoError(t, err)
+	require.Len(t, cfgs, 3)
+	require.Equal(t, ProofTypeZKR0, cfgs[0].ProofType)
+	require.Equal(t, "http://farm-a", cfgs[0].Endpoint)
+	require.Equal(t, "secret", cfgs[0].ApiKey)
+	require.Equal(t, 5*time.Minute, cfgs[0].Timeout)
+	require.Equal(t, ProofTypeZKSP1, cfgs[2].ProofType)
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`[{"proofType": "op", "endpoint": "http://raiko"}]`), 0o600))
+	_, err = LoadBackendConfigs(configPath)
+	require.ErrorContains(t, err, "invalid proof type")
+
+	_, err = NewBackendRegistry([]*BackendConfig{{ProofType: ProofTypeZKR0, Protocol: "unknown"}})
+	require.ErrorIs(t, err, ErrUnknownBackendProtocol)
+}
+
+func TestBackendRegistryFailover(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+	recorder := &raikoRequestRecorder{proofs: map[ProofType]string{ProofTypeZKR0: "0xaaaa"}}
+	healthy := httptest.NewServer(recorder.handler())
+	defer healthy.Close()
+
+	registry, err := NewBackendRegistry([]*BackendConfig{
+		{ProofType: ProofTypeZKR0, Endpoint: failing.URL, Timeout: time.Second},
+		{ProofType: ProofTypeZKR0, Endpoint: healthy.URL, Timeout: time.Second},
+	})
+	require.NoError(t, err)
+	require.True(t, registry.Has(ProofTypeZKR0))
+	require.False(t, registry.Has(ProofTypeZKSP1))
+
+	var (
+		opts = []ProofRequestOptions{&ProposalProofRequestOptions{L2BlockNums: []*big.Int{common.Big1}}}
+		meta = []metadata.TaikoProposalMetaData{
+			metadata.NewTaikoProposalMetadataShasta(&shastaBindings.ShastaInboxClientProposed{Id: common.Big1}, 0),
+		}
+	)
+	output, err := registry.RequestProposalProof(context.Background(), opts, meta, false, ProofTypeZKR0)
+	require.NoError(t, err)
+	require.Equal(t, "0xaaaa", output.Data.Proof)
+
+	// The failing backend is now cooling down, so the healthy one is tried first.
+	candidates := registry.candidates(ProofTypeZKR0)
+	require.Equal(t, healthy.URL, candidates[0].backend.Name())
+	require.Equal(t, uint64(1), candidates[1].failures)
+
+	_, err = registry.RequestProposalProof(context.Background(), opts, meta, false, ProofTypeZKSP1)
+	require.ErrorIs(t, err, ErrNoProofBackend)
+}
+
+func TestBackendRegistryStickyPolling(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		accepted int
+	)
+	accepting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		accepted++
+		data := &RaikoProofData{Status: ErrProofInProgress.Error()}
+		if accepted > 1 {
+			data = &RaikoProofData{Proof: "0xaaaa"}
+		}
+		_ = json.NewEncoder(w).Encode(&RaikoRequestProofBodyResponse{ProofType: ProofTypeZKR0, Data: data})
+	}))
+	defer accepting.Close()
+	recorder := &raikoRequestRecorder{proofs: map[ProofType]string{ProofTypeZKR0: "0xbbbb"}}
+	other := httptest.NewServer(recorder.handler())
+	defer other.Close()
+
+	registry, err := NewBackendRegistry([]*BackendConfig{
+		{ProofType: ProofTypeZKR0, Endpoint: accepting.URL, Timeout: time.Second},
+		{ProofType: ProofTypeZKR0, Endpoint: other.URL, Timeout: time.Second},
+	})
+	require.NoError(t, err)
+
+	var (
+		opts = []ProofRequestOptions{&ProposalProofRequestOptions{L2BlockNums: []*big.Int{common.Big1}}}
+		meta = []metadata.TaikoProposalMetaData{
+			metadata.NewTaikoProposalMetadataShasta(&shastaBindings.ShastaInboxClientProposed{Id: common.Big1}, 0),
+		}
+	)
+	output, err := registry.RequestProposalProof(context.Background(), opts, meta, false, ProofTypeZKR0)
+	require.NoError(t, err)
+	require.Equal(t, ErrProofInProgress.Error(), output.Data.Status)
+
+	// Even though the accepting backend is now ordered last, the task is polled on it.
+	registry.backends[ProofTypeZKR0][0].unhealthyUntil = time.Now().Add(time.Hour)
+	output, err = registry.RequestProposalProof(context.Background(), opts, meta, false, ProofTypeZKR0)
+	require.NoError(t, err)
+	require.Equal(t, "0xaaaa", output.Data.Proof)
+	require.Empty(t, recorder.requestedTypes())
+	require.Empty(t, registry.tasks)
+}
+
+func TestBackendRegistryFailoverOnErrorStatus(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(&RaikoRequestProofBodyResponse{
+			ProofType:    ProofTypeZKR0,
+			Error:        "task_failed",
+			ErrorMessage: "prover crashed",
+		})
+	}))
+	defer failing.Close()
+	recorder := &raikoRequestRecorder{proofs: map[ProofType]string{ProofTypeZKR0: "0xaaaa"}}
+	healthy := httptest.NewServer(recorder.handler())
+	defer healthy.Close()
+
+	registry, err := NewBackendRegistry([]*BackendConfig{
+		{ProofType: ProofTypeZKR0, Endpoint: failing.URL, Timeout: time.Second},
+		{ProofType: ProofTypeZKR0, Endpoint: healthy.URL, Timeout: time.Second},
+	})
+	require.NoError(t, err)
+
+	var (
+		opts = []ProofRequestOptions{&ProposalProofRequestOptions{L2BlockNums: []*big.Int{common.Big1}}}
+		meta = []metadata.TaikoProposalMetaData{
+			metadata.NewTaikoProposalMetadataShasta(&shastaBindings.ShastaInboxClientProposed{Id: common.Big1}, 0),
+		}
+	)
+	output, err := registry.RequestProposalProof(context.Background(), opts, meta, false, ProofTypeZKR0)
+	require.NoError(t, err)
+	require.Equal(t, "0xaaaa", output.Data.Proof)
+	require.Equal(t, map[ProofType]int{ProofTypeZKR0: 1}, recorder.requestedTypes())
+
+	candidates := registry.candidates(ProofTypeZKR0)
+	require.Equal(t, healthy.URL, candidates[0].backend.Name())
+	require.Equal(t, uint64(1), candidates[1].failures)
+}
+
+func TestComposeProducerRequestProofRoutesToBackends(t *testing.T) {
+	defaultRecorder := &raikoRequestRecorder{proofs: map[ProofType]string{ProofTypeSgxGeth: "0xbbbb"}}
+	defaultServer := httptest.NewServer(defaultRecorder.handler())
+	defer defaultServer.Close()
+	farmRecorder := &raikoRequestRecorder{proofs: map[ProofType]string{ProofTypeZKR0: "0xaaaa"}}
+	farmServer := httptest.NewServer(farmRecorder.handler())
+	defer farmServer.Close()
+
+	registry, err := NewBackendRegistry([]*BackendConfig{
+		{ProofType: ProofTypeZKR0, Endpoint: farmServer.URL, Timeout: time.Second},
+	})
+	require.NoError(t, err)
+
+	producer := &ComposeProofProducer{
+		RaikoHostEndpoint:   defaultServer.URL,
+		RaikoRequestTimeout: time.Second,
+		Backends:            registry,
+	}
+	result, err := producer.RequestProof(
+		context.Background(),
+		&ProposalProofRequestOptions{
+			ProofType:          ProofTypeZKR0,
+			CompanionProofType: ProofTypeSgxGeth,
+			L2BlockNums:        []*big.Int{common.Big1},
+		},
+		common.Big1,
+		metadata.NewTaikoProposalMetadataShasta(&shastaBindings.ShastaInboxClientProposed{Id: common.Big1}, 0),
+		time.Now(),
+	)
+
+	require.NoError(t, err)
+	require.Equal(t, common.Hex2Bytes("aaaa"), result.Proof)
+	require.Equal(t, map[ProofType]int{ProofTypeZKR0: 1}, farmRecorder.requestedTypes())
+	require.Equal(t, map[ProofType]int{ProofTypeSgxGeth: 1}, defaultRecorder.requestedTypes())
+}
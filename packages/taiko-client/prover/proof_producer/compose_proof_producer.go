@@ -46,7 +46,12 @@ type ComposeProofProducer struct {
 	RaikoHostEndpoint   string
 	RaikoRequestTimeout time.Duration
 	ApiKey              string // ApiKey provided by Raiko
-	Dummy               bool
+	// Backends optionally routes proof types to their own backends, proof types without
+	// a registered backend are requested from RaikoHostEndpoint.
+	Backends *BackendRegistry
+	// Inflight optionally keeps track of the requests sent to RaikoHostEndpoint.
+	Inflight *InflightRaikoRequests
+	Dummy    bool
 	DummyProofProducer
 }
 
@@ -303,8 +308,28 @@ func (s *ComposeProofProducer) requestBatchProof(
 	requestAt time.Time,
 	alreadyGenerated bool,
 ) (*RaikoRequestProofBodyResponse, error) {
+	if s.Backends != nil && s.Backends.Has(proofType) {
+		if len(metas) == 0 {
+			return nil, ErrInvalidLength
+		}
+		output, err := s.Backends.RequestProposalProof(ctx, opts, metas, isAggregation, proofType)
+		if err != nil {
+			return nil, err
+		}
+		return validateRaikoProofResponse(
+			output,
+			metas[0].GetProposalID(),
+			metas[len(metas)-1].GetProposalID(),
+			proofType,
+			isAggregation,
+			requestAt,
+			alreadyGenerated,
+		)
+	}
+
 	ctx, cancel := rpc.CtxWithTimeoutOrDefault(ctx, s.RaikoRequestTimeout)
 	defer cancel()
+	defer s.Inflight.Track(s.RaikoHostEndpoint, proofType, isAggregation, metas)()
 	output, start, end, err := requestRaikoProposalProofV4(
 		ctx,
 		s.RaikoHostEndpoint,
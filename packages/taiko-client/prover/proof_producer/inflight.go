@@ -0,0 +1,84 @@
+package producer
+
+import (
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+)
+
+// InflightRaikoRequest describes a proof request which is currently waiting for a raiko response.
+type InflightRaikoRequest struct {
+	Endpoint        string    `json:"endpoint"`
+	ProofType       ProofType `json:"proofType"`
+	IsAggregation   bool      `json:"isAggregation"`
+	ProposalIDStart *big.Int  `json:"proposalIdStart"`
+	ProposalIDEnd   *big.Int  `json:"proposalIdEnd"`
+	StartedAt       time.Time `json:"startedAt"`
+}
+
+// InflightRaikoRequests keeps track of the raiko proof requests which are still waiting for a
+// response, a nil instance tracks nothing.
+type InflightRaikoRequests struct {
+	requests map[uint64]*InflightRaikoRequest
+	seq      uint64
+	mutex    sync.Mutex
+}
+
+// NewInflightRaikoRequests creates a new InflightRaikoRequests instance.
+func NewInflightRaikoRequests() *InflightRaikoRequests {
+	return &InflightRaikoRequests{requests: make(map[uint64]*InflightRaikoRequest)}
+}
+
+// Track records the proof request of the given proposals to the given endpoint as in-flight,
+// the returned function must be called once the request returns.
+func (r *InflightRaikoRequests) Track(
+	endpoint string,
+	proofType ProofType,
+	isAggregation bool,
+	metas []metadata.TaikoProposalMetaData,
+) func() {
+	if r == nil || len(metas) == 0 {
+		return func() {}
+	}
+	req := &InflightRaikoRequest{
+		Endpoint:        endpoint,
+		ProofType:       proofType,
+		IsAggregation:   isAggregation,
+		ProposalIDStart: metas[0].GetProposalID(),
+		ProposalIDEnd:   metas[len(metas)-1].GetProposalID(),
+		StartedAt:       time.Now(),
+	}
+
+	r.mutex.Lock()
+	r.seq++
+	id := r.seq
+	r.requests[id] = req
+	r.mutex.Unlock()
+
+	return func() {
+		r.mutex.Lock()
+		delete(r.requests, id)
+		r.mutex.Unlock()
+	}
+}
+
+// List returns all raiko proof requests which are still waiting for a response, the oldest
+// request first.
+func (r *InflightRaikoRequests) List() []*InflightRaikoRequest {
+	if r == nil {
+		return []*InflightRaikoRequest{}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	reqs := make([]*InflightRaikoRequest, 0, len(r.requests))
+	for _, req := range r.requests {
+		reqs = append(reqs, req)
+	}
+	sort.Slice(reqs, func(i, j int) bool { return reqs[i].StartedAt.Before(reqs[j].StartedAt) })
+	return reqs
+}
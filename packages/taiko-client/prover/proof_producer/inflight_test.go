@@ -0,0 +1,41 @@
+package producer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	shastaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/shasta"
+)
+
+func TestInflightRaikoRequests(t *testing.T) {
+	var (
+		inflight = NewInflightRaikoRequests()
+		metas    = []metadata.TaikoProposalMetaData{
+			metadata.NewTaikoProposalMetadataShasta(&shastaBindings.ShastaInboxClientProposed{Id: common.Big1}, 0),
+			metadata.NewTaikoProposalMetadataShasta(&shastaBindings.ShastaInboxClientProposed{Id: common.Big2}, 0),
+		}
+	)
+
+	doneFirst := inflight.Track("http://raiko-a", ProofTypeZKR0, false, metas[:1])
+	doneSecond := inflight.Track("http://raiko-b", ProofTypeZKSP1, true, metas)
+
+	reqs := inflight.List()
+	require.Len(t, reqs, 2)
+	require.Equal(t, "http://raiko-a", reqs[0].Endpoint)
+	require.Equal(t, ProofTypeZKSP1, reqs[1].ProofType)
+	require.Equal(t, common.Big1, reqs[1].ProposalIDStart)
+	require.Equal(t, common.Big2, reqs[1].ProposalIDEnd)
+
+	doneFirst()
+	require.Len(t, inflight.List(), 1)
+	doneSecond()
+	require.Empty(t, inflight.List())
+
+	// A nil tracker is a no-op.
+	var disabled *InflightRaikoRequests
+	disabled.Track("http://raiko-a", ProofTypeZKR0, false, metas)()
+	require.Empty(t, disabled.List())
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"sync"
 	"time"
+
+	"github.com/ethereum/go-ethereum/log"
 )
 
 var (
@@ -20,6 +22,7 @@ type ProofBuffer struct {
 	isAggregating bool
 	mutex         sync.RWMutex
 	lastInsertID  uint64
+	store         ProofStore
 }
 
 // NewProofBuffer creates a new ProofBuffer instance.
@@ -30,6 +33,14 @@ func NewProofBuffer(maxLength uint64) *ProofBuffer {
 	}
 }
 
+// NewPersistentProofBuffer creates a new ProofBuffer instance, which also mirrors all its
+// writes and removals into the given proof store.
+func NewPersistentProofBuffer(maxLength uint64, store ProofStore) *ProofBuffer {
+	pb := NewProofBuffer(maxLength)
+	pb.store = store
+	return pb
+}
+
 // Write adds new item to the buffer.
 func (pb *ProofBuffer) Write(item *ProofResponse) (int, error) {
 	pb.mutex.Lock()
@@ -56,6 +67,14 @@ func (pb *ProofBuffer) Write(item *ProofResponse) (int, error) {
 	pb.buffer = append(pb.buffer, item)
 	pb.lastItemAt = insertedAt
 	pb.lastInsertID = item.BatchID.Uint64()
+
+	// The in-memory buffer stays the source of truth, a persistence failure only means the
+	// proof will be requested again after a restart.
+	if pb.store != nil {
+		if err := pb.store.Put(item, ProofStateBuffered); err != nil {
+			log.Warn("Failed to persist buffered proof", "batchID", item.BatchID, "error", err)
+		}
+	}
 	return len(pb.buffer), nil
 }
 
@@ -84,6 +103,18 @@ func (pb *ProofBuffer) Len() int {
 	return len(pb.buffer)
 }
 
+// Contains returns whether the buffer has an item with the given batch ID.
+func (pb *ProofBuffer) Contains(batchID uint64) bool {
+	pb.mutex.RLock()
+	defer pb.mutex.RUnlock()
+	for _, item := range pb.buffer {
+		if item.BatchID.Uint64() == batchID {
+			return true
+		}
+	}
+	return false
+}
+
 // AvailableCapacity returns current available capacity of the buffer.
 func (pb *ProofBuffer) AvailableCapacity() uint64 {
 	pb.mutex.RLock()
@@ -124,6 +155,11 @@ func (pb *ProofBuffer) ClearItems(blockIDs ...uint64) int {
 			newBuffer = append(newBuffer, b)
 		} else {
 			clearedCount++
+			if pb.store != nil {
+				if err := pb.store.Delete(b.ProofType, batchID); err != nil {
+					log.Warn("Failed to delete persisted proof", "batchID", batchID, "error", err)
+				}
+			}
 		}
 	}
 
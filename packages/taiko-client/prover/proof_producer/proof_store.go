@@ -0,0 +1,316 @@
+package producer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	shastaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/shasta"
+)
+
+var (
+	ErrInvalidStoredProof = errors.New("invalid stored proof")
+)
+
+// proofFileExt is the file extension of the persisted proof records.
+const proofFileExt = ".json"
+
+// ProofState represents where a persisted proof currently lives in the prover.
+type ProofState string
+
+// ProofState constants.
+const (
+	// ProofStateCached means the proof is waiting in the out-of-order cache map.
+	ProofStateCached ProofState = "cached"
+	// ProofStateBuffered means the proof has been written into a proof buffer and
+	// is waiting to be aggregated.
+	ProofStateBuffered ProofState = "buffered"
+)
+
+// ProofStore persists the generated proofs, so that they can survive prover restarts.
+type ProofStore interface {
+	Put(item *ProofResponse, state ProofState) error
+	Delete(proofType ProofType, proposalIDs ...uint64) error
+	Prune(proofType ProofType, lastFinalizedProposalID *big.Int) (int, error)
+	Load() ([]*StoredProof, error)
+}
+
+// StoredProof is the persisted representation of a ProofResponse.
+type StoredProof struct {
+	ProposalID  *big.Int                     `json:"proposalId"`
+	ProofType   ProofType                    `json:"proofType"`
+	State       ProofState                   `json:"state"`
+	Proof       hexutil.Bytes                `json:"proof"`
+	Opts        *ProposalProofRequestOptions `json:"opts"`
+	ProposedLog types.Log                    `json:"proposedLog"`
+	Timestamp   uint64                       `json:"timestamp"`
+}
+
+// NewStoredProof creates a new StoredProof instance from the given proof response.
+func NewStoredProof(item *ProofResponse, state ProofState) (*StoredProof, error) {
+	if item == nil || item.BatchID == nil {
+		return nil, ErrNilBatchID
+	}
+	if item.Meta == nil || !item.Meta.IsShasta() {
+		return nil, fmt.Errorf("%w: missing Shasta metadata, proposalID: %d", ErrInvalidStoredProof, item.BatchID)
+	}
+	if item.Opts == nil {
+		return nil, fmt.Errorf("%w: missing request options, proposalID: %d", ErrInvalidStoredProof, item.BatchID)
+	}
+
+	return &StoredProof{
+		ProposalID:  item.BatchID,
+		ProofType:   item.ProofType,
+		State:       state,
+		Proof:       item.Proof,
+		Opts:        item.Opts.ProposalOptions(),
+		ProposedLog: *item.Meta.Shasta().GetLog(),
+		Timestamp:   item.Meta.Shasta().GetTimestamp(),
+	}, nil
+}
+
+// ToProofResponse rebuilds the ProofResponse from the stored record, the given parser is
+// used to decode the persisted Proposed event log.
+func (p *StoredProof) ToProofResponse(
+	parseProposed func(log types.Log) (*shastaBindings.ShastaInboxClientProposed, error),
+) (*ProofResponse, error) {
+	if p.ProposalID == nil || p.Opts == nil {
+		return nil, fmt.Errorf("%w: missing proposal ID or request options", ErrInvalidStoredProof)
+	}
+	event, err := parseProposed(p.ProposedLog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse stored Proposed event, proposalID: %d: %w", p.ProposalID, err)
+	}
+	if event.Id == nil || event.Id.Cmp(p.ProposalID) != 0 {
+		return nil, fmt.Errorf(
+			"%w: proposal ID mismatch, stored: %d, event: %d",
+			ErrInvalidStoredProof,
+			p.ProposalID,
+			event.Id,
+		)
+	}
+
+	return &ProofResponse{
+		BatchID:   p.ProposalID,
+		Meta:      metadata.NewTaikoProposalMetadataShasta(event, p.Timestamp),
+		Proof:     p.Proof,
+		Opts:      p.Opts,
+		ProofType: p.ProofType,
+	}, nil
+}
+
+// FileProofStore is a ProofStore which keeps every proof in its own file under the given
+// data directory, grouped by proof type. Each record is written to a temporary file,
+// synced and then atomically renamed, so a crash never leaves a partially written proof behind.
+type FileProofStore struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+// NewFileProofStore creates a new FileProofStore instance.
+func NewFileProofStore(dir string) (*FileProofStore, error) {
+	if len(dir) == 0 {
+		return nil, errors.New("empty proof store directory")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create proof store directory: %w", err)
+	}
+
+	return &FileProofStore{dir: dir}, nil
+}
+
+// Put implements the ProofStore interface.
+func (s *FileProofStore) Put(item *ProofResponse, state ProofState) error {
+	record, err := NewStoredProof(item, state)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode proof, proposalID: %d: %w", record.ProposalID, err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	typeDir := filepath.Join(s.dir, string(record.ProofType))
+	if err := os.MkdirAll(typeDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create proof type directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(typeDir, "proof-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary proof file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temporary proof file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync temporary proof file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close temporary proof file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.proofPath(record.ProofType, record.ProposalID.Uint64())); err != nil {
+		return fmt.Errorf("failed to persist proof file: %w", err)
+	}
+
+	return syncDir(typeDir)
+}
+
+// Delete implements the ProofStore interface.
+func (s *FileProofStore) Delete(proofType ProofType, proposalIDs ...uint64) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, proposalID := range proposalIDs {
+		if err := os.Remove(s.proofPath(proofType, proposalID)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to delete proof file, proposalID: %d: %w", proposalID, err)
+		}
+	}
+	return nil
+}
+
+// Prune implements the ProofStore interface, it deletes all proofs of the given type whose
+// proposal IDs are not greater than the last finalized proposal ID.
+func (s *FileProofStore) Prune(proofType ProofType, lastFinalizedProposalID *big.Int) (int, error) {
+	if lastFinalizedProposalID == nil {
+		return 0, nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	proposalIDs, err := s.listProposalIDs(proofType)
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := 0
+	for _, proposalID := range proposalIDs {
+		if new(big.Int).SetUint64(proposalID).Cmp(lastFinalizedProposalID) > 0 {
+			continue
+		}
+		if err := os.Remove(s.proofPath(proofType, proposalID)); err != nil && !os.IsNotExist(err) {
+			return pruned, fmt.Errorf("failed to prune proof file, proposalID: %d: %w", proposalID, err)
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+// Load implements the ProofStore interface, it returns all persisted proofs sorted by proof type
+// and proposal ID. Corrupted records are skipped and removed.
+func (s *FileProofStore) Load() ([]*StoredProof, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read proof store directory: %w", err)
+	}
+
+	var records []*StoredProof
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		proofType := ProofType(entry.Name())
+		proposalIDs, err := s.listProposalIDs(proofType)
+		if err != nil {
+			return nil, err
+		}
+		for _, proposalID := range proposalIDs {
+			path := s.proofPath(proofType, proposalID)
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read proof file, proposalID: %d: %w", proposalID, err)
+			}
+			record := new(StoredProof)
+			if err := json.Unmarshal(data, record); err != nil ||
+				record.ProposalID == nil ||
+				record.ProposalID.Uint64() != proposalID ||
+				record.ProofType != proofType {
+				log.Warn("Removing corrupted proof file", "path", path, "error", err)
+				if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+					return nil, fmt.Errorf("failed to remove corrupted proof file: %w", err)
+				}
+				continue
+			}
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// listProposalIDs returns the sorted proposal IDs persisted for the given proof type, leftover
+// temporary files from an interrupted write are cleaned up along the way.
+func (s *FileProofStore) listProposalIDs(proofType ProofType) ([]uint64, error) {
+	typeDir := filepath.Join(s.dir, string(proofType))
+	entries, err := os.ReadDir(typeDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read proof type directory: %w", err)
+	}
+
+	var proposalIDs []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(name, ".tmp") {
+			if err := os.Remove(filepath.Join(typeDir, name)); err != nil && !os.IsNotExist(err) {
+				log.Warn("Failed to remove temporary proof file", "name", name, "error", err)
+			}
+			continue
+		}
+		if !strings.HasSuffix(name, proofFileExt) {
+			continue
+		}
+		id, ok := new(big.Int).SetString(strings.TrimSuffix(name, proofFileExt), 10)
+		if !ok || !id.IsUint64() {
+			log.Warn("Ignoring unknown file in proof store", "name", name)
+			continue
+		}
+		proposalIDs = append(proposalIDs, id.Uint64())
+	}
+	sort.Slice(proposalIDs, func(i, j int) bool { return proposalIDs[i] < proposalIDs[j] })
+	return proposalIDs, nil
+}
+
+// proofPath returns the file path of the proof with the given type and proposal ID.
+func (s *FileProofStore) proofPath(proofType ProofType, proposalID uint64) string {
+	return filepath.Join(s.dir, string(proofType), fmt.Sprintf("%020d%s", proposalID, proofFileExt))
+}
+
+// syncDir flushes the directory entry changes to disk, so that a rename survives a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("failed to open proof directory: %w", err)
+	}
+	defer d.Close()
+
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("failed to sync proof directory: %w", err)
+	}
+	return nil
+}
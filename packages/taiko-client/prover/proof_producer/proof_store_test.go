@@ -0,0 +1,145 @@
+package producer
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/metadata"
+	shastaBindings "github.com/taikoxyz/taiko-mono/packages/taiko-client/bindings/shasta"
+)
+
+func newTestProofResponse(proposalID uint64, proofType ProofType) *ProofResponse {
+	id := new(big.Int).SetUint64(proposalID)
+	return &ProofResponse{
+		BatchID: id,
+		Meta: metadata.NewTaikoProposalMetadataShasta(&shastaBindings.ShastaInboxClientProposed{
+			Id: id,
+			Raw: types.Log{
+				Address:     common.HexToAddress("0x01"),
+				Topics:      []common.Hash{common.HexToHash("0x02")},
+				Data:        []byte{0x03},
+				BlockNumber: proposalID + 100,
+				BlockHash:   common.HexToHash("0x04"),
+				TxHash:      common.HexToHash("0x05"),
+			},
+		}, 1000+proposalID),
+		Proof: []byte{0x01, 0x02, 0x03},
+		Opts: &ProposalProofRequestOptions{
+			ProposalID:  id,
+			ProofType:   proofType,
+			EventL1Hash: common.HexToHash("0x04"),
+			L2BlockNums: []*big.Int{new(big.Int).SetUint64(proposalID * 10)},
+		},
+		ProofType: proofType,
+	}
+}
+
+func parseTestProposed(l types.Log) (*shastaBindings.ShastaInboxClientProposed, error) {
+	return &shastaBindings.ShastaInboxClientProposed{Id: new(big.Int).SetUint64(l.BlockNumber - 100), Raw: l}, nil
+}
+
+func TestFileProofStore(t *testing.T) {
+	store, err := NewFileProofStore(t.TempDir())
+	require.NoError(t, err)
+
+	for i := uint64(1); i <= 3; i++ {
+		require.NoError(t, store.Put(newTestProofResponse(i, ProofTypeZKR0), ProofStateCached))
+	}
+	require.NoError(t, store.Put(newTestProofResponse(2, ProofTypeZKSP1), ProofStateBuffered))
+
+	records, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+
+	// Overwrite an existing record with a new state.
+	require.NoError(t, store.Put(newTestProofResponse(1, ProofTypeZKR0), ProofStateBuffered))
+	records, err = store.Load()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	for _, record := range records {
+		if record.ProofType == ProofTypeZKR0 && record.ProposalID.Uint64() == 1 {
+			require.Equal(t, ProofStateBuffered, record.State)
+		}
+	}
+
+	// Rebuild the proof response from the stored record.
+	proofResponse, err := records[0].ToProofResponse(parseTestProposed)
+	require.NoError(t, err)
+	expected := newTestProofResponse(records[0].ProposalID.Uint64(), records[0].ProofType)
+	require.Equal(t, expected.BatchID, proofResponse.BatchID)
+	require.Equal(t, expected.Proof, proofResponse.Proof)
+	require.Equal(t, expected.ProofType, proofResponse.ProofType)
+	require.Equal(t, expected.Meta.GetRawBlockHash(), proofResponse.Meta.GetRawBlockHash())
+	require.Equal(t, expected.Meta.Shasta().GetTimestamp(), proofResponse.Meta.Shasta().GetTimestamp())
+	require.Equal(t, expected.Opts.ProposalOptions().L2BlockNums, proofResponse.Opts.ProposalOptions().L2BlockNums)
+
+	// Delete and prune.
+	require.NoError(t, store.Delete(ProofTypeZKSP1, 2))
+	pruned, err := store.Prune(ProofTypeZKR0, common.Big2)
+	require.NoError(t, err)
+	require.Equal(t, 2, pruned)
+
+	records, err = store.Load()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(3), records[0].ProposalID.Uint64())
+	require.Equal(t, ProofTypeZKR0, records[0].ProofType)
+}
+
+func TestFileProofStoreSkipsCorruptedRecords(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileProofStore(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(newTestProofResponse(1, ProofTypeZKR0), ProofStateCached))
+	corrupted := store.proofPath(ProofTypeZKR0, 2)
+	require.NoError(t, os.WriteFile(corrupted, []byte("{"), 0o600))
+	leftover := filepath.Join(dir, string(ProofTypeZKR0), "proof-123.tmp")
+	require.NoError(t, os.WriteFile(leftover, []byte("{}"), 0o600))
+
+	records, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.NoFileExists(t, corrupted)
+	require.NoFileExists(t, leftover)
+}
+
+func TestFileProofStoreRejectsIncompleteProof(t *testing.T) {
+	store, err := NewFileProofStore(t.TempDir())
+	require.NoError(t, err)
+
+	require.ErrorIs(t, store.Put(&ProofResponse{}, ProofStateCached), ErrNilBatchID)
+	require.ErrorIs(t, store.Put(&ProofResponse{BatchID: common.Big1}, ProofStateCached), ErrInvalidStoredProof)
+}
+
+func TestPersistentProofBuffer(t *testing.T) {
+	store, err := NewFileProofStore(t.TempDir())
+	require.NoError(t, err)
+
+	b := NewPersistentProofBuffer(3, store)
+	for i := uint64(1); i <= 3; i++ {
+		_, err := b.Write(newTestProofResponse(i, ProofTypeZKSP1))
+		require.NoError(t, err)
+	}
+	require.True(t, b.Contains(2))
+
+	records, err := store.Load()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	for _, record := range records {
+		require.Equal(t, ProofStateBuffered, record.State)
+	}
+
+	require.Equal(t, 2, b.ClearItems(1, 2))
+	require.False(t, b.Contains(2))
+	records, err = store.Load()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(3), records[0].ProposalID.Uint64())
+}
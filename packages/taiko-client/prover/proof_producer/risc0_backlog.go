@@ -37,15 +37,51 @@ func (s *ComposeProofProducer) ClearBacklog(ctx context.Context) error {
 	if s.Dummy {
 		return nil
 	}
-	ctx, cancel := rpc.CtxWithTimeoutOrDefault(ctx, s.RaikoRequestTimeout)
+	for _, backend := range s.risc0ControlBackends() {
+		if err := clearRaikoBacklog(ctx, backend); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StatusClean implements the Risc0BacklogController interface, it only reports clean when
+// every Raiko backend serving RISC0 proofs is idle.
+func (s *ComposeProofProducer) StatusClean(ctx context.Context) (bool, error) {
+	if s.Dummy {
+		return true, nil
+	}
+	for _, backend := range s.risc0ControlBackends() {
+		clean, err := raikoStatusClean(ctx, backend)
+		if err != nil || !clean {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// risc0ControlBackends returns the Raiko backends which serve the RISC0 proofs, falling back to
+// the default Raiko host when no dedicated RISC0 backend is registered.
+func (s *ComposeProofProducer) risc0ControlBackends() []*RaikoBackend {
+	if s.Backends != nil {
+		if backends := s.Backends.RaikoBackends(ProofTypeZKR0); len(backends) > 0 {
+			return backends
+		}
+	}
+	return []*RaikoBackend{{Endpoint: s.RaikoHostEndpoint, ApiKey: s.ApiKey, Timeout: s.RaikoRequestTimeout}}
+}
+
+// clearRaikoBacklog discards the non-terminal RISC0 proof tasks on the given Raiko backend.
+func clearRaikoBacklog(ctx context.Context, backend *RaikoBackend) error {
+	ctx, cancel := rpc.CtxWithTimeoutOrDefault(ctx, backend.Timeout)
 	defer cancel()
 
 	// The clear endpoint only needs to return HTTP 200; its response body is unused.
 	if _, err := requestRaiko[struct{}](
 		ctx,
 		http.MethodPost,
-		s.RaikoHostEndpoint+"/v4/prover/clear",
-		s.ApiKey,
+		backend.Endpoint+"/v4/prover/clear",
+		backend.ApiKey,
 		raikoProverProofTypeRequest{ProofType: ProofTypeZKR0},
 	); err != nil {
 		return fmt.Errorf("failed to clear RISC0 backlog: %w", err)
@@ -53,19 +89,16 @@ func (s *ComposeProofProducer) ClearBacklog(ctx context.Context) error {
 	return nil
 }
 
-// StatusClean implements the Risc0BacklogController interface.
-func (s *ComposeProofProducer) StatusClean(ctx context.Context) (bool, error) {
-	if s.Dummy {
-		return true, nil
-	}
-	ctx, cancel := rpc.CtxWithTimeoutOrDefault(ctx, s.RaikoRequestTimeout)
+// raikoStatusClean reports whether the RISC0 prover of the given Raiko backend is fully idle.
+func raikoStatusClean(ctx context.Context, backend *RaikoBackend) (bool, error) {
+	ctx, cancel := rpc.CtxWithTimeoutOrDefault(ctx, backend.Timeout)
 	defer cancel()
 
 	out, err := requestRaiko[raikoProverStatusResponse](
 		ctx,
 		http.MethodGet,
-		s.RaikoHostEndpoint+"/v4/prover/status?proof_type="+string(ProofTypeZKR0),
-		s.ApiKey,
+		backend.Endpoint+"/v4/prover/status?proof_type="+string(ProofTypeZKR0),
+		backend.ApiKey,
 		nil,
 	)
 	if err != nil {
@@ -25,6 +25,8 @@ type Submitter interface {
 	RequestProof(ctx context.Context, meta metadata.TaikoProposalMetaData) error
 	BatchSubmitProofs(ctx context.Context, proofsWithHeaders *proofProducer.BatchProofs) error
 	AggregateProofsByType(ctx context.Context, proofType proofProducer.ProofType) error
+	ForceAggregate(proofType proofProducer.ProofType) (bool, error)
 	FlushCache(ctx context.Context, proofType proofProducer.ProofType) error
 	ClearProofBuffers(batchProof *proofProducer.BatchProofs, resend bool) error
+	Status() *Status
 }
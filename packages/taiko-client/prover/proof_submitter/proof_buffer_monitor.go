@@ -72,11 +72,12 @@ func startCacheCleanUpAndFlush(
 	ctx context.Context,
 	rpc *rpc.Client,
 	proofCacheMaps map[proofProducer.ProofType]cmap.ConcurrentMap[string, *proofProducer.ProofResponse],
+	proofStore proofProducer.ProofStore,
 	flushCacheNotify chan proofProducer.ProofType,
 ) {
 	log.Info("Starting proof cache cleanup and flushing monitors", "monitorInterval", monitorInterval)
 	for proofType, cacheMap := range proofCacheMaps {
-		go cleanUpStaleCacheAndFlush(ctx, rpc, cacheMap, monitorInterval, proofType, flushCacheNotify)
+		go cleanUpStaleCacheAndFlush(ctx, rpc, cacheMap, proofStore, monitorInterval, proofType, flushCacheNotify)
 	}
 }
 
@@ -86,6 +87,7 @@ func cleanUpStaleCacheAndFlush(
 	ctx context.Context,
 	rpc *rpc.Client,
 	cacheMap cmap.ConcurrentMap[string, *proofProducer.ProofResponse],
+	proofStore proofProducer.ProofStore,
 	cleanUpInterval time.Duration,
 	proofType proofProducer.ProofType,
 	flushCacheNotify chan proofProducer.ProofType,
@@ -105,7 +107,7 @@ func cleanUpStaleCacheAndFlush(
 				continue // Skip this iteration, retry on next tick
 			}
 			// remove stale cache
-			removeFinalizedProofsFromCache(cacheMap, coreState.LastFinalizedProposalId)
+			removeFinalizedProofsFromCache(cacheMap, proofStore, proofType, coreState.LastFinalizedProposalId)
 			// try to flush cached proofs
 			tryFlushCache(flushCacheNotify, proofType)
 		}
@@ -119,15 +121,27 @@ func tryFlushCache(flushCacheNotify chan proofProducer.ProofType, proofType proo
 	}
 }
 
-// removeFinalizedProofsFromCache deletes cached proofs whose IDs are finalized already.
+// removeFinalizedProofsFromCache deletes cached proofs whose IDs are finalized already, and prunes
+// them from the persistent proof store if there is one.
 func removeFinalizedProofsFromCache(
 	cacheMap cmap.ConcurrentMap[string, *proofProducer.ProofResponse],
+	proofStore proofProducer.ProofStore,
+	proofType proofProducer.ProofType,
 	lastFinalizedProposalID *big.Int,
 ) {
 	if lastFinalizedProposalID == nil {
 		return
 	}
 
+	if proofStore != nil {
+		pruned, err := proofStore.Prune(proofType, lastFinalizedProposalID)
+		if err != nil {
+			log.Error("Failed to prune finalized proofs from store", "proofType", proofType, "error", err)
+		} else if pruned > 0 {
+			log.Info("Pruned finalized proofs from store", "proofType", proofType, "count", pruned)
+		}
+	}
+
 	for _, proposalID := range cacheMap.Keys() {
 		id, ok := new(big.Int).SetString(proposalID, 10)
 		if !ok {
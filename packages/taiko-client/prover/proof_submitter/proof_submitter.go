@@ -52,6 +52,8 @@ type ProofSubmitter struct {
 	// Batch proof related
 	proofBuffers   map[proofProducer.ProofType]*proofProducer.ProofBuffer
 	proofCacheMaps map[proofProducer.ProofType]cmap.ConcurrentMap[string, *proofProducer.ProofResponse]
+	// Optional persistent proof store, nil if the proofs are only kept in memory.
+	proofStore proofProducer.ProofStore
 	// Intervals
 	forceBatchProvingInterval     time.Duration
 	proofPollingInterval          time.Duration
@@ -87,6 +89,7 @@ func NewProofSubmitter(
 	forceSP1Proof bool,
 	forceSGXProof bool,
 	zkOnlyProofs bool,
+	proofStore proofProducer.ProofStore,
 ) (*ProofSubmitter, error) {
 	if zkvmProofProducer == nil {
 		return nil, fmt.Errorf("proof submitter requires a ZKVM proof producer")
@@ -115,6 +118,7 @@ func NewProofSubmitter(
 		forceSP1Proof:                 forceSP1Proof,
 		forceSGXProof:                 forceSGXProof,
 		zkOnlyProofs:                  zkOnlyProofs,
+		proofStore:                    proofStore,
 		ctx:                           ctx,
 	}
 
@@ -136,13 +140,20 @@ func NewProofSubmitter(
 func (s *ProofSubmitter) startBackgroundWorkers(ctx context.Context) {
 	log.Info("Starting proof submitter background workers", "interval", monitorInterval)
 	startProofBufferMonitors(ctx, s.proofBuffers, s.TryAggregate)
-	startCacheCleanUpAndFlush(ctx, s.rpc, s.proofCacheMaps, s.flushCacheNotify)
+	startCacheCleanUpAndFlush(ctx, s.rpc, s.proofCacheMaps, s.proofStore, s.flushCacheNotify)
 }
 
 // RequestProof requests proof for the given Taiko batch.
 func (s *ProofSubmitter) RequestProof(ctx context.Context, meta metadata.TaikoProposalMetaData) error {
 	proposalID := meta.GetProposalID()
 
+	// A proof replayed from the persistent proof store is still waiting to be submitted,
+	// there is no need to request it from raiko again.
+	if s.proofStore != nil && s.isProofPending(proposalID) {
+		log.Info("Proof already generated for proposal, skip requesting proof", "proposalID", proposalID)
+		return nil
+	}
+
 	// Wait for the last block to be inserted at first.
 	header, err := s.rpc.WaitProposalHeader(ctx, proposalID)
 	if err != nil {
@@ -311,6 +322,21 @@ func (s *ProofSubmitter) requestProposalProof(
 	return proofResponse, nil
 }
 
+// isProofPending checks whether a proof for the given proposal is already waiting in any proof buffer or cache.
+func (s *ProofSubmitter) isProofPending(proposalID *big.Int) bool {
+	for _, proofBuffer := range s.proofBuffers {
+		if proofBuffer.Contains(proposalID.Uint64()) {
+			return true
+		}
+	}
+	for _, cacheMap := range s.proofCacheMaps {
+		if cacheMap.Has(proposalID.String()) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *ProofSubmitter) shouldUseRisc0Proof(proposalID *big.Int, lastFinalizedProposalID *big.Int) bool {
 	maxRisc0ProofProposalDistance := s.maxRisc0ProofProposalDistance
 	if maxRisc0ProofProposalDistance == nil {
@@ -361,6 +387,11 @@ func (s *ProofSubmitter) handleProofResponse(
 		s.TryAggregate(proofBuffer, proofResponse.ProofType)
 	} else {
 		cacheMap.Set(proposalID.String(), proofResponse)
+		if s.proofStore != nil {
+			if err := s.proofStore.Put(proofResponse, proofProducer.ProofStateCached); err != nil {
+				log.Warn("Failed to persist cached proof", "proposalID", proposalID, "error", err)
+			}
+		}
 		tryFlushCache(s.flushCacheNotify, proofResponse.ProofType)
 	}
 	log.Info(
@@ -471,6 +502,20 @@ func (s *ProofSubmitter) TryAggregate(buffer *proofProducer.ProofBuffer, proofTy
 	return false
 }
 
+// ForceAggregate triggers the aggregation of the proofs in the buffer of the given proof type, even if the
+// buffer is not full yet, it returns false if the buffer is empty or already aggregating.
+func (s *ProofSubmitter) ForceAggregate(proofType proofProducer.ProofType) (bool, error) {
+	proofBuffer, exist := s.proofBuffers[proofType]
+	if !exist {
+		return false, fmt.Errorf("failed to get expected proof type: %s", proofType)
+	}
+	if proofBuffer.Len() == 0 || !proofBuffer.MarkAggregatingIfNot() {
+		return false, nil
+	}
+	s.batchAggregationNotify <- proofType
+	return true, nil
+}
+
 // AggregateProofsByType aggregates proofs of the specified type and submits them in a batch.
 func (s *ProofSubmitter) AggregateProofsByType(ctx context.Context, proofType proofProducer.ProofType) error {
 	proofBuffer, exist := s.proofBuffers[proofType]
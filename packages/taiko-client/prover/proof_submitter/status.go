@@ -0,0 +1,84 @@
+package submitter
+
+import (
+	"math/big"
+	"sort"
+	"time"
+
+	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
+)
+
+// ProofBufferStatus is a snapshot of a single proof buffer.
+type ProofBufferStatus struct {
+	ProofType     proofProducer.ProofType `json:"proofType"`
+	MaxLength     uint64                  `json:"maxLength"`
+	ProposalIDs   []*big.Int              `json:"proposalIds"`
+	IsAggregating bool                    `json:"isAggregating"`
+	LastInsertID  uint64                  `json:"lastInsertId"`
+	LastItemAt    time.Time               `json:"lastItemAt"`
+}
+
+// SP1FallbackStatus is a snapshot of the RISC0-to-SP1 fallback state machine.
+type SP1FallbackStatus struct {
+	Enabled          bool     `json:"enabled"`
+	InSP1            bool     `json:"inSP1"`
+	MaxSP1ProposalID *big.Int `json:"maxSP1ProposalId"`
+}
+
+// Status is a snapshot of the proof submitter internal state, the in-flight raiko requests are
+// owned by the proof producers and filled in by the prover.
+type Status struct {
+	ProofBuffers []*ProofBufferStatus                   `json:"proofBuffers"`
+	CachedProofs map[proofProducer.ProofType][]*big.Int `json:"cachedProofs"`
+	SP1Fallback  *SP1FallbackStatus                     `json:"sp1Fallback"`
+	Inflight     []*proofProducer.InflightRaikoRequest  `json:"inflightRaikoRequests"`
+}
+
+// Status returns a snapshot of the proof buffers, the proof caches and the SP1 fallback state.
+func (s *ProofSubmitter) Status() *Status {
+	status := &Status{
+		ProofBuffers: make([]*ProofBufferStatus, 0, len(s.proofBuffers)),
+		CachedProofs: make(map[proofProducer.ProofType][]*big.Int, len(s.proofCacheMaps)),
+		SP1Fallback: &SP1FallbackStatus{
+			Enabled: s.maxRisc0ProofProposalDistance != nil &&
+				s.maxRisc0ProofProposalDistance.Sign() > 0 &&
+				s.risc0Backlog != nil,
+			InSP1:            s.inSP1Fallback(),
+			MaxSP1ProposalID: s.maxSP1FallbackProposalID(),
+		},
+	}
+
+	for proofType, buffer := range s.proofBuffers {
+		items, err := buffer.ReadAll()
+		if err != nil {
+			// The buffer has been cleared concurrently, just report it as empty.
+			items = nil
+		}
+		ids := make([]*big.Int, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.BatchID)
+		}
+		status.ProofBuffers = append(status.ProofBuffers, &ProofBufferStatus{
+			ProofType:     proofType,
+			MaxLength:     buffer.MaxLength,
+			ProposalIDs:   ids,
+			IsAggregating: buffer.IsAggregating(),
+			LastInsertID:  buffer.LastInsertID(),
+			LastItemAt:    buffer.LastItemAt(),
+		})
+	}
+	sort.Slice(status.ProofBuffers, func(i, j int) bool {
+		return status.ProofBuffers[i].ProofType < status.ProofBuffers[j].ProofType
+	})
+
+	for proofType, cacheMap := range s.proofCacheMaps {
+		ids := make([]*big.Int, 0, cacheMap.Count())
+		for _, item := range cacheMap.Items() {
+			ids = append(ids, item.BatchID)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i].Cmp(ids[j]) < 0 })
+		status.CachedProofs[proofType] = ids
+	}
+
+	return status
+}
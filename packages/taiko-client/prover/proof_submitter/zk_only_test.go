@@ -72,6 +72,7 @@ func TestNewProofSubmitterRequiresZKVMProducer(t *testing.T) {
 		false,
 		false,
 		false,
+		nil,
 	)
 
 	require.ErrorContains(t, err, "proof submitter requires a ZKVM proof producer")
@@ -2,8 +2,10 @@ package prover
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -23,6 +25,7 @@ import (
 	eventIterator "github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/chain_iterator/event_iterator"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/config"
 	"github.com/taikoxyz/taiko-mono/packages/taiko-client/pkg/rpc"
+	adminServer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/admin_server"
 	handler "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/event_handler"
 	proofProducer "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_producer"
 	proofSubmitter "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/proof_submitter"
@@ -30,6 +33,9 @@ import (
 	state "github.com/taikoxyz/taiko-mono/packages/taiko-client/prover/shared_state"
 )
 
+// adminServerShutdownTimeout bounds the graceful shutdown of the admin server.
+const adminServerShutdownTimeout = 5 * time.Second
+
 // eventHandlers contains all event handlers which will be used by the prover.
 type eventHandlers struct {
 	proposalHandler          handler.ProposalHandler
@@ -57,6 +63,9 @@ type Prover struct {
 	// Proof submitters
 	proofSubmitter proofSubmitter.Submitter
 
+	// Raiko requests which are still waiting for a response
+	inflightRaikoRequests *proofProducer.InflightRaikoRequests
+
 	assignmentExpiredCh      chan metadata.TaikoProposalMetaData
 	proveNotify              chan struct{}
 	batchesAggregationNotify chan proofProducer.ProofType
@@ -70,6 +79,9 @@ type Prover struct {
 	txmgr        txmgr.TxManager
 	privateTxmgr txmgr.TxManager
 
+	// Admin server
+	adminServer *adminServer.AdminAPIServer
+
 	ctx context.Context
 	wg  sync.WaitGroup
 }
@@ -170,6 +182,13 @@ func InitFromConfig(
 		return err
 	}
 
+	// Admin server
+	if cfg.AdminServerPort > 0 {
+		if p.adminServer, err = adminServer.New(p, cfg.AdminServerJWTSecret); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -181,6 +200,18 @@ func (p *Prover) Start() error {
 	go p.proveLoop()
 	go p.eventLoop()
 
+	// Start the admin server if it is enabled.
+	if p.adminServer != nil {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			if err := p.adminServer.Start(p.cfg.AdminServerPort); err != nil &&
+				!errors.Is(err, http.ErrServerClosed) {
+				log.Crit("Failed to start prover admin server", "error", err)
+			}
+		}()
+	}
+
 	return nil
 }
 
@@ -271,6 +302,13 @@ func (p *Prover) eventLoop() {
 
 // Close closes the prover instance.
 func (p *Prover) Close(_ context.Context) {
+	if p.adminServer != nil {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), adminServerShutdownTimeout)
+		defer cancel()
+		if err := p.adminServer.Shutdown(shutdownCtx); err != nil {
+			log.Error("Failed to shutdown prover admin server", "error", err)
+		}
+	}
 	p.wg.Wait()
 }
 